@@ -27,7 +27,24 @@ type BlockScannerStorage struct {
 	db *leveldb.DB
 }
 
+const (
+	// CurrentSchemaVersion is the version of the layout block scanner storage write its data in
+	CurrentSchemaVersion = "1"
+	schemaVersionKey     = "schema-version"
+)
+
+// NewBlockScannerStorage open the level db in the given folder , an in memory storage will be used when the folder is empty
+// It returns an error when the database was written with a schema version other than CurrentSchemaVersion
 func NewBlockScannerStorage(levelDbFolder string) (*BlockScannerStorage, error) {
+	return openBlockScannerStorage(levelDbFolder, true)
+}
+
+// ForceOpenBlockScannerStorage open the level db in the given folder without checking its schema version, for debugging
+func ForceOpenBlockScannerStorage(levelDbFolder string) (*BlockScannerStorage, error) {
+	return openBlockScannerStorage(levelDbFolder, false)
+}
+
+func openBlockScannerStorage(levelDbFolder string, checkSchemaVersion bool) (*BlockScannerStorage, error) {
 	var err error
 	var db *leveldb.DB
 	if len(levelDbFolder) == 0 {
@@ -43,6 +60,14 @@ func NewBlockScannerStorage(levelDbFolder string) (*BlockScannerStorage, error)
 			return nil, fmt.Errorf("fail to open level db %s: %w", levelDbFolder, err)
 		}
 	}
+	if checkSchemaVersion {
+		if err := checkStorageSchemaVersion(db); err != nil {
+			if closeErr := db.Close(); closeErr != nil {
+				return nil, fmt.Errorf("fail to close level db %s: %w", levelDbFolder, closeErr)
+			}
+			return nil, fmt.Errorf("level db %s is not compatible: %w", levelDbFolder, err)
+		}
+	}
 	levelDbStorage, err := NewLevelDBScannerStorage(db)
 	if err != nil {
 		return nil, errors.New("fail to create level db")
@@ -53,6 +78,25 @@ func NewBlockScannerStorage(levelDbFolder string) (*BlockScannerStorage, error)
 	}, nil
 }
 
+// checkStorageSchemaVersion make sure the data in the given db is written in the current schema version , a db without
+// schema version is stamped with the current one , as the layout didn't change before the schema version is recorded
+func checkStorageSchemaVersion(db *leveldb.DB) error {
+	buf, err := db.Get([]byte(schemaVersionKey), nil)
+	if err != nil {
+		if !errors.Is(err, leveldb.ErrNotFound) {
+			return fmt.Errorf("fail to get schema version: %w", err)
+		}
+		if err := db.Put([]byte(schemaVersionKey), []byte(CurrentSchemaVersion), nil); err != nil {
+			return fmt.Errorf("fail to set schema version: %w", err)
+		}
+		return nil
+	}
+	if version := string(buf); version != CurrentSchemaVersion {
+		return fmt.Errorf("schema version is %s, expect %s; remove the db folder to rescan the chain from the start block height, or open it with ForceOpenBlockScannerStorage to inspect it", version, CurrentSchemaVersion)
+	}
+	return nil
+}
+
 func (s *BlockScannerStorage) GetInternalDb() *leveldb.DB {
 	return s.db
 }
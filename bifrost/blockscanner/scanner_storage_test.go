@@ -1,6 +1,7 @@
 package blockscanner
 
 import (
+	"github.com/syndtr/goleveldb/leveldb"
 	. "gopkg.in/check.v1"
 )
 
@@ -19,3 +20,39 @@ func (s *BlockScannerStorageSuite) TestScannerSetup(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(scanner, NotNil)
 }
+
+func (s *BlockScannerStorageSuite) TestSchemaVersion(c *C) {
+	dir := c.MkDir()
+	scanner, err := NewBlockScannerStorage(dir)
+	c.Assert(err, IsNil)
+	buf, err := scanner.GetInternalDb().Get([]byte(schemaVersionKey), nil)
+	c.Assert(err, IsNil)
+	c.Check(string(buf), Equals, CurrentSchemaVersion)
+	c.Assert(scanner.SetScanPos(100), IsNil)
+	c.Assert(scanner.Close(), IsNil)
+
+	// reopen a db of the current schema version
+	scanner, err = NewBlockScannerStorage(dir)
+	c.Assert(err, IsNil)
+	pos, err := scanner.GetScanPos()
+	c.Assert(err, IsNil)
+	c.Check(pos, Equals, int64(100))
+	c.Assert(scanner.Close(), IsNil)
+
+	// a database written in another schema version
+	db, err := leveldb.OpenFile(dir, nil)
+	c.Assert(err, IsNil)
+	c.Assert(db.Put([]byte(schemaVersionKey), []byte("2"), nil), IsNil)
+	c.Assert(db.Close(), IsNil)
+	scanner, err = NewBlockScannerStorage(dir)
+	c.Assert(err, NotNil)
+	c.Check(scanner, IsNil)
+
+	// force open skips the version check
+	scanner, err = ForceOpenBlockScannerStorage(dir)
+	c.Assert(err, IsNil)
+	pos, err = scanner.GetScanPos()
+	c.Assert(err, IsNil)
+	c.Check(pos, Equals, int64(100))
+	c.Assert(scanner.Close(), IsNil)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
 	"path/filepath"
@@ -24,9 +25,17 @@ type Configuration struct {
 
 // SignerConfiguration all the configures need by signer
 type SignerConfiguration struct {
-	SignerDbPath  string                    `json:"signer_db_path" mapstructure:"signer_db_path"`
-	BlockScanner  BlockScannerConfiguration `json:"block_scanner" mapstructure:"block_scanner"`
-	RetryInterval time.Duration             `json:"retry_interval" mapstructure:"retry_interval"`
+	SignerDbPath     string                    `json:"signer_db_path" mapstructure:"signer_db_path"`
+	BlockScanner     BlockScannerConfiguration `json:"block_scanner" mapstructure:"block_scanner"`
+	RetryInterval    time.Duration             `json:"retry_interval" mapstructure:"retry_interval"`
+	MinConfirmations int64                     `json:"min_confirmations" mapstructure:"min_confirmations"` // confirmations a broadcast tx needs before it is considered spent
+	ChainClients     []ChainClientConfig       `json:"chain_clients" mapstructure:"-"`                     // loaded separately, mapstructure can't decode json.RawMessage
+}
+
+// ChainClientConfig configuration of a chain client the signer should load, Config is parsed by the chain's configuration struct
+type ChainClientConfig struct {
+	Chain  common.Chain    `json:"chain"`
+	Config json.RawMessage `json:"config"`
 }
 
 // BackOff configuration
@@ -45,12 +54,14 @@ type ChainConfiguration struct {
 	ChainNetwork string                    `json:"chain_network" mapstructure:"chain_network"`
 	UserName     string                    `json:"username" mapstructure:"username"`
 	Password     string                    `json:"password" mapstructure:"password"`
-	RPCHost      string                    `jsonn:"rpc_host" mapstructure:"rpc_host"`
+	RPCHost      string                    `json:"rpc_host" mapstructure:"rpc_host"`
 	HTTPostMode  bool                      `json:"http_post_mode" mapstructure:"http_post_mode"` // Bitcoin core only supports HTTP POST mode
 	DisableTLS   bool                      `json:"disable_tls" mapstructure:"disable_tls"`       // Bitcoin core does not provide TLS by default
 	BlockScanner BlockScannerConfiguration `json:"block_scanner" mapstructure:"block_scanner"`
 	BackOff      BackOff
 	OptToRetire  bool `json:"opt_to_retire" mapstructure:"opt_to_retire"` // don't emit support for this chain during keygen process
+	// RebroadcastInterval how often the chain client re-broadcast the txs that are stuck, only used by bitcoin client
+	RebroadcastInterval time.Duration `json:"rebroadcast_interval" mapstructure:"rebroadcast_interval"`
 }
 
 // TSSConfiguration
@@ -75,6 +86,8 @@ type BlockScannerConfiguration struct {
 	EnforceBlockHeight         bool          `json:"enforce_block_height" mapstructure:"enforce_block_height"`
 	DBPath                     string        `json:"db_path" mapstructure:"db_path"`
 	ChainID                    common.Chain  `json:"chain_id" mapstructure:"chain_id"`
+	CompactionIntervalBlocks   int64         `json:"compaction_interval_blocks" mapstructure:"compaction_interval_blocks"`
+	TransactionFeeSamples      int64         `json:"transaction_fee_samples" mapstructure:"transaction_fee_samples"`
 }
 
 // ClientConfiguration
@@ -86,6 +99,8 @@ type ClientConfiguration struct {
 	SignerName      string       `json:"signer_name" mapstructure:"signer_name"`
 	SignerPasswd    string
 	BackOff         BackOff
+	RetryOnFailure  bool `json:"retry_on_failure" mapstructure:"retry_on_failure"` // retry broadcasting to thorchain on transient failures
+	MaxRetries      int  `json:"max_retries" mapstructure:"max_retries"`
 }
 
 type MetricsConfiguration struct {
@@ -122,9 +137,96 @@ func LoadBiFrostConfig(file string) (*Configuration, error) {
 		cfg.Chains[i].BackOff = cfg.BackOff
 	}
 
+	chainClients, err := loadChainClientConfigs(viper.Get("signer.chain_clients"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to load signer chain clients: %w", err)
+	}
+	cfg.Signer.ChainClients = chainClients
+
 	return &cfg, nil
 }
 
+// loadChainClientConfigs convert the chain clients read by viper back to json, so each config can be kept as json.RawMessage
+func loadChainClientConfigs(raw interface{}) ([]ChainClientConfig, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	buf, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal chain clients: %w", err)
+	}
+	var chainClients []ChainClientConfig
+	if err := json.Unmarshal(buf, &chainClients); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal chain clients: %w", err)
+	}
+	return chainClients, nil
+}
+
+// ChainConfiguration parse the config of the chain client using the chain configuration struct
+func (c ChainClientConfig) ChainConfiguration() (ChainConfiguration, error) {
+	var chainCfg ChainConfiguration
+	if len(c.Config) == 0 {
+		return chainCfg, fmt.Errorf("missing config of chain client (%s)", c.Chain)
+	}
+	if err := json.Unmarshal(c.Config, &chainCfg); err != nil {
+		return chainCfg, fmt.Errorf("fail to parse config of chain client (%s): %w", c.Chain, err)
+	}
+	if chainCfg.ChainID.IsEmpty() {
+		chainCfg.ChainID = c.Chain
+	}
+	if !chainCfg.ChainID.Equals(c.Chain) {
+		return chainCfg, fmt.Errorf("chain client (%s) is configured with chain id (%s)", c.Chain, chainCfg.ChainID)
+	}
+	if chainCfg.BlockScanner.ChainID.IsEmpty() {
+		chainCfg.BlockScanner.ChainID = c.Chain
+	}
+	if err := chainCfg.BlockScanner.ChainID.Validate(); err != nil {
+		return chainCfg, err
+	}
+	if len(chainCfg.RPCHost) == 0 {
+		return chainCfg, fmt.Errorf("missing rpc host of chain client (%s)", c.Chain)
+	}
+	return chainCfg, nil
+}
+
+// ValidateChainClientConfigs check each chain client is of one of the given registered chain client types and has a
+// valid config
+func (c SignerConfiguration) ValidateChainClientConfigs(registered common.Chains) error {
+	chains := make(common.Chains, 0, len(c.ChainClients))
+	for _, chainClient := range c.ChainClients {
+		if err := chainClient.Chain.Validate(); err != nil {
+			return err
+		}
+		if !registered.Has(chainClient.Chain) {
+			return fmt.Errorf("chain client type (%s) is not registered", chainClient.Chain)
+		}
+		if chains.Has(chainClient.Chain) {
+			return fmt.Errorf("chain client (%s) is configured more than once", chainClient.Chain)
+		}
+		chains = append(chains, chainClient.Chain)
+		if _, err := chainClient.ChainConfiguration(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetChainConfigurations return the parsed config of all the chain clients
+func (c SignerConfiguration) GetChainConfigurations(registered common.Chains) ([]ChainConfiguration, error) {
+	if err := c.ValidateChainClientConfigs(registered); err != nil {
+		return nil, err
+	}
+	chainCfgs := make([]ChainConfiguration, 0, len(c.ChainClients))
+	for _, chainClient := range c.ChainClients {
+		chainCfg, err := chainClient.ChainConfiguration()
+		if err != nil {
+			return nil, err
+		}
+		chainCfgs = append(chainCfgs, chainCfg)
+	}
+	return chainCfgs, nil
+}
+
 // GetBootstrapPeers return the internal bootstrap peers in a slice of maddr.Multiaddr
 func (c TSSConfiguration) GetBootstrapPeers() ([]maddr.Multiaddr, error) {
 	var addrs []maddr.Multiaddr
@@ -147,6 +249,8 @@ func applyDefaultConfig() {
 	viper.SetDefault("metrics.chains", common.Chains{common.BNBChain, common.BTCChain, common.ETHChain})
 	viper.SetDefault("thorchain.chain_id", "thorchain")
 	viper.SetDefault("thorchain.chain_host", "localhost:1317")
+	viper.SetDefault("thorchain.retry_on_failure", false)
+	viper.SetDefault("thorchain.max_retries", 5)
 	viper.SetDefault("back_off.initial_interval", 500*time.Millisecond)
 	viper.SetDefault("back_off.randomization_factor", 0.5)
 	viper.SetDefault("back_off.multiplier", 1.5)
@@ -164,11 +268,14 @@ func applyBlockScannerDefault(path string) {
 	viper.SetDefault(fmt.Sprintf("%s.block_scanner.max_http_request_retry", path), "10")
 	viper.SetDefault(fmt.Sprintf("%s.block_scanner.block_height_discover_back_off", path), "1s")
 	viper.SetDefault(fmt.Sprintf("%s.block_scanner.block_retry_interval", path), "1s")
+	viper.SetDefault(fmt.Sprintf("%s.block_scanner.compaction_interval_blocks", path), "1000")
+	viper.SetDefault(fmt.Sprintf("%s.block_scanner.transaction_fee_samples", path), "144")
 }
 
 func applyDefaultSignerConfig() {
 	viper.SetDefault("signer.signer_db_path", "signer_db")
 	applyBlockScannerDefault("signer")
 	viper.SetDefault("signer.retry_interval", "2s")
+	viper.SetDefault("signer.min_confirmations", 1)
 	viper.SetDefault("signer.block_scanner.chain_id", "ThorChain")
 }
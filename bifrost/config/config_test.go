@@ -0,0 +1,112 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type ConfigSuite struct{}
+
+var _ = Suite(&ConfigSuite{})
+
+func (s *ConfigSuite) TestChainClientConfig(c *C) {
+	chainClient := ChainClientConfig{
+		Chain:  common.BTCChain,
+		Config: json.RawMessage(`{"rpc_host":"localhost:18443","username":"thorchain","block_scanner":{"start_block_height":10}}`),
+	}
+	chainCfg, err := chainClient.ChainConfiguration()
+	c.Assert(err, IsNil)
+	c.Check(chainCfg.ChainID.Equals(common.BTCChain), Equals, true)
+	c.Check(chainCfg.BlockScanner.ChainID.Equals(common.BTCChain), Equals, true)
+	c.Check(chainCfg.RPCHost, Equals, "localhost:18443")
+	c.Check(chainCfg.UserName, Equals, "thorchain")
+	c.Check(chainCfg.BlockScanner.StartBlockHeight, Equals, int64(10))
+
+	// config for another chain
+	chainClient.Config = json.RawMessage(`{"chain_id":"BNB","rpc_host":"localhost:26657"}`)
+	_, err = chainClient.ChainConfiguration()
+	c.Check(err, NotNil)
+
+	// missing rpc host
+	chainClient.Config = json.RawMessage(`{"username":"thorchain"}`)
+	_, err = chainClient.ChainConfiguration()
+	c.Check(err, NotNil)
+
+	// malformed config
+	chainClient.Config = json.RawMessage(`{"rpc_host":`)
+	_, err = chainClient.ChainConfiguration()
+	c.Check(err, NotNil)
+
+	chainClient.Config = nil
+	_, err = chainClient.ChainConfiguration()
+	c.Check(err, NotNil)
+}
+
+func (s *ConfigSuite) TestValidateChainClientConfigs(c *C) {
+	registered := common.Chains{common.BNBChain, common.ETHChain, common.BTCChain}
+	cfg := SignerConfiguration{}
+	c.Check(cfg.ValidateChainClientConfigs(registered), IsNil)
+	chainCfgs, err := cfg.GetChainConfigurations(registered)
+	c.Assert(err, IsNil)
+	c.Check(chainCfgs, HasLen, 0)
+
+	cfg.ChainClients = []ChainClientConfig{
+		{Chain: common.BNBChain, Config: json.RawMessage(`{"rpc_host":"localhost:26657"}`)},
+		{Chain: common.BTCChain, Config: json.RawMessage(`{"rpc_host":"localhost:18443"}`)},
+	}
+	c.Check(cfg.ValidateChainClientConfigs(registered), IsNil)
+	chainCfgs, err = cfg.GetChainConfigurations(registered)
+	c.Assert(err, IsNil)
+	c.Assert(chainCfgs, HasLen, 2)
+	c.Check(chainCfgs[0].ChainID.Equals(common.BNBChain), Equals, true)
+	c.Check(chainCfgs[1].ChainID.Equals(common.BTCChain), Equals, true)
+
+	// chain configured twice
+	cfg.ChainClients = append(cfg.ChainClients, ChainClientConfig{Chain: common.BNBChain, Config: json.RawMessage(`{"rpc_host":"localhost:26657"}`)})
+	c.Check(cfg.ValidateChainClientConfigs(registered), NotNil)
+
+	// chain without a registered client type
+	cfg.ChainClients = []ChainClientConfig{
+		{Chain: common.THORChain, Config: json.RawMessage(`{"rpc_host":"localhost:26657"}`)},
+	}
+	c.Check(cfg.ValidateChainClientConfigs(registered), NotNil)
+	_, err = cfg.GetChainConfigurations(registered)
+	c.Check(err, NotNil)
+
+	// invalid config
+	cfg.ChainClients = []ChainClientConfig{
+		{Chain: common.ETHChain, Config: json.RawMessage(`{}`)},
+	}
+	c.Check(cfg.ValidateChainClientConfigs(registered), NotNil)
+}
+
+func (s *ConfigSuite) TestLoadChainClientConfigs(c *C) {
+	chainClients, err := loadChainClientConfigs(nil)
+	c.Assert(err, IsNil)
+	c.Check(chainClients, HasLen, 0)
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"chain": "BTC",
+			"config": map[string]interface{}{
+				"rpc_host": "localhost:18443",
+			},
+		},
+	}
+	chainClients, err = loadChainClientConfigs(raw)
+	c.Assert(err, IsNil)
+	c.Assert(chainClients, HasLen, 1)
+	c.Check(chainClients[0].Chain.Equals(common.BTCChain), Equals, true)
+	chainCfg, err := chainClients[0].ChainConfiguration()
+	c.Assert(err, IsNil)
+	c.Check(chainCfg.RPCHost, Equals, "localhost:18443")
+
+	_, err = loadChainClientConfigs("BTC")
+	c.Check(err, NotNil)
+}
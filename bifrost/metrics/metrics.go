@@ -37,14 +37,27 @@ const (
 	SignerError   MetricName = `signer_error`
 
 	PubKeyManagerError MetricName = `pubkey_manager_error`
+
+	BlockMetaTotal        MetricName = `block_meta_total`
+	BlockMetaTotalUTXOs   MetricName = `block_meta_total_utxos`
+	BlockMetaOldestHeight MetricName = `block_meta_oldest_height`
+	BlockMetaNewestHeight MetricName = `block_meta_newest_height`
+	BlockMetaStorageSize  MetricName = `block_meta_storage_size`
+
+	BlockHashCacheHit  MetricName = `bitcoin_blockhash_cache_hit_total`
+	BlockHashCacheMiss MetricName = `bitcoin_blockhash_cache_miss_total`
+	BitcoinRebroadcast MetricName = `bitcoin_rebroadcast_total`
 )
 
 // Metrics used to provide promethus metrics
 type Metrics struct {
-	logger zerolog.Logger
-	cfg    config.MetricsConfiguration
-	s      *http.Server
-	wg     *sync.WaitGroup
+	logger   zerolog.Logger
+	cfg      config.MetricsConfiguration
+	s        *http.Server
+	wg       *sync.WaitGroup
+	mux      *http.ServeMux
+	patterns map[string]bool
+	lock     *sync.Mutex
 }
 
 var (
@@ -79,6 +92,23 @@ var (
 			Name:      "tx_to_thorchain_signed",
 			Help:      "number of tx observer signed successfully",
 		}),
+		BlockHashCacheHit: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bitcoin",
+			Subsystem: "blockhash_cache",
+			Name:      "hit_total",
+			Help:      "number of block hash lookups served from block meta storage",
+		}),
+		BlockHashCacheMiss: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bitcoin",
+			Subsystem: "blockhash_cache",
+			Name:      "miss_total",
+			Help:      "number of block hash lookups that required a RPC call",
+		}),
+		BitcoinRebroadcast: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bitcoin",
+			Name:      "rebroadcast_total",
+			Help:      "number of stuck txs re-broadcast to bitcoin chain",
+		}),
 	}
 	counterVecs = map[MetricName]*prometheus.CounterVec{
 		CommonBlockScannerError: prometheus.NewCounterVec(prometheus.CounterOpts{
@@ -154,6 +184,39 @@ var (
 			Help:      "how long it takes to sign and broadcast to binance",
 		}),
 	}
+
+	gauges = map[MetricName]prometheus.Gauge{
+		BlockMetaTotal: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "bitcoin",
+			Subsystem: "block_meta",
+			Name:      "total_block_metas",
+			Help:      "number of block metas in storage",
+		}),
+		BlockMetaTotalUTXOs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "bitcoin",
+			Subsystem: "block_meta",
+			Name:      "total_utxos",
+			Help:      "number of utxos kept in all the block metas in storage",
+		}),
+		BlockMetaOldestHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "bitcoin",
+			Subsystem: "block_meta",
+			Name:      "oldest_height",
+			Help:      "height of the oldest block meta in storage",
+		}),
+		BlockMetaNewestHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "bitcoin",
+			Subsystem: "block_meta",
+			Name:      "newest_height",
+			Help:      "height of the newest block meta in storage",
+		}),
+		BlockMetaStorageSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "bitcoin",
+			Subsystem: "block_meta",
+			Name:      "size_bytes",
+			Help:      "total size of the block metas in storage, in bytes",
+		}),
+	}
 )
 
 // NewMetrics create a new instance of Metrics
@@ -172,6 +235,9 @@ func NewMetrics(cfg config.MetricsConfiguration) (*Metrics, error) {
 	for _, item := range histograms {
 		prometheus.MustRegister(item)
 	}
+	for _, item := range gauges {
+		prometheus.MustRegister(item)
+	}
 	// create a new mux server
 	server := http.NewServeMux()
 	// register a new handler for the /metrics endpoint
@@ -184,13 +250,28 @@ func NewMetrics(cfg config.MetricsConfiguration) (*Metrics, error) {
 		WriteTimeout: cfg.WriteTimeout,
 	}
 	return &Metrics{
-		logger: log.With().Str("module", "metrics").Logger(),
-		cfg:    cfg,
-		s:      s,
-		wg:     &sync.WaitGroup{},
+		logger:   log.With().Str("module", "metrics").Logger(),
+		cfg:      cfg,
+		s:        s,
+		wg:       &sync.WaitGroup{},
+		mux:      server,
+		patterns: map[string]bool{"/metrics": true},
+		lock:     &sync.Mutex{},
 	}, nil
 }
 
+// HandleFunc register an additional http handler on the metric server, it fails when the pattern is already registered
+func (m *Metrics) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.patterns[pattern] {
+		return fmt.Errorf("http handler for %s already registered", pattern)
+	}
+	m.mux.HandleFunc(pattern, handler)
+	m.patterns[pattern] = true
+	return nil
+}
+
 // GetCounter return a counter by name, if it doesn't exist, then it return nil
 func (m *Metrics) GetCounter(name MetricName) prometheus.Counter {
 	if counter, ok := counters[name]; ok {
@@ -207,6 +288,14 @@ func (m *Metrics) GetHistograms(name MetricName) prometheus.Histogram {
 	return nil
 }
 
+// GetGauge return a gauge by name, if it doesn't exist, then it return nil
+func (m *Metrics) GetGauge(name MetricName) prometheus.Gauge {
+	if g, ok := gauges[name]; ok {
+		return g
+	}
+	return nil
+}
+
 func (m *Metrics) GetCounterVec(name MetricName) *prometheus.CounterVec {
 	if c, ok := counterVecs[name]; ok {
 		return c
@@ -123,6 +123,28 @@ func (b *Binance) GetConfig() config.ChainConfiguration {
 	return b.cfg
 }
 
+// Ping check the binance node is reachable through its health endpoint
+func (b *Binance) Ping() error {
+	u, err := url.Parse(b.cfg.RPCHost)
+	if err != nil {
+		return fmt.Errorf("unable to parse dex host: %w", err)
+	}
+	u.Path = "health"
+	resp, err := b.client.Get(u.String())
+	if err != nil {
+		return fmt.Errorf("fail to get request(%s): %w", u.String(), err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Error().Err(err).Msg("fail to close resp body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("binance node is not healthy, status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
 // IsTestNet determinate whether we are running on test net by checking the status
 func (b *Binance) checkIsTestNet() error {
 	// Cached data after first call
@@ -167,6 +167,36 @@ func (s *BinancechainSuite) TestGetHeight(c *C) {
 	c.Check(height, Equals, int64(123456789))
 }
 
+func (s *BinancechainSuite) TestPing(c *C) {
+	healthy := true
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.RequestURI == "/status" {
+			_, err := rw.Write([]byte(status))
+			c.Assert(err, IsNil)
+			return
+		}
+		if req.RequestURI == "/health" && healthy {
+			_, err := rw.Write([]byte(`{ "jsonrpc": "2.0", "id": "", "result": {} }`))
+			c.Assert(err, IsNil)
+			return
+		}
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	b, err := NewBinance(s.thorKeys, config.ChainConfiguration{
+		RPCHost: server.URL,
+		BlockScanner: config.BlockScannerConfiguration{
+			StartBlockHeight: 1, // avoids querying thorchain for block height
+		},
+	}, nil, s.bridge, s.m)
+	c.Assert(err, IsNil)
+	c.Check(b.Ping(), IsNil)
+
+	healthy = false
+	c.Check(b.Ping(), NotNil)
+}
+
 func (s *BinancechainSuite) TestSignTx(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if strings.HasPrefix(req.RequestURI, "/abci_query?") {
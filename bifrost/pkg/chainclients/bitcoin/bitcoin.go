@@ -1,10 +1,14 @@
 package bitcoin
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -27,8 +31,15 @@ import (
 	"gitlab.com/thorchain/thornode/common"
 )
 
-// BlockCacheSize the number of block meta that get store in storage.
-const BlockCacheSize = 100
+const (
+	// BlockCacheSize the number of block meta that get store in storage.
+	BlockCacheSize = 100
+	// BlockMetaStatsInterval the number of blocks between two block meta stats updates
+	BlockMetaStatsInterval = 100
+	// BlockHashCacheConfirmations the number of confirmations a block need before its hash can be served from block meta storage
+	// hashes of the blocks near the tip could still change by a re-org
+	BlockHashCacheConfirmations = 6
+)
 
 // Client observes bitcoin chain and allows to sign and broadcast tx
 type Client struct {
@@ -43,6 +54,12 @@ type Client struct {
 	bridge            *thorclient.ThorchainBridge
 	globalErrataQueue chan<- types.ErrataBlock
 	nodePubKey        common.PubKey
+	m                 *metrics.Metrics
+	broadcastTxs      map[string]trackedTx
+	broadcastTxsLock  *sync.Mutex
+	chainHeight       int64
+	cancel            context.CancelFunc
+	wg                *sync.WaitGroup
 }
 
 // NewClient generates a new Client
@@ -80,14 +97,18 @@ func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server
 	}
 
 	c := &Client{
-		logger:     log.Logger.With().Str("module", "bitcoin").Logger(),
-		cfg:        cfg,
-		chain:      cfg.ChainID,
-		client:     client,
-		privateKey: btcPrivateKey,
-		ksWrapper:  ksWrapper,
-		bridge:     bridge,
-		nodePubKey: nodePubKey,
+		logger:           log.Logger.With().Str("module", "bitcoin").Logger(),
+		cfg:              cfg,
+		chain:            cfg.ChainID,
+		client:           client,
+		privateKey:       btcPrivateKey,
+		ksWrapper:        ksWrapper,
+		bridge:           bridge,
+		nodePubKey:       nodePubKey,
+		m:                m,
+		broadcastTxs:     make(map[string]trackedTx),
+		broadcastTxsLock: &sync.Mutex{},
+		wg:               &sync.WaitGroup{},
 	}
 
 	var path string // if not set later, will in memory storage
@@ -104,7 +125,7 @@ func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server
 		return c, fmt.Errorf("fail to create block scanner: %w", err)
 	}
 
-	c.blockMetaAccessor, err = NewLevelDBBlockMetaAccessor(storage.GetInternalDb())
+	c.blockMetaAccessor, err = NewLevelDBBlockMetaAccessor(storage.GetInternalDb(), c.cfg.BlockScanner.CompactionIntervalBlocks, c.cfg.BlockScanner.TransactionFeeSamples)
 	if err != nil {
 		return c, fmt.Errorf("fail to create utxo accessor: %w", err)
 	}
@@ -116,11 +137,24 @@ func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server
 func (c *Client) Start(globalTxsQueue chan types.TxIn, globalErrataQueue chan types.ErrataBlock) {
 	c.blockScanner.Start(globalTxsQueue)
 	c.globalErrataQueue = globalErrataQueue
+	if c.m != nil {
+		if err := c.m.HandleFunc(NetworkInfoPath, c.handleNetworkInfo); err != nil {
+			c.logger.Err(err).Msg("fail to register network info handler")
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg.Add(1)
+	go c.rebroadcastStuckTxsLoop(ctx)
 }
 
 // Stop stops the block scanner
 func (c *Client) Stop() {
 	c.blockScanner.Stop()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
 }
 
 // GetConfig - get the chain configuration
@@ -128,6 +162,11 @@ func (c *Client) GetConfig() config.ChainConfiguration {
 	return c.cfg
 }
 
+// Ping check the bitcoin node is reachable
+func (c *Client) Ping() error {
+	return c.client.Ping()
+}
+
 // GetChain returns BTC Chain
 func (c *Client) GetChain() common.Chain {
 	return common.BTCChain
@@ -135,7 +174,12 @@ func (c *Client) GetChain() common.Chain {
 
 // GetHeight returns current block height
 func (c *Client) GetHeight() (int64, error) {
-	return c.client.GetBlockCount()
+	height, err := c.client.GetBlockCount()
+	if err != nil {
+		return 0, err
+	}
+	atomic.StoreInt64(&c.chainHeight, height)
+	return height, nil
 }
 
 // GetAddress returns address from pubkey
@@ -151,15 +195,13 @@ func (c *Client) GetAddress(poolPubKey common.PubKey) string {
 // GetAccount returns account with balance for an address
 func (c *Client) GetAccount(pkey common.PubKey) (common.Account, error) {
 	acct := common.Account{}
-	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	utxos, err := c.blockMetaAccessor.GetAllUTXOs(pkey)
 	if err != nil {
-		return acct, fmt.Errorf("fail to get block meta: %w", err)
+		return acct, fmt.Errorf("fail to get UTXOs: %w", err)
 	}
 	total := 0.0
-	for _, item := range blockMetas {
-		for _, utxo := range item.GetUTXOs(pkey) {
-			total += utxo.Value
-		}
+	for _, utxo := range utxos {
+		total += utxo.Value
 	}
 
 	totalAmt, err := btcutil.NewAmount(total)
@@ -174,6 +216,36 @@ func (c *Client) GetAccount(pkey common.PubKey) (common.Account, error) {
 	}), nil
 }
 
+// GetAddressBalance returns the sum of the unspent outputs of the given address, in satoshi
+// the outputs are looked up through the pubkey index of the block meta storage
+func (c *Client) GetAddressBalance(addr string) (sdk.Uint, error) {
+	if len(addr) == 0 {
+		return sdk.ZeroUint(), errors.New("address is empty")
+	}
+	pubKeys, err := c.blockMetaAccessor.GetUTXOPubKeys()
+	if err != nil {
+		return sdk.ZeroUint(), fmt.Errorf("fail to get pubkeys with unspent outputs: %w", err)
+	}
+	total := sdk.ZeroUint()
+	for _, pubKey := range pubKeys {
+		if !strings.EqualFold(c.GetAddress(pubKey), addr) {
+			continue
+		}
+		utxos, err := c.blockMetaAccessor.GetAllUTXOs(pubKey)
+		if err != nil {
+			return sdk.ZeroUint(), fmt.Errorf("fail to get unspent outputs of pubkey(%s): %w", pubKey, err)
+		}
+		for _, utxo := range utxos {
+			amt, err := btcutil.NewAmount(utxo.Value)
+			if err != nil {
+				return sdk.ZeroUint(), fmt.Errorf("fail to convert utxo(%s) value: %w", utxo.GetKey(), err)
+			}
+			total = total.Add(sdk.NewUint(uint64(amt)))
+		}
+	}
+	return total, nil
+}
+
 // OnObservedTxIn gets called from observer when we have a valid observation
 // For bitcoin chain client we want to save the utxo we can spend later to sign
 func (c *Client) OnObservedTxIn(txIn types.TxInItem, blockHeight int64) {
@@ -251,10 +323,16 @@ func (c *Client) reConfirmTx() error {
 			Height: blockMeta.Height,
 			Txs:    errataTxs,
 		}
-		// Let's get the block again to fix the block hash
-		r, err := c.getBlock(blockMeta.Height)
+		// Let's get the block again to fix the block hash, the hash recorded in block meta storage is stale, so don't use GetBlockHash
+		hash, err := c.client.GetBlockHash(blockMeta.Height)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to get block hash: %d", blockMeta.Height)
+			continue
+		}
+		r, err := c.client.GetBlockVerboseTx(hash)
 		if err != nil {
 			c.logger.Err(err).Msgf("fail to get block verbose tx result: %d", blockMeta.Height)
+			continue
 		}
 		blockMeta.PreviousHash = r.PreviousHash
 		blockMeta.BlockHash = r.Hash
@@ -293,7 +371,8 @@ func (c *Client) FetchTxs(height int64) (types.TxIn, error) {
 	block, err := c.getBlock(height)
 	if err != nil {
 		time.Sleep(c.cfg.BlockScanner.BlockHeightDiscoverBackoff)
-		if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCInvalidParameter {
+		var rpcErr *btcjson.RPCError
+		if errors.As(err, &rpcErr) && rpcErr.Code == btcjson.ErrRPCInvalidParameter {
 			// this means the tx had been broadcast to chain, it must be another signer finished quicker then us
 			return types.TxIn{}, btypes.UnavailableBlock
 		}
@@ -312,6 +391,10 @@ func (c *Client) FetchTxs(height int64) (types.TxIn, error) {
 		blockMeta.PreviousHash = block.PreviousHash
 		blockMeta.BlockHash = block.Hash
 	}
+	txs, err := c.extractTxs(block, blockMeta)
+	if err != nil {
+		return types.TxIn{}, fmt.Errorf("fail to extract txs from block: %w", err)
+	}
 
 	if err := c.blockMetaAccessor.SaveBlockMeta(block.Height, blockMeta); err != nil {
 		return types.TxIn{}, fmt.Errorf("fail to save block meta into storage: %w", err)
@@ -324,24 +407,98 @@ func (c *Client) FetchTxs(height int64) (types.TxIn, error) {
 			}
 		}()
 	}
-	txs, err := c.extractTxs(block)
-	if err != nil {
-		return types.TxIn{}, fmt.Errorf("fail to extract txs from block: %w", err)
+	if block.Height%BlockMetaStatsInterval == 0 {
+		c.updateBlockMetaStats()
 	}
 	return txs, nil
 }
 
+// updateBlockMetaStats emit the block meta storage statistics as prometheus gauges
+func (c *Client) updateBlockMetaStats() {
+	if c.m == nil {
+		return
+	}
+	stats, err := c.blockMetaAccessor.Stats()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get block meta stats")
+		return
+	}
+	c.m.GetGauge(metrics.BlockMetaTotal).Set(float64(stats.TotalBlockMetas))
+	c.m.GetGauge(metrics.BlockMetaTotalUTXOs).Set(float64(stats.TotalUTXOs))
+	c.m.GetGauge(metrics.BlockMetaOldestHeight).Set(float64(stats.OldestHeight))
+	c.m.GetGauge(metrics.BlockMetaNewestHeight).Set(float64(stats.NewestHeight))
+	c.m.GetGauge(metrics.BlockMetaStorageSize).Set(float64(stats.SizeBytes))
+}
+
+// GetBlockHash return the hash of the block at the given height, the hash recorded in block meta storage will be used when
+// there is one, otherwise it will be retrieved from the chain and saved into block meta storage.
+// Blocks with less than BlockHashCacheConfirmations confirmations are always retrieved from the chain, and not saved
+func (c *Client) GetBlockHash(height int64) (string, error) {
+	chainHeight := atomic.LoadInt64(&c.chainHeight)
+	if chainHeight == 0 {
+		var err error
+		chainHeight, err = c.GetHeight()
+		if err != nil {
+			return "", fmt.Errorf("fail to get chain height: %w", err)
+		}
+	}
+	if height > chainHeight-BlockHashCacheConfirmations {
+		c.incCounter(metrics.BlockHashCacheMiss)
+		hash, err := c.client.GetBlockHash(height)
+		if err != nil {
+			return "", fmt.Errorf("fail to get block hash of height(%d): %w", height, err)
+		}
+		return hash.String(), nil
+	}
+	blockMeta, err := c.blockMetaAccessor.GetBlockMeta(height)
+	if err != nil {
+		return "", fmt.Errorf("fail to get block meta of height(%d): %w", height, err)
+	}
+	if blockMeta != nil && blockMeta.BlockHash != "" {
+		c.incCounter(metrics.BlockHashCacheHit)
+		return blockMeta.BlockHash, nil
+	}
+	c.incCounter(metrics.BlockHashCacheMiss)
+	hash, err := c.client.GetBlockHash(height)
+	if err != nil {
+		return "", fmt.Errorf("fail to get block hash of height(%d): %w", height, err)
+	}
+	if blockMeta == nil {
+		blockMeta = NewBlockMeta("", height, hash.String())
+	} else {
+		blockMeta.BlockHash = hash.String()
+	}
+	if err := c.blockMetaAccessor.SaveBlockMeta(height, blockMeta); err != nil {
+		c.logger.Err(err).Msgf("fail to save block meta to storage,block height(%d)", height)
+	}
+	return hash.String(), nil
+}
+
+// incCounter increase the counter with the given name by one, it does nothing when metrics is not available
+func (c *Client) incCounter(name metrics.MetricName) {
+	if c.m == nil {
+		return
+	}
+	if counter := c.m.GetCounter(name); counter != nil {
+		counter.Inc()
+	}
+}
+
 // getBlock retrieves block from chain for a block height
 func (c *Client) getBlock(height int64) (*btcjson.GetBlockVerboseTxResult, error) {
-	hash, err := c.client.GetBlockHash(height)
+	blockHash, err := c.GetBlockHash(height)
 	if err != nil {
 		return &btcjson.GetBlockVerboseTxResult{}, err
 	}
+	hash, err := chainhash.NewHashFromStr(blockHash)
+	if err != nil {
+		return &btcjson.GetBlockVerboseTxResult{}, fmt.Errorf("fail to parse block hash(%s): %w", blockHash, err)
+	}
 	return c.client.GetBlockVerboseTx(hash)
 }
 
-// extractTxs extracts txs from a block to type TxIn
-func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn, error) {
+// extractTxs extract the txs bifrost care about from the given block, the input/output value of these txs will be recorded in the given block meta
+func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult, blockMeta *BlockMeta) (types.TxIn, error) {
 	txIn := types.TxIn{
 		BlockHeight: strconv.FormatInt(block.Height, 10),
 		Chain:       c.GetChain(),
@@ -359,10 +516,12 @@ func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn,
 		if err != nil {
 			return types.TxIn{}, fmt.Errorf("fail to get memo from tx: %w", err)
 		}
-		gas, err := c.getGas(&tx)
+		txValue, err := c.getTransactionValue(&tx)
 		if err != nil {
 			return types.TxIn{}, fmt.Errorf("fail to get gas from tx: %w", err)
 		}
+		blockMeta.AddTransactionValue(txValue)
+		gas := getGasFromTransactionValue(txValue)
 
 		output := c.getOutput(sender, &tx)
 		amount := uint64(output.Value * common.One)
@@ -472,17 +631,17 @@ func (c *Client) getMemo(tx *btcjson.TxRawResult) (string, error) {
 	return string(decoded), nil
 }
 
-// getGas returns gas for a btc tx (sum vin - sum vout)
-func (c *Client) getGas(tx *btcjson.TxRawResult) (common.Gas, error) {
+// getTransactionValue returns the total value of vin and vout of a btc tx
+func (c *Client) getTransactionValue(tx *btcjson.TxRawResult) (TransactionValue, error) {
 	var sumVin uint64 = 0
 	for _, vin := range tx.Vin {
 		txHash, err := chainhash.NewHashFromStr(vin.Txid)
 		if err != nil {
-			return common.Gas{}, fmt.Errorf("fail to get tx hash from tx id string")
+			return TransactionValue{}, fmt.Errorf("fail to get tx hash from tx id string")
 		}
 		vinTx, err := c.client.GetRawTransactionVerbose(txHash)
 		if err != nil {
-			return common.Gas{}, fmt.Errorf("fail to query raw tx from bitcoin node")
+			return TransactionValue{}, fmt.Errorf("fail to query raw tx from bitcoin node")
 		}
 		sumVin += uint64(vinTx.Vout[vin.Vout].Value * common.One)
 	}
@@ -490,8 +649,18 @@ func (c *Client) getGas(tx *btcjson.TxRawResult) (common.Gas, error) {
 	for _, vout := range tx.Vout {
 		sumVout += uint64(vout.Value * common.One)
 	}
-	totalGas := sumVin - sumVout
+	return TransactionValue{
+		TxID:        tx.Txid,
+		InputValue:  sumVin,
+		OutputValue: sumVout,
+		VSize:       tx.Vsize,
+	}, nil
+}
+
+// getGasFromTransactionValue returns gas for a btc tx (sum vin - sum vout)
+func getGasFromTransactionValue(txValue TransactionValue) common.Gas {
+	totalGas := txValue.InputValue - txValue.OutputValue
 	return common.Gas{
 		common.NewCoin(common.BTCAsset, sdk.NewUint(totalGas)),
-	}, nil
+	}
 }
@@ -2,12 +2,14 @@ package bitcoin
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -30,12 +32,16 @@ import (
 func TestPackage(t *testing.T) { TestingT(t) }
 
 type BitcoinSuite struct {
-	client  *Client
-	server  *httptest.Server
-	bridge  *thorclient.ThorchainBridge
-	cfg     config.ChainConfiguration
-	m       *metrics.Metrics
-	cleanup func()
+	client           *Client
+	server           *httptest.Server
+	bridge           *thorclient.ThorchainBridge
+	cfg              config.ChainConfiguration
+	m                *metrics.Metrics
+	cleanup          func()
+	blockHashCalls   int64
+	mempoolSize      int64
+	stuckTxID        string
+	rebroadcastCalls int64
 }
 
 var _ = Suite(
@@ -97,12 +103,13 @@ func (s *BitcoinSuite) SetUpTest(c *C) {
 
 	s.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		r := struct {
-			Method string   `json:"method"`
-			Params []string `json:"params"`
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
 		}{}
 		json.NewDecoder(req.Body).Decode(&r)
 		switch {
 		case r.Method == "getblockhash":
+			atomic.AddInt64(&s.blockHashCalls, 1)
 			httpTestHandler(c, rw, "../../../../test/fixtures/btc/blockhash.json")
 		case r.Method == "getblock":
 			httpTestHandler(c, rw, "../../../../test/fixtures/btc/block_verbose.json")
@@ -111,13 +118,51 @@ func (s *BitcoinSuite) SetUpTest(c *C) {
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/tx-c241.json")
 			}
 		case r.Method == "getrawtransaction":
+			if len(s.stuckTxID) > 0 && r.Params[0] == s.stuckTxID {
+				rw.Header().Set("Content-Type", "application/json")
+				_, _ = rw.Write([]byte(`{"result":null,"error":{"code":-5,"message":"No such mempool or blockchain transaction"},"id":1}`))
+				return
+			}
 			if r.Params[0] == "5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513" {
+				if len(r.Params) > 1 && r.Params[1] == float64(0) {
+					httpTestHandler(c, rw, "../../../../test/fixtures/btc/tx-5b08-raw.json")
+					return
+				}
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/tx-5b08.json")
 			} else {
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/tx.json")
 			}
+		case r.Method == "getmempoolentry":
+			if r.Params[0] == "5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513" {
+				httpTestHandler(c, rw, "../../../../test/fixtures/btc/getmempoolentry.json")
+			} else {
+				rw.Header().Set("Content-Type", "application/json")
+				_, _ = rw.Write([]byte(`{"result":null,"error":{"code":-5,"message":"Transaction not in mempool"},"id":1}`))
+			}
+		case r.Method == "gettxout":
+			rw.Header().Set("Content-Type", "application/json")
+			if r.Params[1] == float64(0) {
+				// output has been spent
+				_, _ = rw.Write([]byte(`{"result":null,"error":null,"id":1}`))
+				return
+			}
+			_, _ = rw.Write([]byte(`{"result":{"bestblock":"000000008de7a25f64f9780b6c894016d2c63716a89f7c9e704ebb7e8377a0c8","confirmations":10,"value":0.00001,"scriptPubKey":{"asm":"1","hex":"51","type":"nonstandard"},"coinbase":false},"error":null,"id":1}`))
+		case r.Method == "sendrawtransaction":
+			atomic.AddInt64(&s.rebroadcastCalls, 1)
+			httpTestHandler(c, rw, "../../../../test/fixtures/btc/sendrawtransaction.json")
+		case r.Method == "ping":
+			rw.Header().Set("Content-Type", "application/json")
+			_, _ = rw.Write([]byte(`{"result":null,"error":null,"id":1}`))
 		case r.Method == "getblockcount":
 			httpTestHandler(c, rw, "../../../../test/fixtures/btc/blockcount.json")
+		case r.Method == "getnetworkinfo":
+			httpTestHandler(c, rw, "../../../../test/fixtures/btc/getnetworkinfo.json")
+		case r.Method == "getpeerinfo":
+			httpTestHandler(c, rw, "../../../../test/fixtures/btc/getpeerinfo.json")
+		case r.Method == "getmempoolinfo":
+			size := atomic.LoadInt64(&s.mempoolSize)
+			rw.Header().Set("Content-Type", "application/json")
+			_, _ = rw.Write([]byte(fmt.Sprintf(`{"result":{"size":%d,"bytes":%d,"usage":%d,"maxmempool":300000000,"mempoolminfee":0.00001000,"minrelaytxfee":0.00001000},"error":null,"id":1}`, size, size*250, size*1000)))
 		}
 	}))
 
@@ -150,6 +195,53 @@ func (s *BitcoinSuite) TestGetBlock(c *C) {
 	c.Assert(len(block.Tx), Equals, 110)
 }
 
+func (s *BitcoinSuite) TestGetBlockHash(c *C) {
+	atomic.StoreInt64(&s.blockHashCalls, 0)
+	atomic.StoreInt64(&s.client.chainHeight, 1696800)
+	hash, err := s.client.GetBlockHash(1696761)
+	c.Assert(err, IsNil)
+	c.Assert(hash, Equals, "000000008de7a25f64f9780b6c894016d2c63716a89f7c9e704ebb7e8377a0c8")
+	c.Assert(atomic.LoadInt64(&s.blockHashCalls), Equals, int64(1))
+
+	// following queries of the same height are served from block meta storage
+	for i := 0; i < 3; i++ {
+		hash, err = s.client.GetBlockHash(1696761)
+		c.Assert(err, IsNil)
+		c.Assert(hash, Equals, "000000008de7a25f64f9780b6c894016d2c63716a89f7c9e704ebb7e8377a0c8")
+	}
+	c.Assert(atomic.LoadInt64(&s.blockHashCalls), Equals, int64(1))
+	blockMeta, err := s.client.blockMetaAccessor.GetBlockMeta(1696761)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta, NotNil)
+	c.Assert(blockMeta.BlockHash, Equals, hash)
+
+	// a block meta without block hash requires a RPC call
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(1696762, NewBlockMeta("", 1696762, "")), IsNil)
+	_, err = s.client.GetBlockHash(1696762)
+	c.Assert(err, IsNil)
+	c.Assert(atomic.LoadInt64(&s.blockHashCalls), Equals, int64(2))
+
+	// blocks near the tip are always retrieved from chain, and not saved
+	for i := 0; i < 2; i++ {
+		hash, err = s.client.GetBlockHash(1696800 - BlockHashCacheConfirmations + 1)
+		c.Assert(err, IsNil)
+		c.Assert(hash, Equals, "000000008de7a25f64f9780b6c894016d2c63716a89f7c9e704ebb7e8377a0c8")
+	}
+	c.Assert(atomic.LoadInt64(&s.blockHashCalls), Equals, int64(4))
+	blockMeta, err = s.client.blockMetaAccessor.GetBlockMeta(1696800 - BlockHashCacheConfirmations + 1)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta, IsNil)
+
+	// chain height is retrieved when it is not known yet, blockcount.json return 10
+	atomic.StoreInt64(&s.client.chainHeight, 0)
+	_, err = s.client.GetBlockHash(8)
+	c.Assert(err, IsNil)
+	c.Assert(atomic.LoadInt64(&s.client.chainHeight), Equals, int64(10))
+	blockMeta, err = s.client.blockMetaAccessor.GetBlockMeta(8)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta, IsNil)
+}
+
 func (s *BitcoinSuite) TestFetchTxs(c *C) {
 	txs, err := s.client.FetchTxs(0)
 	c.Assert(err, IsNil)
@@ -462,8 +554,9 @@ func (s *BitcoinSuite) TestGetGas(c *C) {
 			},
 		},
 	}
-	gas, err := s.client.getGas(&tx)
+	txValue, err := s.client.getTransactionValue(&tx)
 	c.Assert(err, IsNil)
+	gas := getGasFromTransactionValue(txValue)
 	c.Assert(gas.Equals(common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(7244430))}), Equals, true)
 
 	tx = btcjson.TxRawResult{
@@ -493,8 +586,9 @@ func (s *BitcoinSuite) TestGetGas(c *C) {
 			},
 		},
 	}
-	gas, err = s.client.getGas(&tx)
+	txValue, err = s.client.getTransactionValue(&tx)
 	c.Assert(err, IsNil)
+	gas = getGasFromTransactionValue(txValue)
 	c.Assert(gas.Equals(common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(149013))}), Equals, true)
 }
 
@@ -516,6 +610,10 @@ func (s *BitcoinSuite) TestGetHeight(c *C) {
 	c.Assert(height, Equals, int64(10))
 }
 
+func (s *BitcoinSuite) TestPing(c *C) {
+	c.Assert(s.client.Ping(), IsNil)
+}
+
 func (s *BitcoinSuite) TestGetAccount(c *C) {
 	acct, err := s.client.GetAccount("bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j")
 	c.Assert(err, IsNil)
@@ -556,6 +654,39 @@ func (s *BitcoinSuite) TestGetAccount(c *C) {
 	c.Assert(acct1.Coins[0].Amount, Equals, uint64(101000000000))
 }
 
+func (s *BitcoinSuite) TestGetAddressBalance(c *C) {
+	pkey := ttypes.GetRandomPubKey()
+	addr := s.client.GetAddress(pkey)
+	c.Assert(addr, Not(Equals), "")
+	balance, err := s.client.GetAddressBalance(addr)
+	c.Assert(err, IsNil)
+	c.Check(balance.IsZero(), Equals, true)
+	_, err = s.client.GetAddressBalance("")
+	c.Check(err, NotNil)
+
+	h1, _ := chainhash.NewHashFromStr("65379c0c158d96d37faf808fdeb65cb1cd5635fdbe0855ca3e92c6f709fe78f4")
+	h2, _ := chainhash.NewHashFromStr("819e927b0377feae269e5bcdca3b194eb4bae60d6b5c32004bd878326efd31e4")
+	blockMeta := NewBlockMeta("000000000000008a0da55afa8432af3b15c225cc7e04d32f0de912702dd9e2ae",
+		100,
+		"0000000000000068f0710c510e94bd29aa624745da43e32a1de887387306bfda")
+	blockMeta.AddUTXO(NewUnspentTransactionOutput(*h1, 0, 1.5, 100, pkey))
+	blockMeta.AddUTXO(NewUnspentTransactionOutput(*h1, 1, 2, 100, ttypes.GetRandomPubKey()))
+	spent := NewUnspentTransactionOutput(*h1, 2, 3, 100, pkey)
+	blockMeta.AddUTXO(spent)
+	blockMeta.SpendUTXO(spent.GetKey())
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	blockMeta1 := NewBlockMeta("0000000000000031c2229f160c0aa0c9530045b01331b90b5ac23f1f41ee2981",
+		101,
+		"000000001ab8a8484eb89f04b87d90eb88e2cbb2829e84eb36b966dcb28af90b")
+	blockMeta1.AddUTXO(NewUnspentTransactionOutput(*h2, 0, 0.25, 101, pkey))
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta1.Height, blockMeta1), IsNil)
+
+	// spent utxos and utxos of other addresses are not counted
+	balance, err = s.client.GetAddressBalance(addr)
+	c.Assert(err, IsNil)
+	c.Check(balance.Uint64(), Equals, uint64(175000000))
+}
+
 func (s *BitcoinSuite) TestOnObservedTxIn(c *C) {
 	pkey := ttypes.GetRandomPubKey()
 	txIn := types.TxIn{
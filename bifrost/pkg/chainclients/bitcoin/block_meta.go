@@ -3,6 +3,8 @@ package bitcoin
 import (
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"gitlab.com/thorchain/thornode/common"
 )
 
@@ -12,6 +14,9 @@ type BlockMeta struct {
 	Height                    int64                      `json:"height"`
 	BlockHash                 string                     `json:"block_hash"`
 	UnspentTransactionOutputs []UnspentTransactionOutput `json:"utxos"`
+	Transactions              []TransactionValue         `json:"transactions"`
+	// BlockFeeRate is the average fee rate (sats per vbyte) of the transactions in this block, it get computed when the block meta is saved
+	BlockFeeRate float64 `json:"block_fee_rate"`
 }
 
 // NewBlockMeta create a new instance of BlockMeta
@@ -34,6 +39,17 @@ func (b *BlockMeta) GetUTXOs(pubKey common.PubKey) []UnspentTransactionOutput {
 	return utxos
 }
 
+// CountUTXOs return the number of UTXOs that match the given pubkey and are unspent
+func (b *BlockMeta) CountUTXOs(pubKey common.PubKey) int {
+	count := 0
+	for _, item := range b.UnspentTransactionOutputs {
+		if item.VaultPubKey.Equals(pubKey) && !item.Spent {
+			count++
+		}
+	}
+	return count
+}
+
 // RemoveUTXO - remove a given UTXO from the storage ,because we already spent it
 func (b *BlockMeta) RemoveUTXO(key string) {
 	idxToDelete := -1
@@ -79,3 +95,45 @@ func (b *BlockMeta) UnspendUTXO(key string) {
 		break
 	}
 }
+
+// AddTransactionValue add the given transaction value to blockmeta
+func (b *BlockMeta) AddTransactionValue(value TransactionValue) {
+	for _, v := range b.Transactions {
+		if v.TxID == value.TxID {
+			return
+		}
+	}
+	b.Transactions = append(b.Transactions, value)
+}
+
+// TotalInputValue return the sum of the value of all UTXOs spent by the transactions in the block
+func (b *BlockMeta) TotalInputValue() sdk.Uint {
+	total := sdk.ZeroUint()
+	for _, v := range b.Transactions {
+		total = total.Add(sdk.NewUint(v.InputValue))
+	}
+	return total
+}
+
+// TotalOutputValue return the sum of the amounts sent by the transactions in the block
+func (b *BlockMeta) TotalOutputValue() sdk.Uint {
+	total := sdk.ZeroUint()
+	for _, v := range b.Transactions {
+		total = total.Add(sdk.NewUint(v.OutputValue))
+	}
+	return total
+}
+
+// calculateBlockFeeRate return the total fee (input - output) divided by the total virtual size of the transactions in the block
+func (b *BlockMeta) calculateBlockFeeRate() float64 {
+	var vSize int64
+	for _, v := range b.Transactions {
+		vSize += int64(v.VSize)
+	}
+	input := b.TotalInputValue()
+	output := b.TotalOutputValue()
+	if vSize == 0 || input.LTE(output) {
+		return 0.0
+	}
+	return float64(input.Sub(output).Uint64()) / float64(vSize)
+}
@@ -1,11 +1,18 @@
 package bitcoin
 
+import "gitlab.com/thorchain/thornode/common"
+
 // BlockMetaAccessor define methods need to access block meta storage
 type BlockMetaAccessor interface {
 	GetBlockMetas() ([]*BlockMeta, error)
 	GetBlockMeta(height int64) (*BlockMeta, error)
 	SaveBlockMeta(height int64, blockMeta *BlockMeta) error
 	PruneBlockMeta(height int64) error
-	UpsertTransactionFee(fee float64, vSize int32) error
+	UpsertTransactionFee(fee float64, vSize int32, height int64) error
 	GetTransactionFee() (float64, int32, error)
+	GetMedianTransactionFeeRate() (float64, error)
+	Stats() (BlockMetaStats, error)
+	GetAllUTXOs(pubKey common.PubKey) ([]UnspentTransactionOutput, error)
+	CountUTXOsByPubKey(pubKey common.PubKey) (int, error)
+	GetUTXOPubKeys() (common.PubKeys, error)
 }
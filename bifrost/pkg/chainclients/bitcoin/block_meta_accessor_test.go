@@ -1,8 +1,13 @@
 package bitcoin
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/storage"
 	. "gopkg.in/check.v1"
@@ -20,7 +25,7 @@ func (s *BitcoinBlockMetaAccessorTestSuite) TestNewBlockMetaAccessor(c *C) {
 	memStorage := storage.NewMemStorage()
 	db, err := leveldb.Open(memStorage, nil)
 	c.Assert(err, IsNil)
-	dbBlockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db)
+	dbBlockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
 	c.Assert(err, IsNil)
 	c.Assert(dbBlockMetaAccessor, NotNil)
 }
@@ -29,7 +34,7 @@ func (s *BitcoinBlockMetaAccessorTestSuite) TestBlockMetaAccessor(c *C) {
 	memStorage := storage.NewMemStorage()
 	db, err := leveldb.Open(memStorage, nil)
 	c.Assert(err, IsNil)
-	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
 	c.Assert(err, IsNil)
 	c.Assert(blockMetaAccessor, NotNil)
 
@@ -44,6 +49,21 @@ func (s *BitcoinBlockMetaAccessorTestSuite) TestBlockMetaAccessor(c *C) {
 	bm, err := blockMetaAccessor.GetBlockMeta(blockMeta.Height)
 	c.Assert(err, IsNil)
 	c.Assert(bm, NotNil)
+	c.Assert(bm.BlockFeeRate, Equals, 0.0)
+
+	// fee rate get computed when block meta is saved
+	blockMeta.AddTransactionValue(TransactionValue{
+		TxID:        thorchain.GetRandomTxHash().String(),
+		InputValue:  100000,
+		OutputValue: 90000,
+		VSize:       250,
+	})
+	c.Assert(blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	bm, err = blockMetaAccessor.GetBlockMeta(blockMeta.Height)
+	c.Assert(err, IsNil)
+	c.Assert(bm.BlockFeeRate, Equals, 40.0)
+	c.Assert(bm.TotalInputValue().Uint64(), Equals, uint64(100000))
+	c.Assert(bm.TotalOutputValue().Uint64(), Equals, uint64(90000))
 
 	nbm, err := blockMetaAccessor.GetBlockMeta(1024)
 	c.Assert(err, IsNil)
@@ -66,9 +86,350 @@ func (s *BitcoinBlockMetaAccessorTestSuite) TestBlockMetaAccessor(c *C) {
 	c.Assert(fee, Equals, 0.0)
 	c.Assert(vSize, Equals, int32(0))
 	// upsert transaction fee
-	c.Assert(blockMetaAccessor.UpsertTransactionFee(1.0, 1), IsNil)
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(1.0, 1, 1), IsNil)
 	fee, vSize, err = blockMetaAccessor.GetTransactionFee()
 	c.Assert(err, IsNil)
 	c.Assert(fee, Equals, 1.0)
 	c.Assert(vSize, Equals, int32(1))
 }
+
+func (s *BitcoinBlockMetaAccessorTestSuite) TestCompaction(c *C) {
+	memStorage := storage.NewMemStorage()
+	db, err := leveldb.Open(memStorage, nil)
+	c.Assert(err, IsNil)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, 0, DefaultTransactionFeeSamples)
+	c.Assert(err, IsNil)
+	c.Assert(blockMetaAccessor.compactionInterval, Equals, int64(DefaultCompactionIntervalBlocks))
+
+	blockMetaAccessor, err = NewLevelDBBlockMetaAccessor(db, 10, DefaultTransactionFeeSamples)
+	c.Assert(err, IsNil)
+	c.Assert(blockMetaAccessor.CompactRange(), IsNil)
+	for i := 0; i < 32; i++ {
+		bm := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(i), thorchain.GetRandomTxHash().String())
+		c.Assert(blockMetaAccessor.SaveBlockMeta(bm.Height, bm), IsNil)
+	}
+	c.Assert(blockMetaAccessor.PruneBlockMeta(5), IsNil)
+	c.Assert(blockMetaAccessor.deletedRecords, Equals, int64(5))
+	// exceed the threshold , storage get compacted and counter reset
+	c.Assert(blockMetaAccessor.PruneBlockMeta(20), IsNil)
+	c.Assert(blockMetaAccessor.deletedRecords, Equals, int64(0))
+	blockMetas, err := blockMetaAccessor.GetBlockMetas()
+	c.Assert(err, IsNil)
+	c.Assert(blockMetas, HasLen, 12)
+}
+
+// failingStorage is a leveldb storage that fails all the writes once fail is set
+type failingStorage struct {
+	storage.Storage
+	fail bool
+}
+
+func (s *failingStorage) Create(fd storage.FileDesc) (storage.Writer, error) {
+	w, err := s.Storage.Create(fd)
+	if err != nil {
+		return nil, err
+	}
+	return &failingWriter{Writer: w, s: s}, nil
+}
+
+type failingWriter struct {
+	storage.Writer
+	s *failingStorage
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.s.fail {
+		return 0, errors.New("injected write failure")
+	}
+	return w.Writer.Write(p)
+}
+
+func (s *BitcoinBlockMetaAccessorTestSuite) TestTransactionFeeHistory(c *C) {
+	memStorage := storage.NewMemStorage()
+	db, err := leveldb.Open(memStorage, nil)
+	c.Assert(err, IsNil)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, 0)
+	c.Assert(err, IsNil)
+	c.Assert(blockMetaAccessor.feeSamples, Equals, int64(DefaultTransactionFeeSamples))
+	blockMetaAccessor, err = NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, 4)
+	c.Assert(err, IsNil)
+	c.Assert(blockMetaAccessor.getTransactionFeeKey(1024), Equals, TransactionFeeKey+"1024")
+
+	rate, err := blockMetaAccessor.GetMedianTransactionFeeRate()
+	c.Assert(err, NotNil)
+	c.Assert(rate, Equals, 0.0)
+
+	// odd number of samples, the fee rate in the middle is returned
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0003, 100, 9), IsNil)
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0001, 100, 10), IsNil)
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0004, 200, 11), IsNil)
+	rate, err = blockMetaAccessor.GetMedianTransactionFeeRate()
+	c.Assert(err, IsNil)
+	c.Check(math.Abs(rate-200) < 1e-6, Equals, true, Commentf("rate: %f", rate))
+	// the most recent sample by block height , even though 9 sorts after 11 lexically
+	fee, vSize, err := blockMetaAccessor.GetTransactionFee()
+	c.Assert(err, IsNil)
+	c.Check(fee, Equals, 0.0004)
+	c.Check(vSize, Equals, int32(200))
+
+	// even number of samples, the two fee rates in the middle are averaged
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0005, 100, 12), IsNil)
+	rate, err = blockMetaAccessor.GetMedianTransactionFeeRate()
+	c.Assert(err, IsNil)
+	c.Check(math.Abs(rate-250) < 1e-6, Equals, true, Commentf("rate: %f", rate))
+
+	// upsert at the same block height overwrite the sample
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0002, 100, 12), IsNil)
+	rate, err = blockMetaAccessor.GetMedianTransactionFeeRate()
+	c.Assert(err, IsNil)
+	c.Check(math.Abs(rate-200) < 1e-6, Equals, true, Commentf("rate: %f", rate))
+
+	// only the most recent samples are kept after prune , the fee saved by earlier versions is removed as well
+	c.Assert(db.Put([]byte(TransactionFeeKey), []byte(`{"fee":1.0,"v_size":1}`), nil), IsNil)
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0006, 100, 13), IsNil)
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(0.0007, 100, 14), IsNil)
+	c.Assert(blockMetaAccessor.PruneBlockMeta(1), IsNil)
+	fees, err := blockMetaAccessor.getTransactionFees()
+	c.Assert(err, IsNil)
+	c.Assert(fees, HasLen, 4)
+	for i, f := range fees {
+		c.Check(f.Height, Equals, int64(11+i))
+	}
+	exist, err := db.Has([]byte(TransactionFeeKey), nil)
+	c.Assert(err, IsNil)
+	c.Check(exist, Equals, false)
+}
+
+func (s *BitcoinBlockMetaAccessorTestSuite) TestPruneBlockMetaFailure(c *C) {
+	failStorage := &failingStorage{Storage: storage.NewMemStorage()}
+	db, err := leveldb.Open(failStorage, nil)
+	c.Assert(err, IsNil)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
+	c.Assert(err, IsNil)
+	for i := 0; i < 100; i++ {
+		bm := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(i), thorchain.GetRandomTxHash().String())
+		c.Assert(blockMetaAccessor.SaveBlockMeta(bm.Height, bm), IsNil)
+	}
+
+	// the deletes are written in one batch, when the write fails none of the block metas are removed
+	failStorage.fail = true
+	c.Assert(blockMetaAccessor.PruneBlockMeta(50), NotNil)
+	c.Assert(blockMetaAccessor.deletedRecords, Equals, int64(0))
+	blockMetas, err := blockMetaAccessor.GetBlockMetas()
+	c.Assert(err, IsNil)
+	c.Assert(blockMetas, HasLen, 100)
+	for i := int64(0); i < 100; i++ {
+		bm, err := blockMetaAccessor.GetBlockMeta(i)
+		c.Assert(err, IsNil)
+		c.Assert(bm, NotNil, Commentf("%d", i))
+	}
+}
+
+func (s *BitcoinBlockMetaAccessorTestSuite) TestStats(c *C) {
+	memStorage := storage.NewMemStorage()
+	db, err := leveldb.Open(memStorage, nil)
+	c.Assert(err, IsNil)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
+	c.Assert(err, IsNil)
+
+	stats, err := blockMetaAccessor.Stats()
+	c.Assert(err, IsNil)
+	c.Assert(stats, Equals, BlockMetaStats{})
+
+	// transaction fee should not be counted
+	c.Assert(blockMetaAccessor.UpsertTransactionFee(1.0, 1, 1), IsNil)
+	pubKey := thorchain.GetRandomPubKey()
+	var sizeBytes int64
+	for i := 100; i < 110; i++ {
+		bm := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(i), thorchain.GetRandomTxHash().String())
+		for n := 0; n < i%3; n++ {
+			bm.AddUTXO(NewUnspentTransactionOutput(chainhash.Hash{}, uint32(n), 1.0, bm.Height, pubKey))
+		}
+		c.Assert(blockMetaAccessor.SaveBlockMeta(bm.Height, bm), IsNil)
+		buf, err := json.Marshal(bm)
+		c.Assert(err, IsNil)
+		sizeBytes += int64(len(blockMetaAccessor.getBlockMetaKey(bm.Height)) + len(buf))
+	}
+
+	stats, err = blockMetaAccessor.Stats()
+	c.Assert(err, IsNil)
+	c.Check(stats.TotalBlockMetas, Equals, int64(10))
+	c.Check(stats.TotalUTXOs, Equals, int64(10))
+	c.Check(stats.OldestHeight, Equals, int64(100))
+	c.Check(stats.NewestHeight, Equals, int64(109))
+	c.Check(stats.SizeBytes, Equals, sizeBytes)
+}
+
+func (s *BitcoinBlockMetaAccessorTestSuite) TestGetAllUTXOs(c *C) {
+	memStorage := storage.NewMemStorage()
+	db, err := leveldb.Open(memStorage, nil)
+	c.Assert(err, IsNil)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
+	c.Assert(err, IsNil)
+
+	pubKey := thorchain.GetRandomPubKey()
+	utxos, err := blockMetaAccessor.GetAllUTXOs(pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(utxos, HasLen, 0)
+	count, err := blockMetaAccessor.CountUTXOsByPubKey(pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+
+	otherPubKey := thorchain.GetRandomPubKey()
+	for i := 8; i < 12; i++ {
+		bm := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(i), thorchain.GetRandomTxHash().String())
+		bm.AddUTXO(NewUnspentTransactionOutput(chainhash.Hash{}, 0, float64(i), bm.Height, pubKey))
+		bm.AddUTXO(NewUnspentTransactionOutput(chainhash.Hash{}, 1, 1.0, bm.Height, otherPubKey))
+		spent := NewUnspentTransactionOutput(chainhash.Hash{}, 2, 1.0, bm.Height, pubKey)
+		bm.AddUTXO(spent)
+		bm.SpendUTXO(spent.GetKey())
+		c.Assert(blockMetaAccessor.SaveBlockMeta(bm.Height, bm), IsNil)
+	}
+
+	utxos, err = blockMetaAccessor.GetAllUTXOs(pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(utxos, HasLen, 4)
+	for i, utxo := range utxos {
+		c.Check(utxo.BlockHeight, Equals, int64(8+i))
+		c.Check(utxo.Value, Equals, float64(8+i))
+		c.Check(utxo.VaultPubKey.Equals(pubKey), Equals, true)
+		c.Check(utxo.Spent, Equals, false)
+	}
+	count, err = blockMetaAccessor.CountUTXOsByPubKey(pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 4)
+	count, err = blockMetaAccessor.CountUTXOsByPubKey(otherPubKey)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 4)
+
+	pubKeys, err := blockMetaAccessor.GetUTXOPubKeys()
+	c.Assert(err, IsNil)
+	c.Assert(pubKeys, HasLen, 2)
+	c.Check(pubKeys.Contains(pubKey), Equals, true)
+	c.Check(pubKeys.Contains(otherPubKey), Equals, true)
+
+	// once all the outputs in a block meta are spent, it is removed from the index
+	bm, err := blockMetaAccessor.GetBlockMeta(8)
+	c.Assert(err, IsNil)
+	for _, utxo := range bm.GetUTXOs(pubKey) {
+		bm.SpendUTXO(utxo.GetKey())
+	}
+	c.Assert(blockMetaAccessor.SaveBlockMeta(bm.Height, bm), IsNil)
+	exist, err := db.Has([]byte(blockMetaAccessor.getUTXOIndexKey(pubKey, 8)), nil)
+	c.Assert(err, IsNil)
+	c.Check(exist, Equals, false)
+	count, err = blockMetaAccessor.CountUTXOsByPubKey(pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 3)
+
+	// block metas saved before the index existed are indexed when the storage is opened
+	c.Assert(db.Delete([]byte(UTXOIndexBuiltKey), nil), IsNil)
+	c.Assert(db.Delete([]byte(blockMetaAccessor.getUTXOIndexKey(pubKey, 9)), nil), IsNil)
+	blockMetaAccessor, err = NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
+	c.Assert(err, IsNil)
+	count, err = blockMetaAccessor.CountUTXOsByPubKey(pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 3)
+
+	// corrupted block meta
+	c.Assert(db.Put([]byte(blockMetaAccessor.getBlockMetaKey(10)), []byte("bogus"), nil), IsNil)
+	_, err = blockMetaAccessor.GetAllUTXOs(pubKey)
+	c.Assert(err, NotNil)
+	_, err = blockMetaAccessor.CountUTXOsByPubKey(pubKey)
+	c.Assert(err, NotNil)
+}
+
+func benchmarkBlockMetaRead(b *testing.B, compact bool) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, 1<<62, DefaultTransactionFeeSamples)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 10000; i++ {
+		bm := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(i), thorchain.GetRandomTxHash().String())
+		if err := blockMetaAccessor.SaveBlockMeta(bm.Height, bm); err != nil {
+			b.Fatal(err)
+		}
+	}
+	// leave tombstones behind for most of the block metas
+	if err := blockMetaAccessor.PruneBlockMeta(9900); err != nil {
+		b.Fatal(err)
+	}
+	if compact {
+		if err := blockMetaAccessor.CompactRange(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := blockMetaAccessor.GetBlockMetas(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBlockMetaReadBeforeCompaction(b *testing.B) {
+	benchmarkBlockMetaRead(b, false)
+}
+
+func BenchmarkBlockMetaReadAfterCompaction(b *testing.B) {
+	benchmarkBlockMetaRead(b, true)
+}
+
+// pruneBlockMetaOneByOne prune the block metas deleting them one at a time, it is the baseline of BenchmarkPruneBlockMeta
+func pruneBlockMetaOneByOne(t *LevelDBBlockMetaAccessor, height int64) error {
+	blockMetas, err := t.GetBlockMetas()
+	if err != nil {
+		return err
+	}
+	for _, bm := range blockMetas {
+		if bm.Height < height {
+			if err := t.db.Delete([]byte(t.getBlockMetaKey(bm.Height)), nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func benchmarkPruneBlockMeta(b *testing.B, prune func(t *LevelDBBlockMetaAccessor, height int64) error) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		db, err := leveldb.Open(storage.NewMemStorage(), nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(db, 1<<62, DefaultTransactionFeeSamples)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for h := 0; h < 10000; h++ {
+			bm := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(h), thorchain.GetRandomTxHash().String())
+			if err := blockMetaAccessor.SaveBlockMeta(bm.Height, bm); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StartTimer()
+		if err := prune(blockMetaAccessor, 10000); err != nil {
+			b.Fatal(err)
+		}
+		b.StopTimer()
+		if err := db.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPruneBlockMetaBatch(b *testing.B) {
+	benchmarkPruneBlockMeta(b, func(t *LevelDBBlockMetaAccessor, height int64) error {
+		return t.PruneBlockMeta(height)
+	})
+}
+
+func BenchmarkPruneBlockMetaOneByOne(b *testing.B) {
+	benchmarkPruneBlockMeta(b, pruneBlockMetaOneByOne)
+}
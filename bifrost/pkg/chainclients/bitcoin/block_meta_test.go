@@ -75,6 +75,7 @@ func (b *BlockMetaTestSuite) TestBlockMeta(c *C) {
 	utxos = blockMeta.GetUTXOs(pkey)
 	c.Assert(err, IsNil)
 	c.Assert(len(utxos), Equals, 0)
+	c.Assert(blockMeta.CountUTXOs(pkey), Equals, 0)
 
 	// mark as unspent
 	utxo = blockMeta.UnspentTransactionOutputs[0]
@@ -87,4 +88,40 @@ func (b *BlockMetaTestSuite) TestBlockMeta(c *C) {
 	utxos = blockMeta.GetUTXOs(pkey)
 	c.Assert(err, IsNil)
 	c.Assert(len(utxos), Equals, 1)
+	c.Assert(blockMeta.CountUTXOs(pkey), Equals, 1)
+	c.Assert(blockMeta.CountUTXOs(thorchain.GetRandomPubKey()), Equals, 0)
+}
+
+func (b *BlockMetaTestSuite) TestBlockMetaTransactionValue(c *C) {
+	blockMeta := NewBlockMeta("00000000000000d9cba4b81d1f8fb5cecd54e4ec3104763ba937aa7692a86dc5",
+		1722479,
+		"00000000000000ca7a4633264b9989355e9709f9e9da19506b0f636cc435dc8f")
+	c.Assert(blockMeta.TotalInputValue().IsZero(), Equals, true)
+	c.Assert(blockMeta.TotalOutputValue().IsZero(), Equals, true)
+	c.Assert(blockMeta.calculateBlockFeeRate(), Equals, 0.0)
+
+	blockMeta.AddTransactionValue(TransactionValue{
+		TxID:        "31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f",
+		InputValue:  100000,
+		OutputValue: 95000,
+		VSize:       200,
+	})
+	// add again and check still unique
+	blockMeta.AddTransactionValue(TransactionValue{
+		TxID:        "31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f",
+		InputValue:  100000,
+		OutputValue: 95000,
+		VSize:       200,
+	})
+	blockMeta.AddTransactionValue(TransactionValue{
+		TxID:        "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+		InputValue:  50000,
+		OutputValue: 45000,
+		VSize:       300,
+	})
+	c.Assert(blockMeta.Transactions, HasLen, 2)
+	c.Assert(blockMeta.TotalInputValue().Uint64(), Equals, uint64(150000))
+	c.Assert(blockMeta.TotalOutputValue().Uint64(), Equals, uint64(140000))
+	// 10000 sats / 500 vbytes
+	c.Assert(blockMeta.calculateBlockFeeRate(), Equals, 20.0)
 }
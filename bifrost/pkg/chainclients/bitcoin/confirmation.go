@@ -0,0 +1,78 @@
+package bitcoin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+)
+
+// GetTxID return the hash of the given signed tx payload , which is the tx id once it get broadcast
+func (c *Client) GetTxID(payload []byte) (string, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewBuffer(payload)); err != nil {
+		return "", fmt.Errorf("fail to deserialize payload: %w", err)
+	}
+	return tx.TxHash().String(), nil
+}
+
+// CheckTxConfirmations return whether the given tx has at least minConfirmations confirmations , and the number of
+// confirmations it has. stypes.ErrTxNotFound is only returned when the node doesn't know about the tx , and some of
+// its inputs have been spent by another tx , so the tx can never make it to the chain
+func (c *Client) CheckTxConfirmations(txID string, minConfirmations int64) (bool, int, error) {
+	txHash, err := chainhash.NewHashFromStr(txID)
+	if err != nil {
+		return false, 0, fmt.Errorf("fail to parse tx hash(%s): %w", txID, err)
+	}
+	result, err := c.client.GetRawTransactionVerbose(txHash)
+	if err != nil {
+		if !isTxNotFound(err) {
+			return false, 0, fmt.Errorf("fail to get tx(%s): %w", txID, err)
+		}
+		spent, spentErr := c.isTrackedTxInputsSpent(txID)
+		if spentErr != nil {
+			return false, 0, fmt.Errorf("tx(%s) is not found, fail to check its inputs: %w", txID, spentErr)
+		}
+		if !spent {
+			// the tx can still make it to the chain , it will be re-broadcast if it is tracked
+			return false, 0, fmt.Errorf("tx(%s) is not found, but its inputs are not spent: %w", txID, err)
+		}
+		return false, 0, fmt.Errorf("tx(%s): %w", txID, stypes.ErrTxNotFound)
+	}
+	return int64(result.Confirmations) >= minConfirmations, int(result.Confirmations), nil
+}
+
+// isTxNotFound return whether the given error means the node doesn't know about the tx , a node that run without txindex
+// can't tell , so it is not treated as not found
+func isTxNotFound(err error) bool {
+	var rpcErr *btcjson.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return rpcErr.Code == btcjson.ErrRPCNoTxInfo && !strings.Contains(strings.ToLower(rpcErr.Message), "txindex")
+}
+
+// isTrackedTxInputsSpent return whether any of the inputs of the given tracked tx has been spent , it returns an error
+// when the tx is not tracked , as the inputs of the tx are unknown
+func (c *Client) isTrackedTxInputsSpent(txID string) (bool, error) {
+	tx := c.getTrackedTx(txID)
+	if tx == nil {
+		return false, fmt.Errorf("tx(%s) is not tracked", txID)
+	}
+	for _, in := range tx.TxIn {
+		out, err := c.client.GetTxOut(&in.PreviousOutPoint.Hash, in.PreviousOutPoint.Index, true)
+		if err != nil {
+			return false, fmt.Errorf("fail to get tx out(%s): %w", in.PreviousOutPoint, err)
+		}
+		if out == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,74 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	. "gopkg.in/check.v1"
+
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+)
+
+func (s *BitcoinSuite) TestCheckTxConfirmations(c *C) {
+	_, _, err := s.client.CheckTxConfirmations("whatever", 1)
+	c.Assert(err, NotNil)
+
+	confirmed, confirmations, err := s.client.CheckTxConfirmations("31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f", 1)
+	c.Assert(err, IsNil)
+	c.Assert(confirmed, Equals, true)
+	c.Assert(confirmations, Equals, 211)
+
+	// not enough confirmations
+	confirmed, confirmations, err = s.client.CheckTxConfirmations("31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f", 212)
+	c.Assert(err, IsNil)
+	c.Assert(confirmed, Equals, false)
+	c.Assert(confirmations, Equals, 211)
+
+	// tx is not found , and it is not tracked , so it's unknown whether it can still make it to the chain
+	droppedTx := wire.NewMsgTx(wire.TxVersion)
+	droppedTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	droppedTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	s.stuckTxID = droppedTx.TxHash().String()
+	_, _, err = s.client.CheckTxConfirmations(s.stuckTxID, 1)
+	c.Assert(err, NotNil)
+	c.Assert(errors.Is(err, stypes.ErrTxNotFound), Equals, false)
+
+	// tx is not found , and its inputs have been spent by another tx
+	s.client.trackTx(s.stuckTxID, droppedTx)
+	_, _, err = s.client.CheckTxConfirmations(s.stuckTxID, 1)
+	c.Assert(errors.Is(err, stypes.ErrTxNotFound), Equals, true)
+	s.client.untrackTx(s.stuckTxID)
+
+	// tx is not found , but its inputs are not spent , it can still make it to the chain
+	stuckTx := wire.NewMsgTx(wire.TxVersion)
+	stuckTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 1), nil, nil))
+	stuckTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	s.stuckTxID = stuckTx.TxHash().String()
+	s.client.trackTx(s.stuckTxID, stuckTx)
+	_, _, err = s.client.CheckTxConfirmations(s.stuckTxID, 1)
+	c.Assert(err, NotNil)
+	c.Assert(errors.Is(err, stypes.ErrTxNotFound), Equals, false)
+	s.client.untrackTx(s.stuckTxID)
+	s.stuckTxID = ""
+}
+
+func (s *BitcoinSuite) TestGetTxID(c *C) {
+	_, err := s.client.GetTxID([]byte("whatever"))
+	c.Assert(err, NotNil)
+
+	content, err := ioutil.ReadFile("../../../../test/fixtures/btc/tx-5b08-raw.json")
+	c.Assert(err, IsNil)
+	var raw struct {
+		Result string `json:"result"`
+	}
+	c.Assert(json.Unmarshal(content, &raw), IsNil)
+	payload, err := hex.DecodeString(raw.Result)
+	c.Assert(err, IsNil)
+	txID, err := s.client.GetTxID(payload)
+	c.Assert(err, IsNil)
+	c.Assert(txID, Equals, "31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f")
+}
@@ -2,32 +2,119 @@ package bitcoin
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 // PrefixUTXOStorage declares prefix to use in leveldb to avoid conflicts
 const (
 	TransactionFeeKey = "transactionfee-"
 	PrefixBlocMeta    = `blockmeta-`
+	// PrefixUTXOIndex is the prefix of the pubkey index, it maps a vault pubkey to the heights of the block metas that
+	// hold unspent outputs of it, keys are utxoindex-<pubkey>-<height>
+	PrefixUTXOIndex = `utxoindex-`
+	// UTXOIndexBuiltKey marks the pubkey index has been built for the block metas saved before the index existed
+	UTXOIndexBuiltKey = `utxoindexbuilt`
+	// DefaultCompactionIntervalBlocks is the number of deleted block metas after which the storage get compacted,
+	// it is used when CompactionIntervalBlocks is not set in the block scanner configuration
+	DefaultCompactionIntervalBlocks = 1000
+	// DefaultTransactionFeeSamples is the number of transaction fee samples kept in storage, roughly a day of bitcoin blocks,
+	// it is used when TransactionFeeSamples is not set in the block scanner configuration
+	DefaultTransactionFeeSamples = 144
 )
 
+var errNoTransactionFee = errors.New("no transaction fee in storage")
+
 // LevelDBBlockMetaAccessor struct
 type LevelDBBlockMetaAccessor struct {
-	db *leveldb.DB
+	db                 *leveldb.DB
+	compactionInterval int64
+	deletedRecords     int64
+	feeSamples         int64
 }
 
 // NewLevelDBBlockMetaAccessor creates a new level db backed BlockMeta accessor
-func NewLevelDBBlockMetaAccessor(db *leveldb.DB) (*LevelDBBlockMetaAccessor, error) {
-	return &LevelDBBlockMetaAccessor{db: db}, nil
+// compactionInterval is the number of block metas need to be deleted before the underlying storage get compacted,
+// when it is not positive , DefaultCompactionIntervalBlocks will be used instead
+// feeSamples is the number of transaction fee samples to keep, when it is not positive , DefaultTransactionFeeSamples will be used instead
+func NewLevelDBBlockMetaAccessor(db *leveldb.DB, compactionInterval, feeSamples int64) (*LevelDBBlockMetaAccessor, error) {
+	if compactionInterval <= 0 {
+		compactionInterval = DefaultCompactionIntervalBlocks
+	}
+	if feeSamples <= 0 {
+		feeSamples = DefaultTransactionFeeSamples
+	}
+	accessor := &LevelDBBlockMetaAccessor{
+		db:                 db,
+		compactionInterval: compactionInterval,
+		feeSamples:         feeSamples,
+	}
+	if err := accessor.buildUTXOIndex(); err != nil {
+		return nil, err
+	}
+	return accessor, nil
+}
+
+// buildUTXOIndex index the block metas saved before the pubkey index existed, it only runs once per storage
+func (t *LevelDBBlockMetaAccessor) buildUTXOIndex() error {
+	built, err := t.db.Has([]byte(UTXOIndexBuiltKey), nil)
+	if err != nil {
+		return fmt.Errorf("fail to check whether pubkey index has been built: %w", err)
+	}
+	if built {
+		return nil
+	}
+	batch := new(leveldb.Batch)
+	if err := t.forEachBlockMeta(func(blockMeta *BlockMeta) {
+		for _, pubKey := range getUnspentPubKeys(blockMeta) {
+			batch.Put([]byte(t.getUTXOIndexKey(pubKey, blockMeta.Height)), []byte{})
+		}
+	}); err != nil {
+		return fmt.Errorf("fail to build pubkey index: %w", err)
+	}
+	batch.Put([]byte(UTXOIndexBuiltKey), []byte{})
+	if err := t.db.Write(batch, nil); err != nil {
+		return fmt.Errorf("fail to save pubkey index: %w", err)
+	}
+	return nil
 }
 
 func (t *LevelDBBlockMetaAccessor) getBlockMetaKey(height int64) string {
 	return fmt.Sprintf(PrefixBlocMeta+"%d", height)
 }
 
+func (t *LevelDBBlockMetaAccessor) getTransactionFeeKey(height int64) string {
+	return fmt.Sprintf(TransactionFeeKey+"%d", height)
+}
+
+func (t *LevelDBBlockMetaAccessor) getUTXOIndexPrefix(pubKey common.PubKey) string {
+	return PrefixUTXOIndex + pubKey.String() + "-"
+}
+
+func (t *LevelDBBlockMetaAccessor) getUTXOIndexKey(pubKey common.PubKey, height int64) string {
+	return fmt.Sprintf("%s%d", t.getUTXOIndexPrefix(pubKey), height)
+}
+
+// getUnspentPubKeys return the distinct vault pubkeys that have unspent outputs in the given block meta
+func getUnspentPubKeys(blockMeta *BlockMeta) common.PubKeys {
+	var pubKeys common.PubKeys
+	for _, utxo := range blockMeta.UnspentTransactionOutputs {
+		if utxo.Spent || pubKeys.Contains(utxo.VaultPubKey) {
+			continue
+		}
+		pubKeys = append(pubKeys, utxo.VaultPubKey)
+	}
+	return pubKeys
+}
+
 // GetBlockMeta at given block height ,  when the requested block meta doesn't exist , it will return nil , thus caller need to double check it
 func (t *LevelDBBlockMetaAccessor) GetBlockMeta(height int64) (*BlockMeta, error) {
 	key := t.getBlockMetaKey(height)
@@ -49,14 +136,30 @@ func (t *LevelDBBlockMetaAccessor) GetBlockMeta(height int64) (*BlockMeta, error
 	return &blockMeta, nil
 }
 
-// SaveBlockMeta persistent the given BlockMeta into storage
+// SaveBlockMeta persistent the given BlockMeta into storage, the fee rate of the block will be computed before it get saved
 func (t *LevelDBBlockMetaAccessor) SaveBlockMeta(height int64, blockMeta *BlockMeta) error {
 	key := t.getBlockMetaKey(height)
+	blockMeta.BlockFeeRate = blockMeta.calculateBlockFeeRate()
 	buf, err := json.Marshal(blockMeta)
 	if err != nil {
 		return fmt.Errorf("fail to marshal block meta to json: %w", err)
 	}
-	return t.db.Put([]byte(key), buf, nil)
+	// the block meta and its pubkey index entries are written in one batch, so they don't get out of sync
+	batch := new(leveldb.Batch)
+	previous, err := t.GetBlockMeta(height)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		for _, pubKey := range getUnspentPubKeys(previous) {
+			batch.Delete([]byte(t.getUTXOIndexKey(pubKey, height)))
+		}
+	}
+	for _, pubKey := range getUnspentPubKeys(blockMeta) {
+		batch.Put([]byte(t.getUTXOIndexKey(pubKey, height)), []byte{})
+	}
+	batch.Put([]byte(key), buf)
+	return t.db.Write(batch, nil)
 }
 
 // GetBlockMetas returns all the block metas in storage
@@ -80,12 +183,115 @@ func (t *LevelDBBlockMetaAccessor) GetBlockMetas() ([]*BlockMeta, error) {
 	return blockMetas, nil
 }
 
+// GetAllUTXOs returns the unspent transaction outputs of the given pubkey, ordered by block height
+// only the block metas the pubkey index points to are read
+func (t *LevelDBBlockMetaAccessor) GetAllUTXOs(pubKey common.PubKey) ([]UnspentTransactionOutput, error) {
+	utxos := make([]UnspentTransactionOutput, 0)
+	if err := t.forEachIndexedBlockMeta(pubKey, func(blockMeta *BlockMeta) {
+		utxos = append(utxos, blockMeta.GetUTXOs(pubKey)...)
+	}); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].BlockHeight < utxos[j].BlockHeight
+	})
+	return utxos, nil
+}
+
+// CountUTXOsByPubKey returns the number of unspent transaction outputs of the given pubkey in storage
+func (t *LevelDBBlockMetaAccessor) CountUTXOsByPubKey(pubKey common.PubKey) (int, error) {
+	total := 0
+	if err := t.forEachIndexedBlockMeta(pubKey, func(blockMeta *BlockMeta) {
+		total += blockMeta.CountUTXOs(pubKey)
+	}); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetUTXOPubKeys returns the vault pubkeys that have unspent transaction outputs in storage
+func (t *LevelDBBlockMetaAccessor) GetUTXOPubKeys() (common.PubKeys, error) {
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixUTXOIndex)), nil)
+	defer iterator.Release()
+	var pubKeys common.PubKeys
+	for iterator.Next() {
+		key := strings.TrimPrefix(string(iterator.Key()), PrefixUTXOIndex)
+		idx := strings.LastIndex(key, "-")
+		if idx < 0 {
+			continue
+		}
+		pubKey, err := common.NewPubKey(key[:idx])
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse pubkey of index key(%s): %w", iterator.Key(), err)
+		}
+		if !pubKeys.Contains(pubKey) {
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate pubkey index: %w", err)
+	}
+	return pubKeys, nil
+}
+
+// forEachIndexedBlockMeta call fn with each block meta the pubkey index lists for the given pubkey
+func (t *LevelDBBlockMetaAccessor) forEachIndexedBlockMeta(pubKey common.PubKey, fn func(blockMeta *BlockMeta)) error {
+	prefix := t.getUTXOIndexPrefix(pubKey)
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	defer iterator.Release()
+	var heights []int64
+	for iterator.Next() {
+		height, err := strconv.ParseInt(strings.TrimPrefix(string(iterator.Key()), prefix), 10, 64)
+		if err != nil {
+			return fmt.Errorf("fail to parse block height of index key(%s): %w", iterator.Key(), err)
+		}
+		heights = append(heights, height)
+	}
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("fail to iterate pubkey index: %w", err)
+	}
+	for _, height := range heights {
+		blockMeta, err := t.GetBlockMeta(height)
+		if err != nil {
+			return err
+		}
+		if blockMeta == nil {
+			continue
+		}
+		fn(blockMeta)
+	}
+	return nil
+}
+
+// forEachBlockMeta iterate all the block metas in storage and call fn with each of them
+func (t *LevelDBBlockMetaAccessor) forEachBlockMeta(fn func(blockMeta *BlockMeta)) error {
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixBlocMeta)), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		buf := iterator.Value()
+		if len(buf) == 0 {
+			continue
+		}
+		var blockMeta BlockMeta
+		if err := json.Unmarshal(buf, &blockMeta); err != nil {
+			return fmt.Errorf("fail to unmarshal block meta: %w", err)
+		}
+		fn(&blockMeta)
+	}
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("fail to iterate block metas: %w", err)
+	}
+	return nil
+}
+
 // PruneBlockMeta remove all block meta that is older than the given block height
 // with exception, if there are unspent transaction output in it , then the block meta will not be removed
+// transaction fee samples beyond the most recent feeSamples are removed as well
+// The block metas are deleted in one batch, so either all of them are removed or none of them
 func (t *LevelDBBlockMetaAccessor) PruneBlockMeta(height int64) error {
 	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixBlocMeta)), nil)
 	defer iterator.Release()
-	targetToDelete := make([]string, 0)
+	batch := new(leveldb.Batch)
 	for iterator.Next() {
 		buf := iterator.Value()
 		if len(buf) == 0 {
@@ -102,40 +308,172 @@ func (t *LevelDBBlockMetaAccessor) PruneBlockMeta(height int64) error {
 			}
 		}
 		if blockMeta.Height < height && unspents == 0 {
-			targetToDelete = append(targetToDelete, t.getBlockMetaKey(blockMeta.Height))
+			batch.Delete([]byte(t.getBlockMetaKey(blockMeta.Height)))
 		}
 	}
-
-	for _, key := range targetToDelete {
-		if err := t.db.Delete([]byte(key), nil); err != nil {
-			return fmt.Errorf("fail to delete block meta with key(%s) from storage: %w", key, err)
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("fail to iterate block metas: %w", err)
+	}
+	if err := t.pruneTransactionFees(batch); err != nil {
+		return err
+	}
+	if batch.Len() > 0 {
+		if err := t.db.Write(batch, nil); err != nil {
+			return fmt.Errorf("fail to delete %d block metas from storage: %w", batch.Len(), err)
+		}
+	}
+	// deleted records leave tombstones behind in leveldb , compact the storage once enough of them have been accumulated
+	t.deletedRecords += int64(batch.Len())
+	if t.deletedRecords >= t.compactionInterval {
+		if err := t.CompactRange(); err != nil {
+			return fmt.Errorf("fail to compact block meta storage: %w", err)
 		}
+		t.deletedRecords = 0
 	}
 	return nil
 }
 
-// UpsertTransactionFee update the transaction fee in storage
-func (t *LevelDBBlockMetaAccessor) UpsertTransactionFee(fee float64, vSize int32) error {
+// BlockMetaStats contains the statistics of the block metas in storage
+type BlockMetaStats struct {
+	TotalBlockMetas int64 `json:"total_block_metas"`
+	TotalUTXOs      int64 `json:"total_utxos"`
+	OldestHeight    int64 `json:"oldest_height"`
+	NewestHeight    int64 `json:"newest_height"`
+	SizeBytes       int64 `json:"size_bytes"`
+}
+
+// Stats iterate all the block metas in storage once and return the statistics of them
+// SizeBytes is the total size of the keys and values of the block metas
+func (t *LevelDBBlockMetaAccessor) Stats() (BlockMetaStats, error) {
+	var stats BlockMetaStats
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixBlocMeta)), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		buf := iterator.Value()
+		if len(buf) == 0 {
+			continue
+		}
+		var blockMeta BlockMeta
+		if err := json.Unmarshal(buf, &blockMeta); err != nil {
+			return BlockMetaStats{}, fmt.Errorf("fail to unmarshal block meta: %w", err)
+		}
+		if stats.TotalBlockMetas == 0 || blockMeta.Height < stats.OldestHeight {
+			stats.OldestHeight = blockMeta.Height
+		}
+		if stats.TotalBlockMetas == 0 || blockMeta.Height > stats.NewestHeight {
+			stats.NewestHeight = blockMeta.Height
+		}
+		stats.TotalBlockMetas++
+		stats.TotalUTXOs += int64(len(blockMeta.UnspentTransactionOutputs))
+		stats.SizeBytes += int64(len(iterator.Key()) + len(buf))
+	}
+	if err := iterator.Error(); err != nil {
+		return BlockMetaStats{}, fmt.Errorf("fail to iterate block metas: %w", err)
+	}
+	return stats, nil
+}
+
+// CompactRange compact the entire key space of the underlying storage
+func (t *LevelDBBlockMetaAccessor) CompactRange() error {
+	return t.db.CompactRange(util.Range{})
+}
+
+// UpsertTransactionFee save the transaction fee of the given block height in storage, a sample already saved at the
+// same block height will be overwritten
+func (t *LevelDBBlockMetaAccessor) UpsertTransactionFee(fee float64, vSize int32, height int64) error {
 	transactionFee := TransactionFee{
-		Fee:   fee,
-		VSize: vSize,
+		Fee:    fee,
+		VSize:  vSize,
+		Height: height,
 	}
 	buf, err := json.Marshal(transactionFee)
 	if err != nil {
 		return fmt.Errorf("fail to marshal transaction fee struct to json: %w", err)
 	}
-	return t.db.Put([]byte(TransactionFeeKey), buf, nil)
+	return t.db.Put([]byte(t.getTransactionFeeKey(height)), buf, nil)
 }
 
-// GetTransactionFee from db
+// GetTransactionFee return the most recent transaction fee sample in storage
 func (t *LevelDBBlockMetaAccessor) GetTransactionFee() (float64, int32, error) {
-	buf, err := t.db.Get([]byte(TransactionFeeKey), nil)
+	fees, err := t.getTransactionFees()
+	if err != nil {
+		return 0.0, 0, err
+	}
+	if len(fees) == 0 {
+		return 0.0, 0, fmt.Errorf("fail to get transaction fee from storage: %w", errNoTransactionFee)
+	}
+	latest := fees[len(fees)-1]
+	return latest.Fee, latest.VSize, nil
+}
+
+// GetMedianTransactionFeeRate return the median fee rate (sats per vbyte) of the transaction fee samples in storage,
+// when there are even number of samples the fee rates of the two middle samples are averaged.
+// Samples without virtual size don't have a fee rate, thus they are ignored
+func (t *LevelDBBlockMetaAccessor) GetMedianTransactionFeeRate() (float64, error) {
+	fees, err := t.getTransactionFees()
+	if err != nil {
+		return 0.0, err
+	}
+	rates := make([]float64, 0, len(fees))
+	for _, fee := range fees {
+		if fee.VSize <= 0 {
+			continue
+		}
+		rates = append(rates, fee.Fee*common.One/float64(fee.VSize))
+	}
+	if len(rates) == 0 {
+		return 0.0, fmt.Errorf("fail to get transaction fee from storage: %w", errNoTransactionFee)
+	}
+	sort.Float64s(rates)
+	mid := len(rates) / 2
+	if len(rates)%2 == 1 {
+		return rates[mid], nil
+	}
+	return (rates[mid-1] + rates[mid]) / 2, nil
+}
+
+// getTransactionFees return all the transaction fee samples in storage, ordered by block height
+func (t *LevelDBBlockMetaAccessor) getTransactionFees() ([]TransactionFee, error) {
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(TransactionFeeKey)), nil)
+	defer iterator.Release()
+	fees := make([]TransactionFee, 0)
+	for iterator.Next() {
+		buf := iterator.Value()
+		// the single transaction fee saved by earlier versions is not a sample of any block height
+		if len(buf) == 0 || string(iterator.Key()) == TransactionFeeKey {
+			continue
+		}
+		var transactionFee TransactionFee
+		if err := json.Unmarshal(buf, &transactionFee); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal transaction fee: %w", err)
+		}
+		fees = append(fees, transactionFee)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate transaction fees: %w", err)
+	}
+	// keys are sorted lexically, which is not the same as block height order
+	sort.SliceStable(fees, func(i, j int) bool {
+		return fees[i].Height < fees[j].Height
+	})
+	return fees, nil
+}
+
+// pruneTransactionFees add the transaction fee samples older than the most recent feeSamples to the given batch
+func (t *LevelDBBlockMetaAccessor) pruneTransactionFees(batch *leveldb.Batch) error {
+	fees, err := t.getTransactionFees()
 	if err != nil {
-		return 0.0, 0, fmt.Errorf("fail to get transaction fee from storage: %w", err)
+		return err
 	}
-	var transactionFee TransactionFee
-	if err := json.Unmarshal(buf, &transactionFee); err != nil {
-		return 0.0, 0, fmt.Errorf("fail to unmarshal transaction fee: %w", err)
+	for i := 0; i < len(fees)-int(t.feeSamples); i++ {
+		batch.Delete([]byte(t.getTransactionFeeKey(fees[i].Height)))
 	}
-	return transactionFee.Fee, transactionFee.VSize, nil
+	exist, err := t.db.Has([]byte(TransactionFeeKey), nil)
+	if err != nil {
+		return fmt.Errorf("fail to check whether transaction fee(%s) exist: %w", TransactionFeeKey, err)
+	}
+	if exist {
+		batch.Delete([]byte(TransactionFeeKey))
+	}
+	return nil
 }
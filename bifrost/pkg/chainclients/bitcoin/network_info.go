@@ -0,0 +1,95 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// NetworkInfoPath is the path on the metric server the bitcoin network info is exposed at
+	NetworkInfoPath = "/bifrost/bitcoin/networkinfo"
+)
+
+// NetworkInfo is bitcoin node's view of the network
+type NetworkInfo struct {
+	Connections     int    `json:"connections"`
+	ProtocolVersion int    `json:"protocol_version"`
+	MempoolSize     int    `json:"mempool_size"`
+	MempoolBytes    int64  `json:"mempool_bytes"`
+	PeerCount       int    `json:"peer_count"`
+	SubVersion      string `json:"sub_version"`
+}
+
+// networkInfoResult is the part of getnetworkinfo rpc result bifrost cares about
+type networkInfoResult struct {
+	Connections     int    `json:"connections"`
+	ProtocolVersion int    `json:"protocolversion"`
+	SubVersion      string `json:"subversion"`
+}
+
+// mempoolInfoResult is the part of getmempoolinfo rpc result bifrost cares about
+type mempoolInfoResult struct {
+	Size  int   `json:"size"`
+	Bytes int64 `json:"bytes"`
+}
+
+// GetNetworkInfo return the network info , combining getnetworkinfo, getmempoolinfo and getpeerinfo rpc calls
+func (c *Client) GetNetworkInfo() (NetworkInfo, error) {
+	var networkInfo networkInfoResult
+	if err := c.rawRequest("getnetworkinfo", &networkInfo); err != nil {
+		return NetworkInfo{}, err
+	}
+	mempoolInfo, err := c.getMempoolInfo()
+	if err != nil {
+		return NetworkInfo{}, err
+	}
+	peers, err := c.client.GetPeerInfo()
+	if err != nil {
+		return NetworkInfo{}, fmt.Errorf("fail to get peer info: %w", err)
+	}
+	return NetworkInfo{
+		Connections:     networkInfo.Connections,
+		ProtocolVersion: networkInfo.ProtocolVersion,
+		MempoolSize:     mempoolInfo.Size,
+		MempoolBytes:    mempoolInfo.Bytes,
+		PeerCount:       len(peers),
+		SubVersion:      networkInfo.SubVersion,
+	}, nil
+}
+
+func (c *Client) getMempoolInfo() (mempoolInfoResult, error) {
+	var mempoolInfo mempoolInfoResult
+	err := c.rawRequest("getmempoolinfo", &mempoolInfo)
+	return mempoolInfo, err
+}
+
+// rawRequest send a rpc request without parameters and unmarshal the result into the given value
+func (c *Client) rawRequest(method string, result interface{}) error {
+	buf, err := c.client.RawRequest(method, nil)
+	if err != nil {
+		return fmt.Errorf("fail to call %s: %w", method, err)
+	}
+	if err := json.Unmarshal(buf, result); err != nil {
+		return fmt.Errorf("fail to unmarshal %s result: %w", method, err)
+	}
+	return nil
+}
+
+// handleNetworkInfo write the network info as json
+func (c *Client) handleNetworkInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	networkInfo, err := c.GetNetworkInfo()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get network info")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(networkInfo); err != nil {
+		c.logger.Err(err).Msg("fail to write network info")
+	}
+}
@@ -0,0 +1,35 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *BitcoinSuite) TestGetNetworkInfo(c *C) {
+	atomic.StoreInt64(&s.mempoolSize, 1200)
+	networkInfo, err := s.client.GetNetworkInfo()
+	c.Assert(err, IsNil)
+	c.Check(networkInfo, Equals, NetworkInfo{
+		Connections:     10,
+		ProtocolVersion: 70015,
+		MempoolSize:     1200,
+		MempoolBytes:    300000,
+		PeerCount:       2,
+		SubVersion:      "/Satoshi:0.20.0/",
+	})
+
+	rec := httptest.NewRecorder()
+	s.client.handleNetworkInfo(rec, httptest.NewRequest(http.MethodGet, NetworkInfoPath, nil))
+	c.Check(rec.Code, Equals, http.StatusOK)
+	var resp NetworkInfo
+	c.Assert(json.Unmarshal(rec.Body.Bytes(), &resp), IsNil)
+	c.Check(resp, Equals, networkInfo)
+
+	rec = httptest.NewRecorder()
+	s.client.handleNetworkInfo(rec, httptest.NewRequest(http.MethodPost, NetworkInfoPath, nil))
+	c.Check(rec.Code, Equals, http.StatusMethodNotAllowed)
+}
@@ -0,0 +1,171 @@
+package bitcoin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/wire"
+
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+)
+
+// DefaultRebroadcastInterval is how often stuck txs will be re-broadcast when RebroadcastInterval is not configured
+const DefaultRebroadcastInterval = 10 * time.Minute
+
+// maxTrackedTxAge is how long a tx will be tracked for , a tx that is still not confirmed by then is given up
+const maxTrackedTxAge = 24 * time.Hour
+
+// trackedTx is a broadcast tx , and when it was first broadcast
+type trackedTx struct {
+	tx          *wire.MsgTx
+	broadcastAt time.Time
+}
+
+// trackTx keep the given broadcast tx , so it can be re-broadcast later if it get stuck
+func (c *Client) trackTx(txID string, tx *wire.MsgTx) {
+	c.broadcastTxsLock.Lock()
+	defer c.broadcastTxsLock.Unlock()
+	c.broadcastTxs[txID] = trackedTx{
+		tx:          tx,
+		broadcastAt: time.Now(),
+	}
+}
+
+// untrackTx stop tracking the given tx
+func (c *Client) untrackTx(txID string) {
+	c.broadcastTxsLock.Lock()
+	defer c.broadcastTxsLock.Unlock()
+	delete(c.broadcastTxs, txID)
+}
+
+// getTrackedTxIDs return the ids of all the txs tracked by the client , in order
+func (c *Client) getTrackedTxIDs() []string {
+	c.broadcastTxsLock.Lock()
+	defer c.broadcastTxsLock.Unlock()
+	txIDs := make([]string, 0, len(c.broadcastTxs))
+	for txID := range c.broadcastTxs {
+		txIDs = append(txIDs, txID)
+	}
+	sort.Strings(txIDs)
+	return txIDs
+}
+
+// getTrackedTx return the tracked tx of the given id , nil when the tx is not tracked
+func (c *Client) getTrackedTx(txID string) *wire.MsgTx {
+	c.broadcastTxsLock.Lock()
+	defer c.broadcastTxsLock.Unlock()
+	return c.broadcastTxs[txID].tx
+}
+
+// isTrackedTxExpired return whether the given tx has been tracked for longer than maxTrackedTxAge
+func (c *Client) isTrackedTxExpired(txID string) bool {
+	c.broadcastTxsLock.Lock()
+	defer c.broadcastTxsLock.Unlock()
+	item, ok := c.broadcastTxs[txID]
+	return ok && time.Since(item.broadcastAt) > maxTrackedTxAge
+}
+
+// getRebroadcastInterval return the configured rebroadcast interval , or DefaultRebroadcastInterval when it is not set
+func (c *Client) getRebroadcastInterval() time.Duration {
+	if c.cfg.RebroadcastInterval > 0 {
+		return c.cfg.RebroadcastInterval
+	}
+	return DefaultRebroadcastInterval
+}
+
+// rebroadcastStuckTxsLoop call RebroadcastStuckTxs every rebroadcast interval until ctx get cancelled
+func (c *Client) rebroadcastStuckTxsLoop(ctx context.Context) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(c.getRebroadcastInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.RebroadcastStuckTxs(ctx); err != nil {
+				c.logger.Err(err).Msg("fail to rebroadcast stuck txs")
+			}
+		}
+	}
+}
+
+// RebroadcastStuckTxs go through all the txs tracked by the client , txs get confirmed or tracked for longer than
+// maxTrackedTxAge are no longer tracked , txs that are neither in mempool nor confirmed will be broadcast to the chain again
+func (c *Client) RebroadcastStuckTxs(ctx context.Context) error {
+	for _, txID := range c.getTrackedTxIDs() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if c.isTrackedTxExpired(txID) {
+			c.logger.Info().Str("hash", txID).Msg("tx is not confirmed after max age, stop tracking it")
+			c.untrackTx(txID)
+			continue
+		}
+		if _, err := c.client.GetMempoolEntry(txID); err == nil {
+			// tx is still in mempool , it is not stuck
+			continue
+		}
+		confirmed, _, err := c.CheckTxConfirmations(txID, 1)
+		if err != nil {
+			// the node doesn't know about the tx anymore , it has been dropped from mempool
+			c.logger.Info().Str("hash", txID).Msgf("tx is not found: %s", err)
+		}
+		if confirmed {
+			c.untrackTx(txID)
+			continue
+		}
+		if err := c.rebroadcastTx(txID); err != nil {
+			c.logger.Err(err).Str("hash", txID).Msg("fail to rebroadcast tx")
+		}
+	}
+	return nil
+}
+
+// rebroadcastTx broadcast the tracked tx to the chain again
+func (c *Client) rebroadcastTx(txID string) error {
+	tx := c.getTrackedTx(txID)
+	if tx == nil {
+		return fmt.Errorf("tx(%s) is not tracked", txID)
+	}
+	if _, err := c.client.SendRawTransaction(tx, true); err != nil {
+		if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCTxAlreadyInChain {
+			c.untrackTx(txID)
+			return nil
+		}
+		if isTxInputsSpent(err) {
+			// the inputs of the tx have been spent by another tx , it will never make it to the chain
+			c.logger.Info().Str("hash", txID).Msgf("tx inputs are spent, stop tracking it: %s", err)
+			c.untrackTx(txID)
+			return nil
+		}
+		return fmt.Errorf("fail to broadcast tx to chain: %w", err)
+	}
+	c.incCounter(metrics.BitcoinRebroadcast)
+	c.logger.Info().Str("hash", txID).Msg("rebroadcast stuck tx to BTC chain successfully")
+	return nil
+}
+
+// isTxInputsSpent return whether the given broadcast error means the inputs of the tx are missing or already spent by a
+// conflicting tx
+func isTxInputsSpent(err error) bool {
+	rpcErr, ok := err.(*btcjson.RPCError)
+	if !ok {
+		return false
+	}
+	if rpcErr.Code != btcjson.ErrRPCTxError && rpcErr.Code != btcjson.ErrRPCTxRejected {
+		return false
+	}
+	for _, reason := range []string{"missing inputs", "missingorspent", "txn-mempool-conflict"} {
+		if strings.Contains(strings.ToLower(rpcErr.Message), reason) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package bitcoin
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	. "gopkg.in/check.v1"
+)
+
+func (s *BitcoinSuite) TestRebroadcastStuckTxs(c *C) {
+	stuckTx := wire.NewMsgTx(wire.TxVersion)
+	stuckTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	stuckTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	s.stuckTxID = stuckTx.TxHash().String()
+	atomic.StoreInt64(&s.rebroadcastCalls, 0)
+
+	// nothing to rebroadcast
+	c.Assert(s.client.RebroadcastStuckTxs(context.Background()), IsNil)
+	c.Assert(atomic.LoadInt64(&s.rebroadcastCalls), Equals, int64(0))
+
+	// tx is neither in mempool nor confirmed
+	s.client.trackTx(s.stuckTxID, stuckTx)
+	// tx has been confirmed
+	s.client.trackTx("31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f", wire.NewMsgTx(wire.TxVersion))
+	// tx is still in mempool
+	s.client.trackTx("5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513", wire.NewMsgTx(wire.TxVersion))
+	c.Assert(s.client.getTrackedTxIDs(), HasLen, 3)
+
+	c.Assert(s.client.RebroadcastStuckTxs(context.Background()), IsNil)
+	c.Assert(atomic.LoadInt64(&s.rebroadcastCalls), Equals, int64(1))
+	txIDs := s.client.getTrackedTxIDs()
+	c.Assert(txIDs, HasLen, 2)
+	c.Assert(s.client.getTrackedTx("31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f"), IsNil)
+	c.Assert(s.client.getTrackedTx(s.stuckTxID), NotNil)
+	c.Assert(s.client.getTrackedTx("5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513"), NotNil)
+
+	// cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Assert(s.client.RebroadcastStuckTxs(ctx), Equals, context.Canceled)
+	c.Assert(atomic.LoadInt64(&s.rebroadcastCalls), Equals, int64(1))
+
+	// tracked for too long
+	s.client.broadcastTxsLock.Lock()
+	item := s.client.broadcastTxs[s.stuckTxID]
+	item.broadcastAt = time.Now().Add(-maxTrackedTxAge - time.Minute)
+	s.client.broadcastTxs[s.stuckTxID] = item
+	s.client.broadcastTxsLock.Unlock()
+	c.Assert(s.client.isTrackedTxExpired(s.stuckTxID), Equals, true)
+	c.Assert(s.client.RebroadcastStuckTxs(context.Background()), IsNil)
+	c.Assert(atomic.LoadInt64(&s.rebroadcastCalls), Equals, int64(1))
+	c.Assert(s.client.getTrackedTx(s.stuckTxID), IsNil)
+
+	s.client.untrackTx(s.stuckTxID)
+	c.Assert(s.client.getTrackedTx(s.stuckTxID), IsNil)
+	c.Assert(s.client.rebroadcastTx(s.stuckTxID), NotNil)
+	s.stuckTxID = ""
+}
+
+func (s *BitcoinSuite) TestIsTxInputsSpent(c *C) {
+	c.Assert(isTxInputsSpent(errors.New("whatever")), Equals, false)
+	c.Assert(isTxInputsSpent(btcjson.NewRPCError(btcjson.ErrRPCTxError, "Missing inputs")), Equals, true)
+	c.Assert(isTxInputsSpent(btcjson.NewRPCError(btcjson.ErrRPCTxError, "bad-txns-inputs-missingorspent")), Equals, true)
+	c.Assert(isTxInputsSpent(btcjson.NewRPCError(btcjson.ErrRPCTxRejected, "txn-mempool-conflict (code 18)")), Equals, true)
+	c.Assert(isTxInputsSpent(btcjson.NewRPCError(btcjson.ErrRPCTxRejected, "min relay fee not met")), Equals, false)
+	c.Assert(isTxInputsSpent(btcjson.NewRPCError(btcjson.ErrRPCMisc, "Missing inputs")), Equals, false)
+}
+
+func (s *BitcoinSuite) TestGetRebroadcastInterval(c *C) {
+	c.Assert(s.client.getRebroadcastInterval(), Equals, DefaultRebroadcastInterval)
+	s.client.cfg.RebroadcastInterval = time.Minute
+	c.Assert(s.client.getRebroadcastInterval(), Equals, time.Minute)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -58,22 +59,42 @@ func (c *Client) getGasCoin(tx stypes.TxOutItem, vSize int64) common.Coin {
 		return tx.MaxGas.ToCoins().GetCoin(common.BTCAsset)
 	}
 	gasRate := int64(SatsPervBytes)
-	fee, vBytes, err := c.blockMetaAccessor.GetTransactionFee()
+	// the median of the recent transaction fees, so a single outlier doesn't decide the gas
+	feeRate, err := c.blockMetaAccessor.GetMedianTransactionFeeRate()
 	if err != nil {
 		c.logger.Error().Err(err).Msg("fail to get previous transaction fee from local storage")
+		gasRate = c.estimateFeeRate()
 		return common.NewCoin(common.BTCAsset, sdk.NewUint(uint64(vSize*gasRate)))
 	}
-	if fee != 0.0 && vSize != 0 {
-		amt, err := btcutil.NewAmount(fee)
-		if err != nil {
-			c.logger.Err(err).Msg("fail to convert amount from float64 to int64")
-		} else {
-			gasRate = int64(amt) / int64(vBytes) // sats per vbyte
-		}
+	if rate := int64(math.Round(feeRate)); rate > 0 {
+		gasRate = rate // sats per vbyte
 	}
 	return common.NewCoin(common.BTCAsset, sdk.NewUint(uint64(gasRate*vSize)))
 }
 
+// estimateFeeRate return the fee rate (sats per vbyte) of the latest scanned block that has one, when none of the
+// block metas in local storage has a fee rate , SatsPervBytes will be used instead
+func (c *Client) estimateFeeRate() int64 {
+	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		c.logger.Error().Err(err).Msg("fail to get block metas from local storage")
+		return SatsPervBytes
+	}
+	var latest *BlockMeta
+	for _, item := range blockMetas {
+		if item.BlockFeeRate <= 0 {
+			continue
+		}
+		if latest == nil || item.Height > latest.Height {
+			latest = item
+		}
+	}
+	if latest == nil {
+		return SatsPervBytes
+	}
+	return int64(math.Ceil(latest.BlockFeeRate))
+}
+
 // isYggdrasil - when the pubkey and node pubkey is the same that means it is signing from yggdrasil
 func (c *Client) isYggdrasil(key common.PubKey) bool {
 	asgards, err := c.bridge.GetAsgards()
@@ -168,6 +189,46 @@ func (c *Client) getSourceScript(tx stypes.TxOutItem) ([]byte, error) {
 	return txscript.PayToAddrScript(addr)
 }
 
+// IsSegWitAddress decode the given address and return whether it is a segwit address, and whether it is a native
+// segwit (bech32) one. P2SH addresses are assumed to be P2SH-P2WPKH wrapped segwit addresses, as there is no way to
+// tell from the address what the script hash commits to
+func (c *Client) IsSegWitAddress(addr string) (isSegwit bool, isNativeSegwit bool, err error) {
+	_, isSegwit, isNativeSegwit, err = c.decodeAddress(addr)
+	return isSegwit, isNativeSegwit, err
+}
+
+// decodeAddress decode the given address of the current network, only P2WPKH, P2WSH, P2SH and P2PKH addresses are
+// supported
+func (c *Client) decodeAddress(addr string) (address btcutil.Address, isSegwit bool, isNativeSegwit bool, err error) {
+	cfg := c.getChainCfg()
+	address, err = btcutil.DecodeAddress(addr, cfg)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("fail to decode address(%s): %w", addr, err)
+	}
+	if !address.IsForNet(cfg) {
+		return nil, false, false, fmt.Errorf("address(%s) is not for %s", addr, cfg.Name)
+	}
+	switch address.(type) {
+	case *btcutil.AddressWitnessPubKeyHash, *btcutil.AddressWitnessScriptHash:
+		return address, true, true, nil
+	case *btcutil.AddressScriptHash:
+		return address, true, false, nil
+	case *btcutil.AddressPubKeyHash:
+		return address, false, false, nil
+	}
+	return nil, false, false, fmt.Errorf("address(%s) type is not supported", addr)
+}
+
+// getPayToAddrScript build the output script paying to the given address, P2WPKH(P2WSH) for native segwit addresses,
+// P2SH for wrapped segwit addresses and P2PKH for legacy addresses
+func (c *Client) getPayToAddrScript(addr string) ([]byte, error) {
+	address, _, _, err := c.decodeAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.PayToAddrScript(address)
+}
+
 // SignTx is going to generate the outbound transaction, and also sign it
 func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, error) {
 	if !tx.Chain.Equals(common.BTCChain) {
@@ -201,11 +262,7 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 		individualAmounts[item.TxID] = amt
 	}
 
-	outputAddr, err := btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfg())
-	if err != nil {
-		return nil, fmt.Errorf("fail to decode next address: %w", err)
-	}
-	buf, err := txscript.PayToAddrScript(outputAddr)
+	buf, err := c.getPayToAddrScript(tx.ToAddress.String())
 	if err != nil {
 		return nil, fmt.Errorf("fail to get pay to address script: %w", err)
 	}
@@ -217,7 +274,7 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(redeemTx))
 	gasCoin := c.getGasCoin(tx, vSize)
 	gasAmt := btcutil.Amount(int64(gasCoin.Amount.Uint64()))
-	if err := c.blockMetaAccessor.UpsertTransactionFee(gasAmt.ToBTC(), int32(vSize)); err != nil {
+	if err := c.blockMetaAccessor.UpsertTransactionFee(gasAmt.ToBTC(), int32(vSize), chainBlockHeight); err != nil {
 		c.logger.Err(err).Msg("fail to save gas info to UTXO storage")
 	}
 	coinToCustomer := tx.Coins.GetCoin(common.BTCAsset)
@@ -426,5 +483,7 @@ func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) error {
 	}
 	// save tx id to block meta in case we need to errata later
 	c.logger.Info().Str("hash", txHash.String()).Msg("broadcast to BTC chain successfully")
+	// keep track of the tx , so it can be re-broadcast when it get stuck
+	c.trackTx(txHash.String(), redeemTx)
 	return nil
 }
@@ -1,6 +1,7 @@
 package bitcoin
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -115,7 +116,7 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 	storage := storage.NewMemStorage()
 	db, err := leveldb.Open(storage, nil)
 	c.Assert(err, IsNil)
-	accessor, err := NewLevelDBBlockMetaAccessor(db)
+	accessor, err := NewLevelDBBlockMetaAccessor(db, DefaultCompactionIntervalBlocks, DefaultTransactionFeeSamples)
 	c.Assert(err, IsNil)
 	s.client.blockMetaAccessor = accessor
 	c.Assert(err, IsNil)
@@ -148,6 +149,46 @@ func (s *BitcoinSignerSuite) TestGetChainCfg(c *C) {
 	c.Assert(param, Equals, &chaincfg.MainNetParams)
 }
 
+func (s *BitcoinSignerSuite) TestIsSegWitAddress(c *C) {
+	defer os.Setenv("NET", "testnet")
+	inputs := []struct {
+		net            string
+		addr           string
+		isSegwit       bool
+		isNativeSegwit bool
+		scriptPrefix   []byte
+	}{
+		{net: "mainnet", addr: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq", isSegwit: true, isNativeSegwit: true, scriptPrefix: []byte{0x00, 0x14}},
+		{net: "mainnet", addr: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", isSegwit: true, isNativeSegwit: false, scriptPrefix: []byte{0xa9, 0x14}},
+		{net: "mainnet", addr: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", isSegwit: false, isNativeSegwit: false, scriptPrefix: []byte{0x76, 0xa9, 0x14}},
+		{net: "testnet", addr: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", isSegwit: true, isNativeSegwit: true, scriptPrefix: []byte{0x00, 0x14}},
+		{net: "testnet", addr: "2MzQwSSnBHWHqSAqtTVQ6v47XtaisrJa1Vc", isSegwit: true, isNativeSegwit: false, scriptPrefix: []byte{0xa9, 0x14}},
+		{net: "testnet", addr: "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", isSegwit: false, isNativeSegwit: false, scriptPrefix: []byte{0x76, 0xa9, 0x14}},
+	}
+	for _, item := range inputs {
+		c.Assert(os.Setenv("NET", item.net), IsNil)
+		isSegwit, isNativeSegwit, err := s.client.IsSegWitAddress(item.addr)
+		c.Assert(err, IsNil, Commentf(item.addr))
+		c.Check(isSegwit, Equals, item.isSegwit, Commentf(item.addr))
+		c.Check(isNativeSegwit, Equals, item.isNativeSegwit, Commentf(item.addr))
+		script, err := s.client.getPayToAddrScript(item.addr)
+		c.Assert(err, IsNil, Commentf(item.addr))
+		c.Check(bytes.HasPrefix(script, item.scriptPrefix), Equals, true, Commentf(item.addr))
+	}
+
+	// address of another network
+	c.Assert(os.Setenv("NET", "mainnet"), IsNil)
+	_, _, err := s.client.IsSegWitAddress("tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx")
+	c.Check(err, NotNil)
+	_, _, err = s.client.IsSegWitAddress("mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn")
+	c.Check(err, NotNil)
+	// not an address at all
+	_, _, err = s.client.IsSegWitAddress("whatever")
+	c.Check(err, NotNil)
+	_, err = s.client.getPayToAddrScript("whatever")
+	c.Check(err, NotNil)
+}
+
 func (s *BitcoinSignerSuite) TestSignTx(c *C) {
 	txOutItem := stypes.TxOutItem{
 		Chain:       common.BNBChain,
@@ -356,3 +397,32 @@ func (s *BitcoinSignerSuite) TestGetAllUTXOs(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(allmetas, HasLen, 148)
 }
+
+func (s *BitcoinSignerSuite) TestEstimateFeeRate(c *C) {
+	// no block meta has fee rate yet
+	c.Assert(s.client.estimateFeeRate(), Equals, int64(SatsPervBytes))
+
+	for i := 0; i < 3; i++ {
+		blockMeta := NewBlockMeta(thorchain.GetRandomTxHash().String(), int64(i), thorchain.GetRandomTxHash().String())
+		blockMeta.AddTransactionValue(TransactionValue{
+			TxID:        thorchain.GetRandomTxHash().String(),
+			InputValue:  100000,
+			OutputValue: 100000 - uint64(i+1)*1000,
+			VSize:       100,
+		})
+		c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	}
+	// the latest block meta has a fee rate of 3000 sats / 100 vbytes
+	c.Assert(s.client.estimateFeeRate(), Equals, int64(30))
+
+	// without previous transaction fee , the gas is estimated according to the latest block fee rate
+	gasCoin := s.client.getGasCoin(stypes.TxOutItem{}, 200)
+	c.Assert(gasCoin.Amount.Uint64(), Equals, uint64(6000))
+
+	// with previous transaction fees , the median fee rate is used
+	c.Assert(s.client.blockMetaAccessor.UpsertTransactionFee(0.0001, 100, 1), IsNil)
+	c.Assert(s.client.blockMetaAccessor.UpsertTransactionFee(0.0002, 100, 2), IsNil)
+	c.Assert(s.client.blockMetaAccessor.UpsertTransactionFee(0.01, 100, 3), IsNil)
+	gasCoin = s.client.getGasCoin(stypes.TxOutItem{}, 200)
+	c.Assert(gasCoin.Amount.Uint64(), Equals, uint64(40000))
+}
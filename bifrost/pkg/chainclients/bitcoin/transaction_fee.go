@@ -1,7 +1,17 @@
 package bitcoin
 
-// TransactionFee on bitcoin
+// TransactionFee on bitcoin, Height is the bitcoin block height the fee sample was taken at
 type TransactionFee struct {
-	Fee   float64 `json:"fee"`
-	VSize int32   `json:"v_size"`
+	Fee    float64 `json:"fee"`
+	VSize  int32   `json:"v_size"`
+	Height int64   `json:"height"`
+}
+
+// TransactionValue records the total value of the inputs and outputs (in sats) and the virtual size of a transaction
+// bifrost scanned , the difference between input and output is the fee paid by the transaction
+type TransactionValue struct {
+	TxID        string `json:"tx_id"`
+	InputValue  uint64 `json:"input_value"`
+	OutputValue uint64 `json:"output_value"`
+	VSize       int32  `json:"v_size"`
 }
@@ -1,6 +1,8 @@
 package chainclients
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"gitlab.com/thorchain/thornode/bifrost/config"
 	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/common"
@@ -17,6 +19,7 @@ import (
 // GetAccount   gets account from thorclient in cain
 // GetGasFee    calculates gas fee based on number of simple transfer sents
 // GetConfig	gets the chain configuration
+// Ping         check the chain node is reachable
 // Start
 // Stop
 type ChainClient interface {
@@ -28,5 +31,22 @@ type ChainClient interface {
 	GetChain() common.Chain
 	Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan stypes.ErrataBlock)
 	GetConfig() config.ChainConfiguration
+	Ping() error
 	Stop()
 }
+
+// AddressBalanceChecker is implemented by chain clients that are able to compute the balance of an address on their own
+//
+// GetAddressBalance  get the spendable balance of the given address, in the smallest unit of the chain gas asset
+type AddressBalanceChecker interface {
+	GetAddressBalance(addr string) (sdk.Uint, error)
+}
+
+// TxConfirmationChecker is implemented by chain clients that are able to check the confirmation of a broadcast tx
+//
+// GetTxID               get the tx id of a signed tx payload
+// CheckTxConfirmations  check whether a tx has at least minConfirmations confirmations , stypes.ErrTxNotFound means dropped
+type TxConfirmationChecker interface {
+	GetTxID(payload []byte) (string, error)
+	CheckTxConfirmations(txID string, minConfirmations int64) (bool, int, error)
+}
@@ -26,4 +26,5 @@ func (DummyChain) GetAccount(addr string) (common.Account, error) {
 func (DummyChain) GetChain() common.Chain                { return "" }
 func (DummyChain) GetGasFee(count uint64) common.Gas     { return nil }
 func (DummyChain) Start(globalTxsQueue chan stypes.TxIn) {}
+func (DummyChain) Ping() error                           { return kaboom }
 func (DummyChain) Stop()                                 {}
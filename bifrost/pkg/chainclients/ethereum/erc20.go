@@ -0,0 +1,78 @@
+package ethereum
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// ERC20TransferGas is the gas limit used for an ERC-20 transfer, on top of the gas charged for the memo bytes
+const ERC20TransferGas = uint64(65000)
+
+// erc20TransferMethodID is the first four bytes of keccak256("transfer(address,uint256)")
+var erc20TransferMethodID = []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+// erc20SymbolMethodID is the first four bytes of keccak256("symbol()")
+var erc20SymbolMethodID = []byte{0x95, 0xd8, 0x9b, 0x41}
+
+// erc20TransferDataLen is the length of the call data of an ERC-20 transfer without the memo
+const erc20TransferDataLen = 4 + 2*ecommon.HashLength
+
+// getTokenAddress returns the contract address of an ERC-20 asset, ERC-20 assets use the contract address as
+// the symbol suffix, for example ETH.TKN-0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483
+func getTokenAddress(asset common.Asset) (ecommon.Address, bool) {
+	if !asset.Chain.Equals(common.ETHChain) || asset.Equals(common.ETHAsset) {
+		return ecommon.Address{}, false
+	}
+	parts := strings.Split(asset.Symbol.String(), "-")
+	if len(parts) != 2 || !ecommon.IsHexAddress(parts[1]) {
+		return ecommon.Address{}, false
+	}
+	return ecommon.HexToAddress(parts[1]), true
+}
+
+// newERC20TransferData encodes the call data of an ERC-20 transfer, the memo is appended after the abi encoded
+// arguments, token contracts ignore the trailing bytes
+func newERC20TransferData(to ecommon.Address, amount *big.Int, memo string) []byte {
+	data := make([]byte, 0, len(erc20TransferMethodID)+2*ecommon.HashLength+len(memo))
+	data = append(data, erc20TransferMethodID...)
+	data = append(data, ecommon.LeftPadBytes(to.Bytes(), ecommon.HashLength)...)
+	data = append(data, ecommon.LeftPadBytes(amount.Bytes(), ecommon.HashLength)...)
+	return append(data, []byte(memo)...)
+}
+
+// parseERC20TransferData decodes the call data of an ERC-20 transfer, it returns false when the data is not a transfer
+func parseERC20TransferData(data []byte) (ecommon.Address, *big.Int, string, bool) {
+	if len(data) < erc20TransferDataLen || !bytes.Equal(data[:len(erc20TransferMethodID)], erc20TransferMethodID) {
+		return ecommon.Address{}, nil, "", false
+	}
+	to := ecommon.BytesToAddress(data[4 : 4+ecommon.HashLength])
+	amount := new(big.Int).SetBytes(data[4+ecommon.HashLength : erc20TransferDataLen])
+	return to, amount, string(data[erc20TransferDataLen:]), true
+}
+
+// parseERC20Symbol decodes the result of a symbol() call, the standard returns an abi encoded string, some older
+// tokens return a bytes32 instead
+func parseERC20Symbol(result []byte) (string, error) {
+	if len(result) == ecommon.HashLength {
+		return string(bytes.TrimRight(result, "\x00")), nil
+	}
+	if len(result) < 2*ecommon.HashLength {
+		return "", errors.New("symbol result is too short")
+	}
+	offset := new(big.Int).SetBytes(result[:ecommon.HashLength])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(result)-ecommon.HashLength) {
+		return "", errors.New("invalid symbol offset")
+	}
+	start := offset.Uint64() + ecommon.HashLength
+	length := new(big.Int).SetBytes(result[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(result))-start {
+		return "", errors.New("invalid symbol length")
+	}
+	return string(result[start : start+length.Uint64()]), nil
+}
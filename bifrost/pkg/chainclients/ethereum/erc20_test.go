@@ -0,0 +1,77 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type ERC20Suite struct{}
+
+var _ = Suite(&ERC20Suite{})
+
+const tokenContract = "0x3b7fa4dd21c6f9ba3ca375217ead7cab9d6bf483"
+
+func (s *ERC20Suite) TestGetTokenAddress(c *C) {
+	asset, err := common.NewAsset("ETH.TKN-" + strings.ToUpper(tokenContract))
+	c.Assert(err, IsNil)
+	addr, ok := getTokenAddress(asset)
+	c.Check(ok, Equals, true)
+	c.Check(addr, Equals, ecommon.HexToAddress(tokenContract))
+
+	for _, input := range []string{"ETH.ETH", "ETH.TKN", "ETH.TKN-0X3B7F", "BNB.TKN-" + tokenContract} {
+		asset, err := common.NewAsset(input)
+		c.Assert(err, IsNil)
+		_, ok := getTokenAddress(asset)
+		c.Check(ok, Equals, false, Commentf("%s", input))
+	}
+}
+
+func (s *ERC20Suite) TestNewERC20TransferData(c *C) {
+	to := ecommon.HexToAddress(account)
+	data := newERC20TransferData(to, big.NewInt(194765912), "OUTBOUND:abc")
+	c.Assert(data, HasLen, 4+32+32+len("OUTBOUND:abc"))
+	c.Check(hex.EncodeToString(data[:4]), Equals, "a9059cbb")
+	c.Check(ecommon.BytesToAddress(data[4:36]), Equals, to)
+	c.Check(new(big.Int).SetBytes(data[36:68]).Int64(), Equals, int64(194765912))
+	c.Check(string(data[68:]), Equals, "OUTBOUND:abc")
+}
+
+func (s *ERC20Suite) TestParseERC20TransferData(c *C) {
+	to := ecommon.HexToAddress(account)
+	addr, amount, memo, ok := parseERC20TransferData(newERC20TransferData(to, big.NewInt(194765912), "OUTBOUND:abc"))
+	c.Assert(ok, Equals, true)
+	c.Check(addr, Equals, to)
+	c.Check(amount.Int64(), Equals, int64(194765912))
+	c.Check(memo, Equals, "OUTBOUND:abc")
+
+	_, _, _, ok = parseERC20TransferData([]byte("hello!"))
+	c.Check(ok, Equals, false)
+	_, _, _, ok = parseERC20TransferData(make([]byte, erc20TransferDataLen))
+	c.Check(ok, Equals, false)
+}
+
+func (s *ERC20Suite) TestParseERC20Symbol(c *C) {
+	result, err := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"544b4e0000000000000000000000000000000000000000000000000000000000")
+	c.Assert(err, IsNil)
+	symbol, err := parseERC20Symbol(result)
+	c.Assert(err, IsNil)
+	c.Check(symbol, Equals, "TKN")
+
+	// bytes32 symbol
+	symbol, err = parseERC20Symbol(ecommon.RightPadBytes([]byte("MKR"), ecommon.HashLength))
+	c.Assert(err, IsNil)
+	c.Check(symbol, Equals, "MKR")
+
+	_, err = parseERC20Symbol([]byte("TKN"))
+	c.Check(err, NotNil)
+	_, err = parseERC20Symbol(result[:2*ecommon.HashLength])
+	c.Check(err, NotNil)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	ecommon "github.com/ethereum/go-ethereum/common"
 	etypes "github.com/ethereum/go-ethereum/core/types"
@@ -19,6 +20,7 @@ import (
 	"gitlab.com/thorchain/thornode/bifrost/config"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/ethereum/types"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/bifrost/tss"
@@ -40,7 +42,7 @@ type Client struct {
 }
 
 // NewClient create new instance of Ethereum client
-func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tssp.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics) (*Client, error) {
+func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tssp.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) (*Client, error) {
 	tssKm, err := tss.NewKeySign(server)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create tss signer: %w", err)
@@ -95,7 +97,7 @@ func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server
 		return c, fmt.Errorf("fail to create blockscanner storage: %w", err)
 	}
 
-	c.ethScanner, err = NewBlockScanner(c.cfg.BlockScanner, storage, c.chainID, c.client, m)
+	c.ethScanner, err = NewBlockScanner(c.cfg.BlockScanner, storage, c.chainID, c.client, m, pubkeyMgr)
 	if err != nil {
 		return c, fmt.Errorf("fail to create eth block scanner: %w", err)
 	}
@@ -121,6 +123,14 @@ func (c *Client) GetConfig() config.ChainConfiguration {
 	return c.cfg
 }
 
+// Ping check the ethereum node is reachable
+func (c *Client) Ping() error {
+	if _, err := c.client.ChainID(context.Background()); err != nil {
+		return fmt.Errorf("fail to get chain id: %w", err)
+	}
+	return nil
+}
+
 // IsTestNet determinate whether we are running on test net by checking the status
 func (c *Client) InitChainID() {
 	chainID, err := c.client.ChainID(context.Background())
@@ -183,6 +193,11 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, error) {
 		c.logger.Error().Msg("invalid tx params")
 		return nil, nil
 	}
+	// ERC-20 tokens are transferred by calling the token contract, so a tx can only carry one token
+	tokenAddr, isToken := getTokenAddress(tx.Coins[0].Asset)
+	if isToken && len(tx.Coins) > 1 {
+		return nil, fmt.Errorf("fail to sign tx, erc20 transfer can only have one coin, got %d", len(tx.Coins))
+	}
 	fromAddr := c.GetAddress(tx.VaultPubKey)
 
 	currentHeight, err := c.GetHeight()
@@ -206,9 +221,16 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, error) {
 	c.logger.Info().Uint64("nonce", meta.Nonce).Msg("account info")
 
 	gasPrice := c.ethScanner.GetGasPrice()
+	to := ecommon.HexToAddress(toAddr)
 	encodedData := []byte(hex.EncodeToString([]byte(tx.Memo)))
 	gasFee := common.GetETHGasFee(big.NewInt(1), uint64(len(tx.Memo)))[0].Amount.Uint64()
-	createdTx := etypes.NewTransaction(meta.Nonce, ecommon.HexToAddress(toAddr), value, gasFee, gasPrice, encodedData)
+	if isToken {
+		encodedData = newERC20TransferData(to, value, hex.EncodeToString([]byte(tx.Memo)))
+		gasFee = common.GetETHGasFee(big.NewInt(1), uint64(len(encodedData)))[0].Amount.Uint64() - ETHTransferGas + ERC20TransferGas
+		to = tokenAddr
+		value = big.NewInt(0)
+	}
+	createdTx := etypes.NewTransaction(meta.Nonce, to, value, gasFee, gasPrice, encodedData)
 
 	rawTx, err := c.sign(createdTx, fromAddr, tx.VaultPubKey, currentHeight, tx)
 	if err != nil || len(rawTx) == 0 {
@@ -271,8 +293,41 @@ func (c *Client) BroadcastTx(stx stypes.TxOutItem, hexTx []byte) error {
 		return err
 	}
 	if err := c.client.SendTransaction(context.Background(), tx); err != nil {
+		if isNonceCollision(err) {
+			// the cached nonce is stale, another tx from the vault took it already. Refresh the nonce from the
+			// pending state so the signer can sign the item again with the correct nonce when it retries
+			c.logger.Info().Uint64("nonce", tx.Nonce()).Msg("nonce collision, resync account nonce")
+			if errSync := c.resyncNonce(stx.VaultPubKey); errSync != nil {
+				c.logger.Error().Err(errSync).Msg("fail to resync account nonce")
+			}
+		}
 		return err
 	}
 	c.accts.NonceInc(stx.VaultPubKey)
 	return nil
 }
+
+// isNonceCollision returns true when the tx is rejected because its nonce has been used by another tx
+func isNonceCollision(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "replacement transaction underpriced")
+}
+
+// resyncNonce refresh the cached nonce of the given vault with the pending nonce from the Ethereum node
+func (c *Client) resyncNonce(pk common.PubKey) error {
+	addr := c.GetAddress(pk)
+	nonce, err := c.client.PendingNonceAt(context.Background(), ecommon.HexToAddress(addr))
+	if err != nil {
+		return fmt.Errorf("fail to get pending account nonce: %w", err)
+	}
+	height, err := c.GetHeight()
+	if err != nil {
+		return fmt.Errorf("fail to get current Ethereum block height: %w", err)
+	}
+	c.accts.Set(pk, EthereumMetadata{
+		Address:     addr,
+		Nonce:       nonce,
+		BlockHeight: height,
+	})
+	return nil
+}
@@ -8,9 +8,11 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum"
+	ecommon "github.com/ethereum/go-ethereum/common"
 	etypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/prometheus/client_golang/prometheus"
@@ -22,6 +24,7 @@ import (
 	"gitlab.com/thorchain/thornode/bifrost/config"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/ethereum/types"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/common"
 )
@@ -43,16 +46,22 @@ type BlockScanner struct {
 	errCounter *prometheus.CounterVec
 	gasPrice   *big.Int
 	client     *ethclient.Client
+	tokenLock  *sync.Mutex
+	tokens     map[ecommon.Address]common.Asset
+	pubkeyMgr  pubkeymanager.PubKeyValidator
 }
 
 // NewBlockScanner create a new instance of BlockScan
-func NewBlockScanner(cfg config.BlockScannerConfiguration, scanStorage blockscanner.ScannerStorage, chainID types.ChainID, client *ethclient.Client, m *metrics.Metrics) (*BlockScanner, error) {
+func NewBlockScanner(cfg config.BlockScannerConfiguration, scanStorage blockscanner.ScannerStorage, chainID types.ChainID, client *ethclient.Client, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) (*BlockScanner, error) {
 	if scanStorage == nil {
 		return nil, errors.New("scanStorage is nil")
 	}
 	if m == nil {
 		return nil, errors.New("metrics is nil")
 	}
+	if pubkeyMgr == nil {
+		return nil, errors.New("pubkey manager is nil")
+	}
 	eipSigner = etypes.NewEIP155Signer(big.NewInt(int64(chainID)))
 	gasPrice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
@@ -65,6 +74,9 @@ func NewBlockScanner(cfg config.BlockScannerConfiguration, scanStorage blockscan
 		errCounter: m.GetCounterVec(metrics.BlockScanError(common.ETHChain)),
 		client:     client,
 		gasPrice:   gasPrice,
+		tokenLock:  &sync.Mutex{},
+		tokens:     make(map[ecommon.Address]common.Asset),
+		pubkeyMgr:  pubkeyMgr,
 		httpClient: &http.Client{
 			Timeout: cfg.HttpRequestTimeout,
 		},
@@ -223,6 +235,25 @@ func (e *BlockScanner) fromTxToTxIn(encodedTx string) (*stypes.TxInItem, error)
 	}
 	txInItem.To = strings.ToLower(tx.To().String())
 
+	// an ERC-20 transfer is sent to the token contract, the recipient and the amount are in the call data
+	if to, amount, memo, ok := parseERC20TransferData(tx.Data()); ok && tx.Value().Sign() == 0 {
+		// only transfers to a vault are decoded, anyone can deploy a contract that fails the symbol lookup
+		if isVault, _ := e.pubkeyMgr.IsValidPoolAddress(to.String(), common.ETHChain); !isVault {
+			return nil, nil
+		}
+		asset, err := e.getTokenAsset(*tx.To())
+		if err != nil {
+			e.errCounter.WithLabelValues("fail_get_token", txInItem.To).Inc()
+			e.logger.Error().Err(err).Str("contract", txInItem.To).Msg("fail to get token asset, ignore the transfer")
+			return nil, nil
+		}
+		txInItem.To = strings.ToLower(to.String())
+		txInItem.Memo = memo
+		txInItem.Coins = append(txInItem.Coins, common.NewCoin(asset, sdk.NewUintFromBigInt(amount)))
+		txInItem.Gas = common.GetETHGasFee(e.gasPrice, uint64(len(txInItem.Memo)))
+		return txInItem, nil
+	}
+
 	asset, err := common.NewAsset("ETH.ETH")
 	if err != nil {
 		e.errCounter.WithLabelValues("fail_create_ticker", "ETH").Inc()
@@ -234,3 +265,29 @@ func (e *BlockScanner) fromTxToTxIn(encodedTx string) (*stypes.TxInItem, error)
 
 	return txInItem, nil
 }
+
+// getTokenAsset returns the asset of the given ERC-20 contract, the symbol is read from the contract once and cached
+func (e *BlockScanner) getTokenAsset(contract ecommon.Address) (common.Asset, error) {
+	e.tokenLock.Lock()
+	defer e.tokenLock.Unlock()
+	if asset, ok := e.tokens[contract]; ok {
+		return asset, nil
+	}
+	result, err := e.client.CallContract(context.Background(), ethereum.CallMsg{
+		To:   &contract,
+		Data: erc20SymbolMethodID,
+	}, nil)
+	if err != nil {
+		return common.EmptyAsset, fmt.Errorf("fail to call symbol: %w", err)
+	}
+	symbol, err := parseERC20Symbol(result)
+	if err != nil {
+		return common.EmptyAsset, fmt.Errorf("fail to parse symbol: %w", err)
+	}
+	asset, err := common.NewAsset(common.ETHChain.String() + "." + symbol + "-" + strings.ToUpper(contract.String()))
+	if err != nil {
+		return common.EmptyAsset, fmt.Errorf("fail to create asset: %w", err)
+	}
+	e.tokens[contract] = asset
+	return asset, nil
+}
@@ -3,12 +3,17 @@ package ethereum
 import (
 	"encoding/json"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	. "gopkg.in/check.v1"
 
@@ -16,6 +21,8 @@ import (
 	"gitlab.com/thorchain/thornode/bifrost/config"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/ethereum/types"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
+	"gitlab.com/thorchain/thornode/common"
 )
 
 func Test(t *testing.T) { TestingT(t) }
@@ -52,19 +59,22 @@ func (s *BlockScannerTestSuite) TestNewBlockScanner(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
-	bs, err := NewBlockScanner(getConfigForTest(""), storage, types.Mainnet, ethClient, s.m)
+	bs, err := NewBlockScanner(getConfigForTest(""), storage, types.Mainnet, ethClient, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
-	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m)
+	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
-	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, nil, s.m)
+	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
-	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m)
+	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, nil, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
-	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m)
+	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m, pubkeymanager.NewMockPoolAddressValidator())
+	c.Assert(err, NotNil)
+	c.Assert(bs, IsNil)
+	bs, err = NewBlockScanner(getConfigForTest("127.0.0.1"), storage, types.Mainnet, ethClient, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 }
@@ -133,7 +143,7 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
 	c.Assert(ethClient, NotNil)
-	bs, err := NewBlockScanner(getConfigForTest(server.URL), blockscanner.NewMockScannerStorage(), types.Mainnet, ethClient, s.m)
+	bs, err := NewBlockScanner(getConfigForTest(server.URL), blockscanner.NewMockScannerStorage(), types.Mainnet, ethClient, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 	txIn, err := bs.FetchTxs(int64(1))
@@ -181,7 +191,7 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
 	c.Assert(ethClient, NotNil)
-	bs, err := NewBlockScanner(getConfigForTest(server.URL), blockscanner.NewMockScannerStorage(), types.Mainnet, ethClient, s.m)
+	bs, err := NewBlockScanner(getConfigForTest(server.URL), blockscanner.NewMockScannerStorage(), types.Mainnet, ethClient, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 	encodedTx := `{
@@ -219,3 +229,102 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 		true,
 	)
 }
+
+func (s *BlockScannerTestSuite) TestFromERC20TxToTxIn(c *C) {
+	symbolCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		c.Assert(err, IsNil)
+		type RPCRequest struct {
+			JSONRPC string          `json:"jsonrpc"`
+			ID      interface{}     `json:"id"`
+			Method  string          `json:"method"`
+			Params  json.RawMessage `json:"params"`
+		}
+		var rpcRequest RPCRequest
+		err = json.Unmarshal(body, &rpcRequest)
+		c.Assert(err, IsNil)
+		if rpcRequest.Method == "eth_gasPrice" {
+			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+			c.Assert(err, IsNil)
+		}
+		if rpcRequest.Method == "eth_call" {
+			symbolCalls++
+			if strings.Contains(string(rpcRequest.Params), badTokenContract) {
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`))
+				c.Assert(err, IsNil)
+				return
+			}
+			// abi encoded string "TKN"
+			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x` +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000003" +
+				"544b4e0000000000000000000000000000000000000000000000000000000000" + `"}`))
+			c.Assert(err, IsNil)
+		}
+	}))
+	ethClient, err := ethclient.Dial(server.URL)
+	c.Assert(err, IsNil)
+	bs, err := NewBlockScanner(getConfigForTest(server.URL), blockscanner.NewMockScannerStorage(), types.Mainnet, ethClient, s.m, &mockVaultValidator{
+		MockPoolAddressValidator: pubkeymanager.NewMockPoolAddressValidator(),
+		vaults:                   []string{account},
+	})
+	c.Assert(err, IsNil)
+
+	key, err := crypto.GenerateKey()
+	c.Assert(err, IsNil)
+	encodeTransfer := func(contract, to string) string {
+		data := newERC20TransferData(ecommon.HexToAddress(to), big.NewInt(194765912), "SWAP:ETH.ETH")
+		tx := etypes.NewTransaction(0, ecommon.HexToAddress(contract), big.NewInt(0), ERC20TransferGas, big.NewInt(1), data)
+		tx, err = etypes.SignTx(tx, etypes.NewEIP155Signer(big.NewInt(int64(types.Mainnet))), key)
+		c.Assert(err, IsNil)
+		encodedTx, err := tx.MarshalJSON()
+		c.Assert(err, IsNil)
+		return string(encodedTx)
+	}
+	encodedTx := encodeTransfer(tokenContract, account)
+
+	for i := 0; i < 2; i++ {
+		txInItem, err := bs.fromTxToTxIn(encodedTx)
+		c.Assert(err, IsNil)
+		c.Assert(txInItem, NotNil)
+		c.Check(txInItem.Memo, Equals, "SWAP:ETH.ETH")
+		c.Check(txInItem.Sender, Equals, strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).String()))
+		c.Check(txInItem.To, Equals, account)
+		c.Assert(txInItem.Coins, HasLen, 1)
+		c.Check(txInItem.Coins[0].Asset.String(), Equals, "ETH.TKN-"+strings.ToUpper(tokenContract))
+		c.Check(txInItem.Coins[0].Amount.Equal(sdk.NewUint(194765912)), Equals, true)
+	}
+	// the token symbol is only looked up once
+	c.Check(symbolCalls, Equals, 1)
+
+	// transfer to an address that is not a vault is ignored , without looking up the token symbol
+	txInItem, err := bs.fromTxToTxIn(encodeTransfer(badTokenContract, "0x0000000000000000000000000000000000000001"))
+	c.Assert(err, IsNil)
+	c.Check(txInItem, IsNil)
+	c.Check(symbolCalls, Equals, 1)
+
+	// token symbol can't be resolved , the transfer is ignored rather than failing the block
+	txInItem, err = bs.fromTxToTxIn(encodeTransfer(badTokenContract, account))
+	c.Assert(err, IsNil)
+	c.Check(txInItem, IsNil)
+	c.Check(symbolCalls, Equals, 2)
+}
+
+// badTokenContract is a token contract that reverts on symbol()
+const badTokenContract = "0x5b1b5fea1b99d83ad479df0c222f0492385381dd"
+
+// mockVaultValidator treat the given addresses as vault addresses
+type mockVaultValidator struct {
+	*pubkeymanager.MockPoolAddressValidator
+	vaults []string
+}
+
+func (m *mockVaultValidator) IsValidPoolAddress(addr string, chain common.Chain) (bool, common.ChainPoolInfo) {
+	for _, vault := range m.vaults {
+		if strings.EqualFold(addr, vault) {
+			return true, common.EmptyChainPoolInfo
+		}
+	}
+	return false, common.EmptyChainPoolInfo
+}
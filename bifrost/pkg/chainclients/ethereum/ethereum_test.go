@@ -1,8 +1,10 @@
 package ethereum
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -14,10 +16,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/bifrost/config"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/common"
@@ -128,8 +134,31 @@ func (s *EthereumSuite) TearDownSuite(c *C) {
 
 var account = "0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae"
 
+// blockResponse is the eth_getBlockByNumber response of the mock Ethereum node
+const blockResponse = `{"jsonrpc":"2.0","id":1,"result":{
+	"difficulty": "0x31962a3fc82b",
+	"extraData": "0x4477617266506f6f6c",
+	"gasLimit": "0x47c3d8",
+	"gasUsed": "0x0",
+	"hash": "0x78bfef68fccd4507f9f4804ba5c65eb2f928ea45b3383ade88aaa720f1209cba",
+	"logsBloom": "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	"miner": "0x2a65aca4d5fc5b5c859090a6c34d164135398226",
+	"nonce": "0xa5e8fb780cc2cd5e",
+	"number": "0x1",
+	"parentHash": "0x8b535592eb3192017a527bbf8e3596da86b3abea51d6257898b2ced9d3a83826",
+	"receiptsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	"sha3Uncles": "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+	"size": "0x20e",
+	"stateRoot": "0xdc6ed0a382e50edfedb6bd296892690eb97eb3fc88fd55088d5ea753c48253dc",
+	"timestamp": "0x579f4981",
+	"totalDifficulty": "0x25cff06a0d96f4bee",
+	"transactions": [],
+	"transactionsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	"uncles": []
+}}`
+
 func (s *EthereumSuite) TestClient(c *C) {
-	e, err := NewClient(s.thorKeys, config.ChainConfiguration{}, nil, s.bridge, s.m)
+	e, err := NewClient(s.thorKeys, config.ChainConfiguration{}, nil, s.bridge, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 
@@ -166,27 +195,7 @@ func (s *EthereumSuite) TestClient(c *C) {
 			c.Assert(err, IsNil)
 		}
 		if rpcRequest.Method == "eth_getBlockByNumber" {
-			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{
-				"difficulty": "0x31962a3fc82b",
-				"extraData": "0x4477617266506f6f6c",
-				"gasLimit": "0x47c3d8",
-				"gasUsed": "0x0",
-				"hash": "0x78bfef68fccd4507f9f4804ba5c65eb2f928ea45b3383ade88aaa720f1209cba",
-				"logsBloom": "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-				"miner": "0x2a65aca4d5fc5b5c859090a6c34d164135398226",
-				"nonce": "0xa5e8fb780cc2cd5e",
-				"number": "0x1",
-				"parentHash": "0x8b535592eb3192017a527bbf8e3596da86b3abea51d6257898b2ced9d3a83826",
-				"receiptsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
-				"sha3Uncles": "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
-				"size": "0x20e",
-				"stateRoot": "0xdc6ed0a382e50edfedb6bd296892690eb97eb3fc88fd55088d5ea753c48253dc",
-				"timestamp": "0x579f4981",
-				"totalDifficulty": "0x25cff06a0d96f4bee",
-				"transactions": [],
-				"transactionsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
-				"uncles": [
-		]}}`))
+			_, err := rw.Write([]byte(blockResponse))
 			c.Assert(err, IsNil)
 		}
 	}))
@@ -196,11 +205,12 @@ func (s *EthereumSuite) TestClient(c *C) {
 		BlockScanner: config.BlockScannerConfiguration{
 			StartBlockHeight: 1, // avoids querying thorchain for block height
 		},
-	}, nil, s.bridge, s.m)
+	}, nil, s.bridge, s.m, pubkeymanager.NewMockPoolAddressValidator())
 	c.Assert(err2, IsNil)
 	c.Assert(e2, NotNil)
 
 	c.Check(e2.GetChain(), Equals, common.ETHChain)
+	c.Check(e2.Ping(), IsNil)
 	height, err := e2.GetHeight()
 	c.Assert(err, IsNil)
 	c.Check(height, Equals, int64(1))
@@ -252,3 +262,92 @@ func (s *EthereumSuite) TestClient(c *C) {
 	c.Check(meta.Address, Equals, addr)
 	c.Check(meta.Nonce, Equals, uint64(1))
 }
+
+func (s *EthereumSuite) TestBroadcastERC20NonceCollision(c *C) {
+	collide := true
+	sendCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		c.Assert(err, IsNil)
+		var rpcRequest struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		c.Assert(json.Unmarshal(body, &rpcRequest), IsNil)
+		var response string
+		switch rpcRequest.Method {
+		case "eth_chainId":
+			response = `{"jsonrpc":"2.0","id":1,"result":"0x2"}`
+		case "eth_gasPrice":
+			response = `{"jsonrpc":"2.0","id":1,"result":"0xd"}`
+		case "eth_getBlockByNumber":
+			response = blockResponse
+		case "eth_getTransactionCount":
+			// another tx from the vault is in the mempool already
+			response = `{"jsonrpc":"2.0","id":1,"result":"0x0"}`
+			if strings.Contains(string(rpcRequest.Params), "pending") {
+				response = `{"jsonrpc":"2.0","id":1,"result":"0x5"}`
+			}
+		case "eth_sendRawTransaction":
+			sendCalls++
+			response = `{"jsonrpc":"2.0","id":1,"result":"0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"}`
+			if collide {
+				collide = false
+				response = `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"nonce too low"}}`
+			}
+		}
+		_, err = rw.Write([]byte(response))
+		c.Assert(err, IsNil)
+	}))
+	defer server.Close()
+	e, err := NewClient(s.thorKeys, config.ChainConfiguration{
+		RPCHost: server.URL,
+		BlockScanner: config.BlockScannerConfiguration{
+			StartBlockHeight: 1, // avoids querying thorchain for block height
+		},
+	}, nil, s.bridge, s.m, pubkeymanager.NewMockPoolAddressValidator())
+	c.Assert(err, IsNil)
+	c.Assert(e, NotNil)
+
+	asset, err := common.NewAsset("ETH.TKN-" + tokenContract)
+	c.Assert(err, IsNil)
+	out := stypes.TxOutItem{
+		Chain:       common.ETHChain,
+		ToAddress:   common.Address(account),
+		VaultPubKey: e.kw.GetPubKey(),
+		Coins:       common.Coins{common.NewCoin(asset, sdk.NewUint(194765912))},
+		Memo:        "OUTBOUND:abc",
+	}
+
+	// a tx can't transfer more than one token
+	multi := out
+	multi.Coins = append(multi.Coins, common.NewCoin(common.ETHAsset, sdk.NewUint(1)))
+	r, err := e.SignTx(multi, 1)
+	c.Assert(err, NotNil)
+	c.Assert(r, IsNil)
+
+	r, err = e.SignTx(out, 1)
+	c.Assert(err, IsNil)
+	c.Assert(r, NotNil)
+	tx := &etypes.Transaction{}
+	c.Assert(json.Unmarshal(r, tx), IsNil)
+	c.Check(tx.Nonce(), Equals, uint64(0))
+	c.Check(*tx.To(), Equals, ecommon.HexToAddress(tokenContract))
+	c.Check(tx.Value().Int64(), Equals, int64(0))
+	c.Check(tx.Data(), DeepEquals, newERC20TransferData(ecommon.HexToAddress(account), big.NewInt(194765912), hex.EncodeToString([]byte(out.Memo))))
+	c.Check(tx.Gas() > ERC20TransferGas, Equals, true)
+
+	// the nonce has been taken by another tx, the cached nonce is refreshed from the pending state
+	c.Assert(e.BroadcastTx(out, r), NotNil)
+	c.Check(e.accts.Get(out.VaultPubKey).Nonce, Equals, uint64(5))
+
+	// the retry signs the tx again with the refreshed nonce
+	r, err = e.SignTx(out, 1)
+	c.Assert(err, IsNil)
+	tx = &etypes.Transaction{}
+	c.Assert(json.Unmarshal(r, tx), IsNil)
+	c.Check(tx.Nonce(), Equals, uint64(5))
+	c.Assert(e.BroadcastTx(out, r), IsNil)
+	c.Check(e.accts.Get(out.VaultPubKey).Nonce, Equals, uint64(6))
+	c.Check(sendCalls, Equals, 2)
+}
@@ -1,48 +1,64 @@
 package chainclients
 
 import (
+	"sort"
+
 	"github.com/rs/zerolog/log"
 	"gitlab.com/thorchain/thornode/bifrost/config"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/binance"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/bitcoin"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/ethereum"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/tss/go-tss/tss"
 )
 
+// chainClientLoader create the chain client of a chain from its configuration
+type chainClientLoader func(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tss.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) (ChainClient, error)
+
+// chainClientLoaders are the chains bifrost has a chain client for
+var chainClientLoaders = map[common.Chain]chainClientLoader{
+	common.BNBChain: func(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tss.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) (ChainClient, error) {
+		return binance.NewBinance(thorKeys, cfg, server, thorchainBridge, m)
+	},
+	common.ETHChain: func(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tss.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) (ChainClient, error) {
+		return ethereum.NewClient(thorKeys, cfg, server, thorchainBridge, m, pubkeyMgr)
+	},
+	common.BTCChain: func(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tss.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) (ChainClient, error) {
+		return bitcoin.NewClient(thorKeys, cfg, server, thorchainBridge, m)
+	},
+}
+
+// RegisteredChains return the chains LoadChains can create a chain client for
+func RegisteredChains() common.Chains {
+	chains := make(common.Chains, 0, len(chainClientLoaders))
+	for chain := range chainClientLoaders {
+		chains = append(chains, chain)
+	}
+	sort.SliceStable(chains, func(i, j int) bool {
+		return chains[i].String() < chains[j].String()
+	})
+	return chains
+}
+
 // LoadChains returns chain clients from chain configuration
-func LoadChains(thorKeys *thorclient.Keys, cfg []config.ChainConfiguration, server *tss.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics) map[common.Chain]ChainClient {
+func LoadChains(thorKeys *thorclient.Keys, cfg []config.ChainConfiguration, server *tss.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics, pubkeyMgr pubkeymanager.PubKeyValidator) map[common.Chain]ChainClient {
 	logger := log.Logger.With().Str("module", "bifrost").Logger()
 	chains := make(map[common.Chain]ChainClient, 0)
 
 	for _, chain := range cfg {
-		switch chain.ChainID {
-		case common.BNBChain:
-			bnb, err := binance.NewBinance(thorKeys, chain, server, thorchainBridge, m)
-			if err != nil {
-				logger.Error().Err(err).Str("chain_id", chain.ChainID.String()).Msg("fail to load chain")
-				continue
-			}
-			chains[common.BNBChain] = bnb
-		case common.ETHChain:
-			eth, err := ethereum.NewClient(thorKeys, chain, server, thorchainBridge, m)
-			if err != nil {
-				logger.Error().Err(err).Str("chain_id", chain.ChainID.String()).Msg("fail to load chain")
-				continue
-			}
-			chains[common.ETHChain] = eth
-		case common.BTCChain:
-			btc, err := bitcoin.NewClient(thorKeys, chain, server, thorchainBridge, m)
-			if err != nil {
-				logger.Error().Err(err).Str("chain_id", chain.ChainID.String()).Msg("fail to load chain")
-				continue
-			}
-			chains[common.BTCChain] = btc
-		default:
+		load, ok := chainClientLoaders[chain.ChainID]
+		if !ok {
+			continue
+		}
+		client, err := load(thorKeys, chain, server, thorchainBridge, m, pubkeyMgr)
+		if err != nil {
+			logger.Error().Err(err).Str("chain_id", chain.ChainID.String()).Msg("fail to load chain")
 			continue
 		}
+		chains[chain.ChainID] = client
 	}
 
 	return chains
@@ -0,0 +1,87 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// HealthPath is the path on the metric server the signer health is exposed at
+const HealthPath = "/signer/health"
+
+// HealthCheck make sure the signer is able to do its job, all the chain clients should be able to reach their chain
+// node, the pubkey manager should have at least one key, thorchain should be reachable and the vaults should hold at
+// least the balance thorchain expects
+func (s *Signer) HealthCheck() error {
+	for chain, client := range s.chains {
+		if err := client.Ping(); err != nil {
+			return fmt.Errorf("fail to reach %s node: %w", chain, err)
+		}
+	}
+	if len(s.pubkeyMgr.GetPubKeys()) == 0 {
+		return errors.New("pubkey manager doesn't have any key")
+	}
+	if _, err := s.thorchainBridge.GetBlockHeight(); err != nil {
+		return fmt.Errorf("fail to reach thorchain: %w", err)
+	}
+	return s.checkVaultBalances()
+}
+
+// checkVaultBalances compare the balance of the asgard vaults this node manage with the balance thorchain expects them
+// to have, only chain clients able to compute an address balance are checked
+func (s *Signer) checkVaultBalances() error {
+	checkers := make(map[common.Chain]chainclients.AddressBalanceChecker)
+	for chain, client := range s.chains {
+		if checker, ok := client.(chainclients.AddressBalanceChecker); ok {
+			checkers[chain] = checker
+		}
+	}
+	if len(checkers) == 0 {
+		return nil
+	}
+	vaults, err := s.thorchainBridge.GetAsgards()
+	if err != nil {
+		return fmt.Errorf("fail to get asgard vaults: %w", err)
+	}
+	pubKeys := s.pubkeyMgr.GetPubKeys()
+	for _, vault := range vaults {
+		if !pubKeys.Contains(vault.PubKey) {
+			continue
+		}
+		for chain, checker := range checkers {
+			expected := vault.Coins.GetCoin(chain.GetGasAsset()).Amount
+			if expected.IsZero() {
+				continue
+			}
+			addr := s.chains[chain].GetAddress(vault.PubKey)
+			balance, err := checker.GetAddressBalance(addr)
+			if err != nil {
+				return fmt.Errorf("fail to get %s balance of vault(%s): %w", chain, vault.PubKey, err)
+			}
+			if balance.LT(expected) {
+				return fmt.Errorf("%s balance of vault(%s) is %s, thorchain expects %s", chain, vault.PubKey, balance, expected)
+			}
+		}
+	}
+	return nil
+}
+
+// handleHealth respond with 200 when the signer is healthy, otherwise 503 with the reason
+func (s *Signer) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.HealthCheck(); err != nil {
+		s.logger.Error().Err(err).Msg("signer is not healthy")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte("OK")); err != nil {
+		s.logger.Error().Err(err).Msg("fail to write signer health")
+	}
+}
@@ -0,0 +1,134 @@
+package signer
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/config"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
+	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MockUnreachableChainClient struct {
+	MockChainClient
+}
+
+func (b *MockUnreachableChainClient) Ping() error {
+	return errors.New("connection refused")
+}
+
+type MockBalanceChainClient struct {
+	MockChainClient
+	balance sdk.Uint
+}
+
+func (b *MockBalanceChainClient) GetAddressBalance(addr string) (sdk.Uint, error) {
+	return b.balance, nil
+}
+
+// signPubKeyValidator report the sign pubkeys of the mock as the pubkeys this node holds
+type signPubKeyValidator struct {
+	*pubkeymanager.MockPoolAddressValidator
+}
+
+func (v signPubKeyValidator) GetPubKeys() common.PubKeys { return v.GetSignPubKeys() }
+
+func (s *SignSuite) TestHealthCheck(c *C) {
+	sign := &Signer{
+		logger: log.With().Str("module", "signer").Logger(),
+		chains: map[common.Chain]chainclients.ChainClient{
+			common.BNBChain: &MockChainClient{},
+		},
+		pubkeyMgr:       signPubKeyValidator{pubkeymanager.NewMockPoolAddressValidator()},
+		thorchainBridge: s.bridge,
+	}
+	c.Check(sign.HealthCheck(), IsNil)
+	rec := httptest.NewRecorder()
+	sign.handleHealth(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+	c.Check(rec.Code, Equals, http.StatusOK)
+
+	rec = httptest.NewRecorder()
+	sign.handleHealth(rec, httptest.NewRequest(http.MethodPost, HealthPath, nil))
+	c.Check(rec.Code, Equals, http.StatusMethodNotAllowed)
+
+	// pubkey manager without any key
+	sign.pubkeyMgr = pubkeymanager.NewMockPoolAddressValidator()
+	c.Check(sign.HealthCheck(), NotNil)
+	rec = httptest.NewRecorder()
+	sign.handleHealth(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+	c.Check(rec.Code, Equals, http.StatusServiceUnavailable)
+
+	// chain node not reachable
+	sign.pubkeyMgr = signPubKeyValidator{pubkeymanager.NewMockPoolAddressValidator()}
+	sign.chains[common.BTCChain] = &MockUnreachableChainClient{}
+	c.Check(sign.HealthCheck(), NotNil)
+	rec = httptest.NewRecorder()
+	sign.handleHealth(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+	c.Check(rec.Code, Equals, http.StatusServiceUnavailable)
+}
+
+func (s *SignSuite) TestHealthCheckVaultBalances(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if strings.HasPrefix(req.RequestURI, "/thorchain/lastblock") {
+			_, err := rw.Write([]byte(`{ "chain": "BTC", "lastobservedin": "0", "lastsignedout": "0", "statechain": "1" }`))
+			c.Assert(err, IsNil)
+		} else if strings.HasPrefix(req.RequestURI, "/thorchain/vaults/asgard") {
+			_, err := rw.Write([]byte(`[
+			{
+				"block_height": "0",
+				"pub_key": "thorpub1addwnpepqfgfxharps79pqv8fv9ndqh90smw8c3slrtrssn58ryc5g3p9sx856x07yn",
+				"coins": [ { "asset": "BTC.BTC", "amount": "100000000" } ],
+				"type": "asgard",
+				"status": "active",
+				"chains": [ "BTC" ]
+			},
+			{
+				"block_height": "0",
+				"pub_key": "thorpub1addwnpepqflvfv08t6qt95lmttd6wpf3ss8wx63e9vf6fvyuj2yy6nnyna5763e2kck",
+				"coins": [ { "asset": "BTC.BTC", "amount": "500000000" } ],
+				"type": "asgard",
+				"status": "active",
+				"chains": [ "BTC" ]
+			}
+		]`))
+			c.Assert(err, IsNil)
+		}
+	}))
+	defer server.Close()
+	bridge, err := thorclient.NewThorchainBridge(config.ClientConfiguration{
+		ChainID:         "thorchain",
+		ChainHost:       server.Listener.Addr().String(),
+		SignerName:      "bob",
+		SignerPasswd:    "password",
+		ChainHomeFolder: s.thordir,
+	}, s.m)
+	c.Assert(err, IsNil)
+
+	// only the vault this node is a member of is checked
+	client := &MockBalanceChainClient{balance: sdk.NewUint(100000000)}
+	sign := &Signer{
+		logger: log.With().Str("module", "signer").Logger(),
+		chains: map[common.Chain]chainclients.ChainClient{
+			common.BNBChain: &MockChainClient{},
+			common.BTCChain: client,
+		},
+		pubkeyMgr:       signPubKeyValidator{pubkeymanager.NewMockPoolAddressValidator()},
+		thorchainBridge: bridge,
+	}
+	c.Check(sign.HealthCheck(), IsNil)
+
+	// vault holds less than thorchain expects
+	client.balance = sdk.NewUint(99999999)
+	c.Check(sign.HealthCheck(), NotNil)
+	rec := httptest.NewRecorder()
+	sign.handleHealth(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+	c.Check(rec.Code, Equals, http.StatusServiceUnavailable)
+}
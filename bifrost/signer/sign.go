@@ -1,10 +1,12 @@
 package signer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/blang/semver"
@@ -29,6 +31,17 @@ import (
 	ttypes "gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
+const (
+	// keygenBroadcastAttempts is the maximum number of times the signer try to send the keygen result to thorchain
+	keygenBroadcastAttempts = 5
+	// maxConfirmationCheckFailures is the number of times in a row the chain has to report a broadcast tx as not found
+	// before the signer consider it dropped, and sign the tx out item again
+	maxConfirmationCheckFailures = 300
+)
+
+// keygenBroadcastInterval is how long the signer wait between two attempts to send the keygen result to thorchain
+var keygenBroadcastInterval = 2 * time.Second
+
 // Signer will pull the tx out from thorchain and then forward it to chain
 type Signer struct {
 	logger                zerolog.Logger
@@ -44,6 +57,8 @@ type Signer struct {
 	errCounter            *prometheus.CounterVec
 	tssKeygen             *tss.KeyGen
 	pubkeyMgr             pubkeymanager.PubKeyValidator
+	lastSignedHeight      int64
+	lastKeygenHeight      int64
 }
 
 // NewSigner create a new instance of signer
@@ -74,13 +89,10 @@ func NewSigner(cfg config.SignerConfiguration,
 		time.Sleep(5 * time.Second)
 		fmt.Println("Waiting for node account to be registered...")
 	}
-	for _, item := range na.SignerMembership {
-		pubkeyMgr.AddPubKey(item, true)
-	}
 	if na.PubKeySet.Secp256k1.IsEmpty() {
 		return nil, fmt.Errorf("unable to find pubkey for this node account. Exiting...")
 	}
-	pubkeyMgr.AddNodePubKey(na.PubKeySet.Secp256k1)
+	addSignPubKeys(thorchainBridge, na, pubkeyMgr)
 
 	cfg.BlockScanner.ChainID = common.THORChain // hard code to thorchain
 
@@ -100,7 +112,7 @@ func NewSigner(cfg config.SignerConfiguration,
 		return nil, fmt.Errorf("fail to create Tss Key gen,err:%w", err)
 	}
 
-	return &Signer{
+	signer := &Signer{
 		logger:                log.With().Str("module", "signer").Logger(),
 		cfg:                   cfg,
 		wg:                    &sync.WaitGroup{},
@@ -114,7 +126,14 @@ func NewSigner(cfg config.SignerConfiguration,
 		pubkeyMgr:             pubkeyMgr,
 		thorchainBridge:       thorchainBridge,
 		tssKeygen:             kg,
-	}, nil
+	}
+	if err := m.HandleFunc(SigningStatusPath, signer.handleSigningStatus); err != nil {
+		return nil, fmt.Errorf("fail to register signing status handler: %w", err)
+	}
+	if err := m.HandleFunc(HealthPath, signer.handleHealth); err != nil {
+		return nil, fmt.Errorf("fail to register signer health handler: %w", err)
+	}
+	return signer, nil
 }
 
 func (s *Signer) getChain(chainID common.Chain) (chainclients.ChainClient, error) {
@@ -127,6 +146,12 @@ func (s *Signer) getChain(chainID common.Chain) (chainclients.ChainClient, error
 }
 
 func (s *Signer) Start() error {
+	// a node that is not a member of any vault yet has no key to sign with, it is reported as unhealthy on the
+	// signing status endpoint until it gets one through keygen, rather than refusing to start
+	if !s.GetSigningStatus().IsHealthy() {
+		s.logger.Warn().Msg("signer has no active key to sign with")
+	}
+
 	s.wg.Add(1)
 	go s.processTxnOut(s.thorchainBlockScanner.GetTxOutMessages(), 1)
 
@@ -175,15 +200,32 @@ func (s *Signer) processTransactions() {
 					if item.Status == TxSpent { // don't rebroadcast spent transactions
 						continue
 					}
+					if item.Status == TxBroadcast { // don't rebroadcast , remove it from our store once it get confirmed
+						s.checkBroadcastItem(item)
+						continue
+					}
 
 					s.logger.Info().Msgf("Signing transaction (Num: %d | Height: %d | Status: %d): %+v", i, item.Height, item.Status, item.TxOutItem)
-					if err := s.signAndBroadcast(item); err != nil {
+					txID, err := s.signAndBroadcast(item)
+					if err != nil {
 						s.logger.Error().Err(err).Msg("fail to sign and broadcast tx out store item")
+						// mark the item, so it is counted in the retry queue of the signing status
+						item.Status = TxUnavailable
+						item.RetryCount++
+						if err := s.storage.Set(item); err != nil {
+							s.logger.Error().Err(err).Msg("fail to update tx out store item")
+						}
 						return
 					}
+					s.setLastSignedHeight(item.Height)
 
 					// We have a successful broadcast! Remove the item from our store
 					item.Status = TxSpent
+					if len(txID) > 0 {
+						// the chain is able to check confirmations , keep the item until the tx get confirmed
+						item.Status = TxBroadcast
+						item.TxID = txID
+					}
 					if err := s.storage.Set(item); err != nil {
 						s.logger.Error().Err(err).Msg("fail to update tx out store item")
 					}
@@ -219,6 +261,66 @@ func (s *Signer) processTxnOut(ch <-chan types.TxOut, idx int) {
 	}
 }
 
+// addSignPubKeys bootstrap the pubkey manager with the active vaults the given node account is a member of, fall back
+// to the node account signer membership
+func addSignPubKeys(thorchainBridge *thorclient.ThorchainBridge, na *ttypes.NodeAccount, pubkeyMgr pubkeymanager.PubKeyValidator) {
+	vaults, err := thorchainBridge.GetVaults(string(ttypes.ActiveVault))
+	if err != nil {
+		log.Error().Err(err).Msg("fail to get active vaults from thorchain, fallback to signer membership")
+	}
+	for _, vault := range vaults {
+		if vault.Contains(na.PubKeySet.Secp256k1) {
+			pubkeyMgr.AddPubKey(vault.PubKey, true)
+		}
+	}
+	for _, item := range na.SignerMembership {
+		pubkeyMgr.AddPubKey(item, true)
+	}
+	pubkeyMgr.AddNodePubKey(na.PubKeySet.Secp256k1)
+}
+
+// RetryTxOutAtHeight fetch the tx out items at the given block height that need to be signed by the vaults this node
+// is a member of from thorchain again, and save them to the signer storage, so they will be signed and broadcast again
+// once the signer starts. The signer must not be running, as it holds the signer storage
+func RetryTxOutAtHeight(cfg config.SignerConfiguration,
+	thorchainBridge *thorclient.ThorchainBridge,
+	thorKeys *thorclient.Keys,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	m *metrics.Metrics,
+	height int64) (int, error) {
+	na, err := thorchainBridge.GetNodeAccount(thorKeys.GetSignerInfo().GetAddress().String())
+	if err != nil {
+		return 0, fmt.Errorf("fail to get node account from thorchain: %w", err)
+	}
+	addSignPubKeys(thorchainBridge, na, pubkeyMgr)
+
+	storage, err := NewSignerStore(cfg.SignerDbPath, thorchainBridge.GetConfig().SignerPasswd)
+	if err != nil {
+		return 0, fmt.Errorf("fail to open signer storage: %w", err)
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			log.Error().Err(err).Msg("fail to close signer storage")
+		}
+	}()
+	thorchainBlockScanner, err := NewThorchainBlockScan(cfg.BlockScanner, storage, thorchainBridge, m, pubkeyMgr)
+	if err != nil {
+		return 0, fmt.Errorf("fail to create thorchain block scan: %w", err)
+	}
+	txOut, err := thorchainBlockScanner.GetTxOutAtHeight(height)
+	if err != nil {
+		return 0, fmt.Errorf("fail to get tx out at height(%d): %w", height, err)
+	}
+	items := make([]TxOutStoreItem, len(txOut.TxArray))
+	for i, tx := range txOut.TxArray {
+		items[i] = NewTxOutStoreItem(txOut.Height, tx.TxOutItem())
+	}
+	if err := storage.Batch(items); err != nil {
+		return 0, fmt.Errorf("fail to save tx out items to storage: %w", err)
+	}
+	return len(items), nil
+}
+
 func (s *Signer) processKeygen(ch <-chan ttypes.KeygenBlock) {
 	s.logger.Info().Msg("start to process keygen")
 	defer s.logger.Info().Msg("stop to process keygen")
@@ -253,16 +355,48 @@ func (s *Signer) processKeygen(ch <-chan ttypes.KeygenBlock) {
 					s.pubkeyMgr.AddPubKey(pubKey.Secp256k1, true)
 				}
 
-				if err := s.sendKeygenToThorchain(keygenBlock.Height, pubKey.Secp256k1, blame, keygenReq.Members, keygenReq.Type); err != nil {
+				if err := s.processKeygenResult(keygenBlock.Height, pubKey.Secp256k1, blame, keygenReq.Members, keygenReq.Type); err != nil {
 					s.errCounter.WithLabelValues("fail_to_broadcast_keygen", "").Inc()
 					s.logger.Error().Err(err).Msg("fail to broadcast keygen")
+				} else {
+					atomic.StoreInt64(&s.lastKeygenHeight, keygenBlock.Height)
 				}
 			}
 		}
 	}
 }
 
-func (s *Signer) sendKeygenToThorchain(height int64, poolPk common.PubKey, blame blame.Blame, input common.PubKeys, keygenType ttypes.KeygenType) error {
+// processKeygenResult send the keygen result back to thorchain, it retries a few times before giving up, because
+// when the keygen result doesn't make it to thorchain the vault rotation will not happen
+func (s *Signer) processKeygenResult(height int64, poolPk common.PubKey, blame blame.Blame, input common.PubKeys, keygenType ttypes.KeygenType) error {
+	// cancel any in flight broadcast retry when the signer is stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	var err error
+	for i := 0; i < keygenBroadcastAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-s.stopChan:
+				return fmt.Errorf("signer stopped before keygen result is sent to thorchain: %w", err)
+			case <-time.After(keygenBroadcastInterval):
+			}
+		}
+		if err = s.sendKeygenToThorchain(ctx, height, poolPk, blame, input, keygenType); err == nil {
+			return nil
+		}
+		s.logger.Error().Err(err).Int("attempt", i+1).Msg("fail to send keygen result to thorchain")
+	}
+	return fmt.Errorf("fail to send keygen result to thorchain after %d attempts: %w", keygenBroadcastAttempts, err)
+}
+
+func (s *Signer) sendKeygenToThorchain(ctx context.Context, height int64, poolPk common.PubKey, blame blame.Blame, input common.PubKeys, keygenType ttypes.KeygenType) error {
 	// collect supported chains in the configuration
 	chains := make(common.Chains, 0)
 	for name, chain := range s.chains {
@@ -277,7 +411,7 @@ func (s *Signer) sendKeygenToThorchain(height int64, poolPk common.PubKey, blame
 		s.errCounter.WithLabelValues("fail_to_sign", strHeight).Inc()
 		return fmt.Errorf("fail to sign the tx: %w", err)
 	}
-	txID, err := s.thorchainBridge.Broadcast(*stdTx, types.TxSync)
+	txID, err := s.thorchainBridge.BroadcastWithContext(ctx, *stdTx, types.TxSync)
 	if err != nil {
 		s.errCounter.WithLabelValues("fail_to_send_to_thorchain", strHeight).Inc()
 		return fmt.Errorf("fail to send the tx to thorchain: %w", err)
@@ -287,34 +421,35 @@ func (s *Signer) sendKeygenToThorchain(height int64, poolPk common.PubKey, blame
 }
 
 // signAndBroadcast retry a few times before THORNode move on to he next block
-func (s *Signer) signAndBroadcast(item TxOutStoreItem) error {
+// It returns the id of the broadcast tx when the chain is able to check its confirmations
+func (s *Signer) signAndBroadcast(item TxOutStoreItem) (string, error) {
 	height := item.Height
 	tx := item.TxOutItem
 	blockHeight, err := s.thorchainBridge.GetBlockHeight()
 	if err != nil {
 		s.logger.Error().Err(err).Msgf("fail to get block height")
-		return err
+		return "", err
 	}
 	// TODO hardcode it as 0.1.0 for now, will need to get it appropriately later
 	cv := constants.GetConstantValues(semver.MustParse("0.1.0"))
 	if blockHeight-height > cv.GetInt64Value(constants.SigningTransactionPeriod) {
 		s.logger.Error().Msgf("tx was created at block height(%d), now it is (%d), it is older than (%d) blocks , skip it ", height, blockHeight, cv.GetInt64Value(constants.SigningTransactionPeriod))
-		return nil
+		return "", nil
 	}
 	chain, err := s.getChain(tx.Chain)
 	if err != nil {
 		s.logger.Error().Err(err).Msgf("not supported %s", tx.Chain.String())
-		return err
+		return "", err
 	}
 
 	if !s.shouldSign(tx) {
 		s.logger.Info().Str("signer_address", chain.GetAddress(tx.VaultPubKey)).Msg("different pool address, ignore")
-		return fmt.Errorf("not a member of the vault pubkey")
+		return "", fmt.Errorf("not a member of the vault pubkey")
 	}
 
 	if len(tx.ToAddress) == 0 {
 		s.logger.Info().Msg("To address is empty, THORNode don't know where to send the fund , ignore")
-		return nil // return nil and discard item
+		return "", nil // return nil and discard item
 	}
 
 	// Check if we're sending all funds back , given we don't have memo in txoutitem anymore, so it rely on the coins field to be empty
@@ -323,7 +458,7 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) error {
 		tx, err = s.handleYggReturn(height, tx)
 		if err != nil {
 			s.logger.Error().Err(err).Msg("failed to handle yggdrasil return")
-			return err
+			return "", err
 		}
 	}
 
@@ -334,7 +469,7 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) error {
 
 	if !tx.OutHash.IsEmpty() {
 		s.logger.Info().Str("OutHash", tx.OutHash.String()).Msg("tx had been sent out before")
-		return nil // return nil and discard item
+		return "", nil // return nil and discard item
 	}
 
 	// We get the keysign object from thorchain again to ensure it hasn't
@@ -344,14 +479,14 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) error {
 	txOut, err := s.thorchainBridge.GetKeysign(height, tx.VaultPubKey.String())
 	if err != nil {
 		s.logger.Error().Err(err).Msg("fail to get keysign items")
-		return err
+		return "", err
 	}
 	for _, out := range txOut.Chains {
 		for _, txArray := range out.TxArray {
 			if txArray.TxOutItem().Equals(tx) && !txArray.OutHash.IsEmpty() {
 				// already been signed, we can skip it
 				s.logger.Info().Str("tx_id", tx.OutHash.String()).Msgf("already signed. skipping...")
-				return nil
+				return "", nil
 			}
 		}
 	}
@@ -359,20 +494,80 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) error {
 	signedTx, err := chain.SignTx(tx, height)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("fail to sign tx")
-		return err
+		return "", err
 	}
 
 	// looks like the transaction is already signed
 	if len(signedTx) == 0 {
-		return nil
+		return "", nil
 	}
 
 	if err := chain.BroadcastTx(tx, signedTx); err != nil {
 		s.logger.Error().Err(err).Msg("fail to broadcast tx to chain")
-		return err
+		return "", err
 	}
 
-	return nil
+	checker, ok := chain.(chainclients.TxConfirmationChecker)
+	if !ok {
+		return "", nil
+	}
+	txID, err := checker.GetTxID(signedTx)
+	if err != nil {
+		// the tx has been broadcast already , don't sign it again
+		s.logger.Error().Err(err).Msg("fail to get the tx id of the broadcast tx")
+		return "", nil
+	}
+	return txID, nil
+}
+
+// checkBroadcastItem mark the given broadcast item as spent once its tx get confirmed. When the chain keep reporting
+// the tx as not found, it can never make it to the chain, the item is made available again so it get signed again.
+// Any other error is not counted, as the tx might still get mined , signing it again could pay the same outbound twice
+func (s *Signer) checkBroadcastItem(item TxOutStoreItem) {
+	confirmed, err := s.isTxConfirmed(item)
+	switch {
+	case err != nil && !errors.Is(err, types.ErrTxNotFound):
+		s.logger.Error().Err(err).Str("tx_id", item.TxID).Msg("fail to check tx confirmations")
+		return
+	case err != nil:
+		s.logger.Error().Err(err).Str("tx_id", item.TxID).Msg("tx is not found")
+		item.CheckFailures++
+		if item.CheckFailures >= maxConfirmationCheckFailures {
+			s.logger.Info().Str("tx_id", item.TxID).Msg("tx dropped from mempool, sign it again")
+			item.Status = TxAvailable
+			item.TxID = ""
+			item.CheckFailures = 0
+		}
+	case confirmed:
+		item.Status = TxSpent
+	case item.CheckFailures > 0:
+		item.CheckFailures = 0
+	default:
+		return
+	}
+	if err := s.storage.Set(item); err != nil {
+		s.logger.Error().Err(err).Msg("fail to update tx out store item")
+	}
+}
+
+// isTxConfirmed check whether the broadcast tx of the given item has been confirmed on chain
+func (s *Signer) isTxConfirmed(item TxOutStoreItem) (bool, error) {
+	chain, err := s.getChain(item.TxOutItem.Chain)
+	if err != nil {
+		return false, fmt.Errorf("not supported %s: %w", item.TxOutItem.Chain, err)
+	}
+	checker, ok := chain.(chainclients.TxConfirmationChecker)
+	if !ok {
+		return true, nil
+	}
+	confirmed, confirmations, err := checker.CheckTxConfirmations(item.TxID, s.cfg.MinConfirmations)
+	if err != nil {
+		return false, err
+	}
+	if confirmed {
+		s.logger.Info().Str("tx_id", item.TxID).Msgf("tx confirmed (%d confirmations)", confirmations)
+	}
+	return confirmed, nil
 }
 
 func (s *Signer) handleYggReturn(height int64, tx types.TxOutItem) (types.TxOutItem, error) {
@@ -2,6 +2,9 @@ package signer
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -18,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
 	"github.com/tendermint/tendermint/crypto"
+	"gitlab.com/thorchain/tss/go-tss/blame"
 
 	. "gopkg.in/check.v1"
 
@@ -224,6 +228,10 @@ func (b *MockChainClient) Start(globalTxsQueue chan stypes.TxIn, globalErrataQue
 
 func (b *MockChainClient) Stop() {}
 
+func (b *MockChainClient) Ping() error {
+	return nil
+}
+
 func (s *SignSuite) TestHandleYggReturn_Success_FeeSingleton(c *C) {
 	sign := &Signer{
 		chains: map[common.Chain]chainclients.ChainClient{
@@ -346,3 +354,204 @@ func (s *SignSuite) TestProcess(c *C) {
 	time.Sleep(time.Second * 2)
 	go sign.Stop()
 }
+
+func (s *SignSuite) TestProcessKeygenResult(c *C) {
+	oldInterval := keygenBroadcastInterval
+	keygenBroadcastInterval = time.Millisecond
+	defer func() { keygenBroadcastInterval = oldInterval }()
+
+	cdc := thorclient.MakeCodec()
+	var payloads []stypes.SetTx
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		fixture := ""
+		switch {
+		case strings.HasPrefix(req.RequestURI, thorclient.LastBlockEndpoint):
+			fixture = "../../test/fixtures/endpoints/lastblock/bnb.json"
+		case strings.HasPrefix(req.RequestURI, thorclient.AuthAccountEndpoint):
+			fixture = "../../test/fixtures/endpoints/auth/accounts/template.json"
+		case strings.HasPrefix(req.RequestURI, thorclient.BroadcastTxsEndpoint):
+			buf, err := ioutil.ReadAll(req.Body)
+			c.Assert(err, IsNil)
+			var setTx stypes.SetTx
+			c.Assert(cdc.UnmarshalJSON(buf, &setTx), IsNil)
+			payloads = append(payloads, setTx)
+			// reject the first attempts , the signer should try again
+			fixture = "../../test/fixtures/endpoints/txs/success.json"
+			if len(payloads) <= failures {
+				fixture = "../../test/fixtures/endpoints/txs/bad_seq_num.json"
+			}
+		}
+		content, err := ioutil.ReadFile(fixture)
+		c.Assert(err, IsNil, Commentf("%s", req.RequestURI))
+		_, err = rw.Write(content)
+		c.Assert(err, IsNil)
+	}))
+	defer server.Close()
+
+	bridge, err := thorclient.NewThorchainBridge(config.ClientConfiguration{
+		ChainID:         "thorchain",
+		ChainHost:       server.Listener.Addr().String(),
+		SignerName:      "bob",
+		SignerPasswd:    "password",
+		ChainHomeFolder: s.thordir,
+	}, s.m)
+	c.Assert(err, IsNil)
+	sign := &Signer{
+		logger:          log.With().Str("module", "signer").Logger(),
+		stopChan:        make(chan struct{}),
+		chains:          map[common.Chain]chainclients.ChainClient{common.BNBChain: &MockChainClient{}},
+		m:               s.m,
+		errCounter:      s.m.GetCounterVec(metrics.SignerError),
+		thorchainBridge: bridge,
+	}
+
+	poolPubKey := thorchain.GetRandomPubKey()
+	members := common.PubKeys{thorchain.GetRandomPubKey(), thorchain.GetRandomPubKey(), thorchain.GetRandomPubKey()}
+	c.Assert(sign.processKeygenResult(1024, poolPubKey, blame.Blame{}, members, types2.AsgardKeygen), IsNil)
+	c.Assert(payloads, HasLen, 2)
+	for _, payload := range payloads {
+		c.Assert(payload.Tx.Msg, HasLen, 1)
+		c.Assert(payload.Tx.Signatures, HasLen, 1)
+		msg, ok := payload.Tx.Msg[0].(types2.MsgTssPool)
+		c.Assert(ok, Equals, true)
+		c.Check(msg.PoolPubKey.Equals(poolPubKey), Equals, true)
+		c.Check(msg.PubKeys, DeepEquals, members)
+		c.Check(msg.Height, Equals, int64(1024))
+		c.Check(msg.KeygenType, Equals, types2.AsgardKeygen)
+		c.Check(msg.Chains, DeepEquals, common.Chains{common.BNBChain})
+		c.Check(msg.Signer.Equals(s.thorKeys.GetSignerInfo().GetAddress()), Equals, true)
+	}
+
+	// give up once all the attempts fail
+	payloads = payloads[:0]
+	failures = keygenBroadcastAttempts
+	c.Assert(sign.processKeygenResult(1024, poolPubKey, blame.Blame{}, members, types2.AsgardKeygen), NotNil)
+	c.Assert(payloads, HasLen, keygenBroadcastAttempts)
+}
+
+type MockConfirmationChainClient struct {
+	MockChainClient
+	confirmations int
+}
+
+func (b *MockConfirmationChainClient) GetTxID(payload []byte) (string, error) {
+	return string(payload), nil
+}
+
+func (b *MockConfirmationChainClient) CheckTxConfirmations(txID string, minConfirmations int64) (bool, int, error) {
+	if txID == "" {
+		return false, 0, errors.New("invalid tx id")
+	}
+	if txID == "dropped" {
+		return false, 0, fmt.Errorf("tx(%s): %w", txID, stypes.ErrTxNotFound)
+	}
+	return int64(b.confirmations) >= minConfirmations, b.confirmations, nil
+}
+
+func (s *SignSuite) TestIsTxConfirmed(c *C) {
+	client := &MockConfirmationChainClient{}
+	sign := &Signer{
+		logger: log.With().Str("module", "signer").Logger(),
+		cfg:    config.SignerConfiguration{MinConfirmations: 2},
+		chains: map[common.Chain]chainclients.ChainClient{
+			common.BNBChain: &MockChainClient{},
+			common.BTCChain: client,
+		},
+	}
+	item := NewTxOutStoreItem(10, stypes.TxOutItem{Chain: common.BTCChain})
+	item.Status = TxBroadcast
+	item.TxID = "whatever"
+	confirmed, err := sign.isTxConfirmed(item)
+	c.Assert(err, IsNil)
+	c.Check(confirmed, Equals, false)
+	client.confirmations = 1
+	confirmed, err = sign.isTxConfirmed(item)
+	c.Assert(err, IsNil)
+	c.Check(confirmed, Equals, false)
+	client.confirmations = 2
+	confirmed, err = sign.isTxConfirmed(item)
+	c.Assert(err, IsNil)
+	c.Check(confirmed, Equals, true)
+
+	// fail to check confirmations
+	item.TxID = ""
+	confirmed, err = sign.isTxConfirmed(item)
+	c.Assert(err, NotNil)
+	c.Check(confirmed, Equals, false)
+
+	// chain client can't check confirmations
+	item.TxOutItem.Chain = common.BNBChain
+	confirmed, err = sign.isTxConfirmed(item)
+	c.Assert(err, IsNil)
+	c.Check(confirmed, Equals, true)
+
+	// chain not supported
+	item.TxOutItem.Chain = common.ETHChain
+	confirmed, err = sign.isTxConfirmed(item)
+	c.Assert(err, NotNil)
+	c.Check(confirmed, Equals, false)
+}
+
+func (s *SignSuite) TestCheckBroadcastItem(c *C) {
+	client := &MockConfirmationChainClient{}
+	storage, err := NewSignerStore("", "")
+	c.Assert(err, IsNil)
+	defer storage.Close()
+	sign := &Signer{
+		logger:  log.With().Str("module", "signer").Logger(),
+		cfg:     config.SignerConfiguration{MinConfirmations: 1},
+		storage: storage,
+		chains: map[common.Chain]chainclients.ChainClient{
+			common.BTCChain: client,
+		},
+	}
+	item := NewTxOutStoreItem(10, stypes.TxOutItem{Chain: common.BTCChain})
+	item.Status = TxBroadcast
+	item.TxID = "whatever"
+	c.Assert(storage.Set(item), IsNil)
+
+	// not confirmed yet
+	sign.checkBroadcastItem(item)
+	item, err = storage.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.Status, Equals, TxBroadcast)
+
+	// fail to check the tx , it is not counted as the tx might still get mined
+	item.TxID = ""
+	item.CheckFailures = maxConfirmationCheckFailures - 1
+	c.Assert(storage.Set(item), IsNil)
+	sign.checkBroadcastItem(item)
+	item, err = storage.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.Status, Equals, TxBroadcast)
+	c.Check(item.CheckFailures, Equals, int64(maxConfirmationCheckFailures-1))
+
+	// the chain can't find the tx
+	item.TxID = "dropped"
+	item.CheckFailures = 0
+	c.Assert(storage.Set(item), IsNil)
+	sign.checkBroadcastItem(item)
+	item, err = storage.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.Status, Equals, TxBroadcast)
+	c.Check(item.CheckFailures, Equals, int64(1))
+
+	// dropped , sign it again
+	item.CheckFailures = maxConfirmationCheckFailures - 1
+	sign.checkBroadcastItem(item)
+	item, err = storage.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.Status, Equals, TxAvailable)
+	c.Check(item.TxID, Equals, "")
+	c.Check(item.CheckFailures, Equals, int64(0))
+
+	// confirmed
+	item.Status = TxBroadcast
+	item.TxID = "whatever"
+	client.confirmations = 1
+	sign.checkBroadcastItem(item)
+	item, err = storage.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.Status, Equals, TxSpent)
+}
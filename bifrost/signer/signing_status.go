@@ -0,0 +1,63 @@
+package signer
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+)
+
+// SigningStatusPath is the path on the metric server the signing status is exposed at
+const SigningStatusPath = "/signer/status"
+
+// SigningStatus is a snapshot of the signer state, used by operators to check whether the signer is healthy
+type SigningStatus struct {
+	ActiveKeys       int   `json:"active_keys"`
+	PendingTxOuts    int   `json:"pending_tx_outs"`
+	LastSignedHeight int64 `json:"last_signed_height"`
+	LastKeygenHeight int64 `json:"last_keygen_height"`
+	RetryQueueDepth  int   `json:"retry_queue_depth"`
+}
+
+// IsHealthy return true when the signer has at least one key to sign with
+func (ss SigningStatus) IsHealthy() bool {
+	return ss.ActiveKeys > 0
+}
+
+// GetSigningStatus return the current status of the signer
+func (s *Signer) GetSigningStatus() SigningStatus {
+	status := SigningStatus{
+		ActiveKeys:       len(s.pubkeyMgr.GetSignPubKeys()),
+		LastSignedHeight: atomic.LoadInt64(&s.lastSignedHeight),
+		LastKeygenHeight: atomic.LoadInt64(&s.lastKeygenHeight),
+	}
+	for _, item := range s.storage.List() {
+		status.PendingTxOuts++
+		if item.Status == TxUnavailable {
+			status.RetryQueueDepth++
+		}
+	}
+	return status
+}
+
+// handleSigningStatus write the signing status as json, it responds with 503 when the signer is not healthy
+func (s *Signer) handleSigningStatus(w http.ResponseWriter, _ *http.Request) {
+	status := s.GetSigningStatus()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.IsHealthy() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		s.logger.Error().Err(err).Msg("fail to write signing status")
+	}
+}
+
+// setLastSignedHeight record the given height as the last signed height, unless a higher one has been recorded already
+// tx outs of different vaults are signed concurrently, so they might complete out of order
+func (s *Signer) setLastSignedHeight(height int64) {
+	for {
+		current := atomic.LoadInt64(&s.lastSignedHeight)
+		if height <= current || atomic.CompareAndSwapInt64(&s.lastSignedHeight, current, height) {
+			return
+		}
+	}
+}
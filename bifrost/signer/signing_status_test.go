@@ -0,0 +1,79 @@
+package signer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/rs/zerolog/log"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/common"
+	types2 "gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+type SigningStatusSuite struct{}
+
+var _ = Suite(&SigningStatusSuite{})
+
+type noSignKeyValidator struct {
+	*pubkeymanager.MockPoolAddressValidator
+}
+
+func (noSignKeyValidator) GetSignPubKeys() common.PubKeys { return nil }
+
+func (s *SigningStatusSuite) TestGetSigningStatus(c *C) {
+	storage, err := NewSignerStore("", "")
+	c.Assert(err, IsNil)
+	defer func() {
+		c.Assert(storage.Close(), IsNil)
+	}()
+	signer := &Signer{
+		logger:    log.With().Str("module", "signer").Logger(),
+		storage:   storage,
+		pubkeyMgr: pubkeymanager.NewMockPoolAddressValidator(),
+	}
+
+	status := signer.GetSigningStatus()
+	c.Check(status.ActiveKeys, Equals, 1)
+	c.Check(status.PendingTxOuts, Equals, 0)
+	c.Check(status.RetryQueueDepth, Equals, 0)
+	c.Check(status.IsHealthy(), Equals, true)
+
+	newItem := func(height int64) TxOutStoreItem {
+		return NewTxOutStoreItem(height, stypes.TxOutItem{
+			Chain:       common.BNBChain,
+			ToAddress:   types2.GetRandomBNBAddress(),
+			VaultPubKey: types2.GetRandomPubKey(),
+			Memo:        "OUT:" + types2.GetRandomTxHash().String(),
+		})
+	}
+	spent := newItem(10)
+	spent.Status = TxSpent
+	failed := newItem(11)
+	failed.Status = TxUnavailable
+	c.Assert(storage.Batch([]TxOutStoreItem{newItem(10), spent, failed, newItem(12)}), IsNil)
+
+	signer.setLastSignedHeight(12)
+	signer.setLastSignedHeight(10)
+	status = signer.GetSigningStatus()
+	c.Check(status.PendingTxOuts, Equals, 3)
+	c.Check(status.RetryQueueDepth, Equals, 1)
+	c.Check(status.LastSignedHeight, Equals, int64(12))
+
+	rec := httptest.NewRecorder()
+	signer.handleSigningStatus(rec, httptest.NewRequest(http.MethodGet, SigningStatusPath, nil))
+	c.Check(rec.Code, Equals, http.StatusOK)
+	var resp SigningStatus
+	c.Assert(json.Unmarshal(rec.Body.Bytes(), &resp), IsNil)
+	c.Check(resp, Equals, status)
+
+	// no key to sign with
+	signer.pubkeyMgr = noSignKeyValidator{pubkeymanager.NewMockPoolAddressValidator()}
+	c.Check(signer.GetSigningStatus().IsHealthy(), Equals, false)
+	rec = httptest.NewRecorder()
+	signer.handleSigningStatus(rec, httptest.NewRequest(http.MethodGet, SigningStatusPath, nil))
+	c.Check(rec.Code, Equals, http.StatusServiceUnavailable)
+}
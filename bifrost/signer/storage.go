@@ -21,7 +21,11 @@ import (
 
 const (
 	DefaultSignerLevelDBFolder = "signer_data"
-	txOutPrefix                = "txout-v1-"
+	// SignerStoreSchemaVersion is the version of the schema the signer store write its records with
+	SignerStoreSchemaVersion = "2"
+	schemaVersionKey         = "schema-version"
+	txOutPrefixV1            = "txout-v1-"
+	txOutPrefix              = "txout-v2-"
 )
 
 type TxStatus int
@@ -31,12 +35,16 @@ const (
 	TxAvailable
 	TxUnavailable
 	TxSpent
+	TxBroadcast
 )
 
 type TxOutStoreItem struct {
-	TxOutItem types.TxOutItem
-	Status    TxStatus
-	Height    int64
+	TxOutItem     types.TxOutItem
+	Status        TxStatus
+	Height        int64
+	TxID          string
+	RetryCount    int64
+	CheckFailures int64
 }
 
 func NewTxOutStoreItem(height int64, item types.TxOutItem) TxOutStoreItem {
@@ -99,12 +107,135 @@ func NewSignerStore(levelDbFolder, passphrase string) (*SignerStore, error) {
 	if err != nil {
 		return nil, errors.New("fail to create level db")
 	}
-	return &SignerStore{
+	store := &SignerStore{
 		LevelDBScannerStorage: levelDbStorage,
 		logger:                log.With().Str("module", "signer-storage").Logger(),
 		db:                    db,
 		passphrase:            passphrase,
-	}, nil
+	}
+	if err := store.checkSchemaVersion(); err != nil {
+		return nil, fmt.Errorf("fail to check signer store schema version: %w", err)
+	}
+	return store, nil
+}
+
+// checkSchemaVersion migrate the records to the current schema when the store has been written with an older one
+func (s *SignerStore) checkSchemaVersion() error {
+	version, err := s.GetSchemaVersion()
+	if err != nil {
+		return err
+	}
+	if version == SignerStoreSchemaVersion {
+		return nil
+	}
+	s.logger.Info().Str("from", version).Str("to", SignerStoreSchemaVersion).Msg("migrate signer store")
+	return s.Migrate(version, SignerStoreSchemaVersion)
+}
+
+// GetSchemaVersion return the schema version of the records in the store. Stores written before the schema version
+// got recorded are version 1, an empty store is always on the current version
+func (s *SignerStore) GetSchemaVersion() (string, error) {
+	buf, err := s.db.Get([]byte(schemaVersionKey), nil)
+	if err == nil {
+		return string(buf), nil
+	}
+	if !errors.Is(err, leveldb.ErrNotFound) {
+		return "", fmt.Errorf("fail to get schema version: %w", err)
+	}
+	iterator := s.db.NewIterator(util.BytesPrefix([]byte(txOutPrefixV1)), nil)
+	defer iterator.Release()
+	if iterator.Next() {
+		return "1", nil
+	}
+	if err := s.setSchemaVersion(SignerStoreSchemaVersion); err != nil {
+		return "", err
+	}
+	return SignerStoreSchemaVersion, nil
+}
+
+func (s *SignerStore) setSchemaVersion(version string) error {
+	if err := s.db.Put([]byte(schemaVersionKey), []byte(version), nil); err != nil {
+		return fmt.Errorf("fail to set schema version: %w", err)
+	}
+	return nil
+}
+
+// signerStoreMigrations are the migrations from one schema version to the next one, by the version they migrate from
+var signerStoreMigrations = map[string]struct {
+	toVersion string
+	migrate   func(s *SignerStore) error
+}{
+	"1": {toVersion: "2", migrate: MigrationV1ToV2},
+}
+
+// Migrate convert the records written with the fromVersion schema to the toVersion schema, one version at a time
+func (s *SignerStore) Migrate(fromVersion, toVersion string) error {
+	version := fromVersion
+	for version != toVersion {
+		migration, ok := signerStoreMigrations[version]
+		if !ok {
+			return fmt.Errorf("no migration from schema version %s to %s", version, toVersion)
+		}
+		if err := migration.migrate(s); err != nil {
+			return fmt.Errorf("fail to migrate from schema version %s to %s: %w", version, migration.toVersion, err)
+		}
+		if err := s.setSchemaVersion(migration.toVersion); err != nil {
+			return err
+		}
+		version = migration.toVersion
+	}
+	return nil
+}
+
+// txOutStoreItemV1 is TxOutStoreItem in schema version 1
+type txOutStoreItemV1 struct {
+	TxOutItem types.TxOutItem
+	Status    TxStatus
+	Height    int64
+	TxID      string
+}
+
+// MigrationV1ToV2 add the RetryCount to the tx out items, and move them to the version 2 key prefix
+func MigrationV1ToV2(s *SignerStore) error {
+	iterator := s.db.NewIterator(util.BytesPrefix([]byte(txOutPrefixV1)), nil)
+	defer iterator.Release()
+	batch := new(leveldb.Batch)
+	for iterator.Next() {
+		var err error
+		buf := iterator.Value()
+		if len(s.passphrase) > 0 {
+			buf, err = common.Decrypt(buf, s.passphrase)
+			if err != nil {
+				return fmt.Errorf("fail to decrypt txout item: %w", err)
+			}
+		}
+		var old txOutStoreItemV1
+		if err := json.Unmarshal(buf, &old); err != nil {
+			return fmt.Errorf("fail to unmarshal txout item: %w", err)
+		}
+		item := TxOutStoreItem{
+			TxOutItem: old.TxOutItem,
+			Status:    old.Status,
+			Height:    old.Height,
+			TxID:      old.TxID,
+		}
+		buf, err = json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("fail to marshal txout item: %w", err)
+		}
+		if len(s.passphrase) > 0 {
+			buf, err = common.Encrypt(buf, s.passphrase)
+			if err != nil {
+				return fmt.Errorf("fail to encrypt txout item: %w", err)
+			}
+		}
+		batch.Put([]byte(item.Key()), buf)
+		batch.Delete(iterator.Key())
+	}
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("fail to iterate txout items: %w", err)
+	}
+	return s.db.Write(batch, nil)
 }
 
 func (s *SignerStore) Set(item TxOutStoreItem) error {
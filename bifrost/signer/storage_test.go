@@ -1,7 +1,9 @@
 package signer
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	. "gopkg.in/check.v1"
 
@@ -75,3 +77,57 @@ func (s *StorageSuite) TestKey(c *C) {
 	item1.Status = TxSpent
 	c.Check(item1.Key(), Equals, item2.Key())
 }
+
+func (s *StorageSuite) TestMigrateV1ToV2(c *C) {
+	dir := c.MkDir()
+	passphrase := "my secret passphrase"
+	store, err := NewSignerStore(dir, passphrase)
+	c.Assert(err, IsNil)
+	version, err := store.GetSchemaVersion()
+	c.Assert(err, IsNil)
+	c.Check(version, Equals, SignerStoreSchemaVersion)
+
+	// write the records the way version 1 of the schema did
+	c.Assert(store.db.Delete([]byte(schemaVersionKey), nil), IsNil)
+	olds := []txOutStoreItemV1{
+		{TxOutItem: types.TxOutItem{Chain: common.BNBChain, Memo: "foo"}, Status: TxAvailable, Height: 10},
+		{TxOutItem: types.TxOutItem{Chain: common.BTCChain, Memo: "bar"}, Status: TxBroadcast, Height: 12, TxID: "abc"},
+	}
+	for _, old := range olds {
+		item := TxOutStoreItem{TxOutItem: old.TxOutItem, Height: old.Height}
+		key := strings.Replace(item.Key(), txOutPrefix, txOutPrefixV1, 1)
+		buf, err := json.Marshal(old)
+		c.Assert(err, IsNil)
+		buf, err = common.Encrypt(buf, passphrase)
+		c.Assert(err, IsNil)
+		c.Assert(store.db.Put([]byte(key), buf, nil), IsNil)
+	}
+	version, err = store.GetSchemaVersion()
+	c.Assert(err, IsNil)
+	c.Check(version, Equals, "1")
+	c.Check(store.List(), HasLen, 0)
+	c.Assert(store.Close(), IsNil)
+
+	// the records get migrated when the store is opened
+	store, err = NewSignerStore(dir, passphrase)
+	c.Assert(err, IsNil)
+	version, err = store.GetSchemaVersion()
+	c.Assert(err, IsNil)
+	c.Check(version, Equals, "2")
+	items := store.List()
+	c.Assert(items, HasLen, 2)
+	for i, item := range items {
+		c.Check(item.TxOutItem.Memo, Equals, olds[i].TxOutItem.Memo)
+		c.Check(item.Status, Equals, olds[i].Status)
+		c.Check(item.Height, Equals, olds[i].Height)
+		c.Check(item.TxID, Equals, olds[i].TxID)
+		c.Check(item.RetryCount, Equals, int64(0))
+		c.Check(store.Has(item.Key()), Equals, true)
+		c.Check(store.Has(strings.Replace(item.Key(), txOutPrefix, txOutPrefixV1, 1)), Equals, false)
+	}
+
+	// migrating again is a no-op, downgrade is not supported
+	c.Check(store.Migrate("2", "2"), IsNil)
+	c.Check(store.Migrate("2", "1"), NotNil)
+	c.Check(store.Close(), IsNil)
+}
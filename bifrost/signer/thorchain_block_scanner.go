@@ -127,3 +127,25 @@ func (b *ThorchainBlockScan) processTxOutBlock(blockHeight int64) error {
 	}
 	return nil
 }
+
+// GetTxOutAtHeight query thorchain for all the tx out items at the given block height that need to be signed by the
+// vaults this node is a member of, tx out items of all chains will be merged into one TxOut
+func (b *ThorchainBlockScan) GetTxOutAtHeight(height int64) (types.TxOut, error) {
+	result := types.TxOut{
+		Height:  height,
+		TxArray: []types.TxArrayItem{},
+	}
+	for _, pk := range b.pubkeyMgr.GetSignPubKeys() {
+		if len(pk.String()) == 0 {
+			continue
+		}
+		tx, err := b.thorchain.GetKeysign(height, pk.String())
+		if err != nil {
+			return types.TxOut{}, fmt.Errorf("fail to get keysign at height(%d): %w", height, err)
+		}
+		for _, out := range tx.Chains {
+			result.TxArray = append(result.TxArray, out.TxArray...)
+		}
+	}
+	return result, nil
+}
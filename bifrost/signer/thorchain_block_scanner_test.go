@@ -170,3 +170,23 @@ func (s *ThorchainBlockScanSuite) TestProcess(c *C) {
 	c.Assert(blockScan, NotNil)
 	c.Assert(err, IsNil)
 }
+
+func (s *ThorchainBlockScanSuite) TestGetTxOutAtHeight(c *C) {
+	cfg := config.BlockScannerConfiguration{
+		RPCHost:                    "127.0.0.1:" + s.rpcHost,
+		ChainID:                    "ThorChain",
+		StartBlockHeight:           1,
+		EnforceBlockHeight:         true,
+		BlockScanProcessors:        1,
+		BlockHeightDiscoverBackoff: time.Second,
+		BlockRetryInterval:         10 * time.Second,
+	}
+	blockScan, err := NewThorchainBlockScan(cfg, s.storage, s.bridge, s.m, pubkeymanager.NewMockPoolAddressValidator())
+	c.Assert(err, IsNil)
+	txOut, err := blockScan.GetTxOutAtHeight(1)
+	c.Assert(err, IsNil)
+	c.Assert(txOut.Height, Equals, int64(1))
+	c.Assert(txOut.TxArray, HasLen, 1)
+	c.Check(txOut.TxArray[0].Chain.String(), Equals, "BNB")
+	c.Check(txOut.TxArray[0].Coin.Amount.Uint64(), Equals, uint64(10000000000))
+}
@@ -1,6 +1,7 @@
 package thorclient
 
 import (
+	"errors"
 	"fmt"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -9,6 +10,9 @@ import (
 
 // GetLastObservedInHeight returns the lastobservedin value for the chain past in
 func (b *ThorchainBridge) GetLastObservedInHeight(chain common.Chain) (int64, error) {
+	if chain.IsEmpty() {
+		return 0, errors.New("chain is empty")
+	}
 	lastblock, err := b.getLastBlock(chain)
 	if err != nil {
 		return 0, fmt.Errorf("failed to GetLastObservedInHeight: %w", err)
@@ -69,6 +69,9 @@ func (s *BlockHeightSuite) TestGetLastObservedInHeight(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(height, NotNil)
 	c.Assert(height, Equals, int64(12345))
+
+	_, err = s.bridge.GetLastObservedInHeight(common.EmptyChain)
+	c.Assert(err, NotNil)
 }
 
 func (s *BlockHeightSuite) TestGetLastSignedHeight(c *C) {
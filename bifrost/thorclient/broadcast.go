@@ -2,6 +2,7 @@ package thorclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,14 +16,52 @@ import (
 	"gitlab.com/thorchain/thornode/common"
 )
 
+// broadcastRetryInterval is how long to wait before the first retry, it doubles after each failed attempt
+var broadcastRetryInterval = time.Second
+
 // Broadcast Broadcasts tx to thorchain
 func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (common.TxID, error) {
+	return b.BroadcastWithContext(context.Background(), stdTx, mode)
+}
+
+// BroadcastWithContext Broadcasts tx to thorchain, when RetryOnFailure is enabled it retries up to MaxRetries attempts
+// with exponential backoff on transient network failures. It gives up when the given context is cancelled
+func (b *ThorchainBridge) BroadcastWithContext(ctx context.Context, stdTx authtypes.StdTx, mode types.TxMode) (common.TxID, error) {
+	attempts := 1
+	if b.cfg.RetryOnFailure && b.cfg.MaxRetries > 1 {
+		attempts = b.cfg.MaxRetries
+	}
+	interval := broadcastRetryInterval
+	var txID common.TxID
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return txID, fmt.Errorf("broadcast cancelled: %w", ctx.Err())
+			case <-time.After(interval):
+			}
+			interval *= 2
+		}
+		var retryable bool
+		txID, retryable, err = b.broadcast(stdTx, mode)
+		if err == nil || !retryable {
+			return txID, err
+		}
+		b.logger.Error().Err(err).Int("attempt", i+1).Msg("fail to broadcast tx to thorchain")
+	}
+	return txID, err
+}
+
+// broadcast sign and post the tx to thorchain, the returned bool indicates whether the failure is transient
+// and worth retrying
+func (b *ThorchainBridge) broadcast(stdTx authtypes.StdTx, mode types.TxMode) (common.TxID, bool, error) {
 	b.broadcastLock.Lock()
 	defer b.broadcastLock.Unlock()
 
 	noTxID := common.TxID("")
 	if !mode.IsValid() {
-		return noTxID, errors.New(fmt.Sprintf("transaction Mode (%s) is invalid", mode))
+		return noTxID, false, errors.New(fmt.Sprintf("transaction Mode (%s) is invalid", mode))
 	}
 	start := time.Now()
 	defer func() {
@@ -31,13 +70,13 @@ func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (c
 
 	blockHeight, err := b.GetBlockHeight()
 	if err != nil {
-		return noTxID, err
+		return noTxID, true, err
 	}
 	if blockHeight > b.blockHeight {
 		var seqNum uint64
 		b.accountNumber, seqNum, err = b.getAccountNumberAndSequenceNumber()
 		if err != nil {
-			return noTxID, fmt.Errorf("fail to get account number and sequence number from thorchain : %w", err)
+			return noTxID, true, fmt.Errorf("fail to get account number and sequence number from thorchain : %w", err)
 		}
 		b.blockHeight = blockHeight
 		if seqNum > b.seqNumber {
@@ -57,7 +96,7 @@ func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (c
 	sig, err := authtypes.MakeSignature(b.keys.GetKeybase(), b.cfg.SignerName, b.cfg.SignerPasswd, stdMsg)
 	if err != nil {
 		b.errCounter.WithLabelValues("fail_sign", "").Inc()
-		return noTxID, fmt.Errorf("fail to sign the message: %w", err)
+		return noTxID, false, fmt.Errorf("fail to sign the message: %w", err)
 	}
 
 	signed := authtypes.NewStdTx(
@@ -78,14 +117,14 @@ func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (c
 	result, err := b.cdc.MarshalJSON(setTx)
 	if err != nil {
 		b.errCounter.WithLabelValues("fail_marshal_settx", "").Inc()
-		return noTxID, fmt.Errorf("fail to marshal settx to json: %w", err)
+		return noTxID, false, fmt.Errorf("fail to marshal settx to json: %w", err)
 	}
 
 	b.logger.Info().Int("size", len(result)).Str("payload", string(result)).Msg("post to thorchain")
 
 	body, err := b.post(BroadcastTxsEndpoint, "application/json", bytes.NewBuffer(result))
 	if err != nil {
-		return noTxID, fmt.Errorf("fail to post tx to thorchain: %w", err)
+		return noTxID, true, fmt.Errorf("fail to post tx to thorchain: %w", err)
 	}
 
 	// NOTE: we can actually see two different json responses for the same end.
@@ -102,14 +141,14 @@ func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (c
 		err = json.Unmarshal(body, &badCommit)
 		if err != nil {
 			b.logger.Error().Err(err).Msg("fail unmarshal bad commit")
-			return noTxID, fmt.Errorf("fail to unmarshal bad commit: %w", err)
+			return noTxID, false, fmt.Errorf("fail to unmarshal bad commit: %w", err)
 		}
 
 		// check for any failure logs
 		if badCommit.Code > 0 {
 			err := errors.New(badCommit.Log)
 			b.logger.Error().Err(err).Msg("fail to broadcast")
-			return badCommit.TxHash, fmt.Errorf("fail to broadcast: %w", err)
+			return badCommit.TxHash, false, fmt.Errorf("fail to broadcast: %w", err)
 		}
 	}
 
@@ -117,7 +156,7 @@ func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (c
 		if !log.Success {
 			err := errors.New(log.Log)
 			b.logger.Error().Err(err).Msg("fail to broadcast")
-			return noTxID, fmt.Errorf("fail to broadcast: %w", err)
+			return noTxID, false, fmt.Errorf("fail to broadcast: %w", err)
 		}
 	}
 
@@ -127,5 +166,5 @@ func (b *ThorchainBridge) Broadcast(stdTx authtypes.StdTx, mode types.TxMode) (c
 	// increment seqNum
 	atomic.AddUint64(&b.seqNumber, 1)
 
-	return commit.TxHash, nil
+	return commit.TxHash, false, nil
 }
@@ -1,9 +1,11 @@
 package thorclient
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	. "gopkg.in/check.v1"
@@ -75,3 +77,60 @@ func (s *BroadcastSuite) TestBroadcast(c *C) {
 	c.Check(s.bridge.accountNumber, Equals, uint64(3))
 	c.Check(s.bridge.seqNumber, Equals, uint64(6))
 }
+
+func (s *BroadcastSuite) TestBroadcastRetryOnFailure(c *C) {
+	broadcastRetryInterval = time.Millisecond
+	failures := 2
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case strings.HasPrefix(req.RequestURI, AuthAccountEndpoint):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/auth/accounts/template.json")
+		case strings.HasPrefix(req.RequestURI, LastBlockEndpoint):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/lastblock/bnb.json")
+		case strings.HasPrefix(req.RequestURI, BroadcastTxsEndpoint):
+			attempts++
+			if attempts <= failures {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/txs/success.json")
+		}
+	}))
+	defer server.Close()
+
+	cfg := s.cfg
+	cfg.ChainHost = server.Listener.Addr().String()
+	bridge, err := NewThorchainBridge(cfg, GetMetricForTest(c))
+	c.Assert(err, IsNil)
+	bridge.httpClient.RetryMax = 0
+
+	// retry is disabled, broadcast once
+	_, err = bridge.Broadcast(authtypes.StdTx{}, types.TxSync)
+	c.Assert(err, NotNil)
+	c.Check(attempts, Equals, 1)
+
+	// retry until it succeed
+	attempts = 0
+	bridge.cfg.RetryOnFailure = true
+	bridge.cfg.MaxRetries = 3
+	txID, err := bridge.Broadcast(authtypes.StdTx{}, types.TxSync)
+	c.Assert(err, IsNil)
+	c.Check(txID.String(), Equals, "D97E8A81417E293F5B28DDB53A4AD87B434CA30F51D683DA758ECC2168A7A005")
+	c.Check(attempts, Equals, 3)
+
+	// give up after MaxRetries attempts
+	attempts = 0
+	failures = 10
+	_, err = bridge.Broadcast(authtypes.StdTx{}, types.TxSync)
+	c.Assert(err, NotNil)
+	c.Check(attempts, Equals, 3)
+
+	// cancelled context stop retrying
+	attempts = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bridge.BroadcastWithContext(ctx, authtypes.StdTx{}, types.TxSync)
+	c.Assert(err, NotNil)
+	c.Check(attempts, Equals, 1)
+}
@@ -0,0 +1,45 @@
+package thorclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gitlab.com/thorchain/thornode/common"
+	stypes "gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+// GetPool retrieve the pool of the given asset from thorchain
+func (b *ThorchainBridge) GetPool(asset common.Asset) (stypes.Pool, error) {
+	if asset.IsEmpty() {
+		return stypes.Pool{}, errors.New("pool asset is empty")
+	}
+	buf, s, err := b.getWithPath(fmt.Sprintf(PoolEndpoint, asset))
+	if err != nil {
+		return stypes.Pool{}, fmt.Errorf("fail to get pool(%s): %w", asset, err)
+	}
+	if s != http.StatusOK {
+		return stypes.Pool{}, fmt.Errorf("unexpected status code %d", s)
+	}
+	var pool stypes.Pool
+	if err := b.cdc.UnmarshalJSON(buf, &pool); err != nil {
+		return stypes.Pool{}, fmt.Errorf("fail to unmarshal pool(%s) from json: %w", asset, err)
+	}
+	return pool, nil
+}
+
+// GetPools retrieve all the pools from thorchain
+func (b *ThorchainBridge) GetPools() (stypes.Pools, error) {
+	buf, s, err := b.getWithPath(PoolsEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pools: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", s)
+	}
+	var pools stypes.Pools
+	if err := b.cdc.UnmarshalJSON(buf, &pools); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal pools from json: %w", err)
+	}
+	return pools, nil
+}
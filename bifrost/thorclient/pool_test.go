@@ -0,0 +1,74 @@
+package thorclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/config"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+type PoolSuite struct {
+	server  *httptest.Server
+	bridge  *ThorchainBridge
+	cfg     config.ClientConfiguration
+	cleanup func()
+}
+
+var _ = Suite(&PoolSuite{})
+
+func (s *PoolSuite) SetUpSuite(c *C) {
+	s.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case strings.HasPrefix(req.RequestURI, PoolsEndpoint):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/pools/pools.json")
+		case strings.HasPrefix(req.RequestURI, "/thorchain/pool/BNB.BNB"):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/pools/pool.json")
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	s.cfg, _, s.cleanup = SetupStateChainForTest(c)
+	s.cfg.ChainHost = s.server.Listener.Addr().String()
+	var err error
+	s.bridge, err = NewThorchainBridge(s.cfg, GetMetricForTest(c))
+	c.Assert(err, IsNil)
+	c.Assert(s.bridge, NotNil)
+	s.bridge.httpClient.RetryMax = 1
+}
+
+func (s *PoolSuite) TearDownSuite(c *C) {
+	s.cleanup()
+	s.server.Close()
+}
+
+func (s *PoolSuite) TestGetPool(c *C) {
+	pool, err := s.bridge.GetPool(common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(pool.Status, Equals, types.Enabled)
+	c.Check(pool.BalanceRune.Uint64(), Equals, uint64(194290717513))
+	c.Check(pool.BalanceAsset.Uint64(), Equals, uint64(1427420741))
+
+	_, err = s.bridge.GetPool(common.Asset{})
+	c.Assert(err, NotNil)
+
+	// pool doesn't exist
+	_, err = s.bridge.GetPool(common.BTCAsset)
+	c.Assert(err, NotNil)
+}
+
+func (s *PoolSuite) TestGetPools(c *C) {
+	pools, err := s.bridge.GetPools()
+	c.Assert(err, IsNil)
+	c.Assert(pools, HasLen, 2)
+	c.Check(pools[0].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(pools[0].Status, Equals, types.Enabled)
+	c.Check(pools[1].Asset.String(), Equals, "BNB.TCAN-014")
+	c.Check(pools[1].Status, Equals, types.Suspended)
+}
@@ -40,6 +40,10 @@ const (
 	SignerMembershipEndpoint = "/thorchain/vaults/%s/signers"
 	StatusEndpoint           = "/status"
 	AsgardVault              = "/thorchain/vaults/asgard"
+	VaultsEndpoint           = "/thorchain/vaults/%s"
+	VaultEndpoint            = "/thorchain/vault/%s"
+	PoolEndpoint             = "/thorchain/pool/%s"
+	PoolsEndpoint            = "/thorchain/pools"
 )
 
 // ThorchainBridge will be used to send tx to thorchain
@@ -404,3 +408,41 @@ func (b *ThorchainBridge) GetAsgards() (stypes.Vaults, error) {
 	}
 	return vaults, nil
 }
+
+// GetVaults retrieve all the vaults with the given status (active, retiring, inactive) from thorchain
+func (b *ThorchainBridge) GetVaults(status string) (stypes.Vaults, error) {
+	if len(status) == 0 {
+		return nil, errors.New("vault status is empty")
+	}
+	buf, s, err := b.getWithPath(fmt.Sprintf(VaultsEndpoint, status))
+	if err != nil {
+		return nil, fmt.Errorf("fail to get %s vaults: %w", status, err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", s)
+	}
+	var vaults stypes.Vaults
+	if err := b.cdc.UnmarshalJSON(buf, &vaults); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal %s vaults from json: %w", status, err)
+	}
+	return vaults, nil
+}
+
+// GetVault retrieve the vault with the given pub key from thorchain
+func (b *ThorchainBridge) GetVault(pubKey string) (stypes.Vault, error) {
+	if len(pubKey) == 0 {
+		return stypes.Vault{}, errors.New("vault pub key is empty")
+	}
+	buf, s, err := b.getWithPath(fmt.Sprintf(VaultEndpoint, pubKey))
+	if err != nil {
+		return stypes.Vault{}, fmt.Errorf("fail to get vault: %w", err)
+	}
+	if s != http.StatusOK {
+		return stypes.Vault{}, fmt.Errorf("unexpected status code %d", s)
+	}
+	var vault stypes.Vault
+	if err := b.cdc.UnmarshalJSON(buf, &vault); err != nil {
+		return stypes.Vault{}, fmt.Errorf("fail to unmarshal vault from json: %w", err)
+	}
+	return vault, nil
+}
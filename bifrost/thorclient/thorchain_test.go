@@ -1,6 +1,7 @@
 package thorclient
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -49,6 +50,10 @@ func (s *ThorchainSuite) SetUpSuite(c *C) {
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/tss/keysign_party.json")
 		case strings.HasPrefix(req.RequestURI, AsgardVault):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/asgard.json")
+		case strings.HasPrefix(req.RequestURI, fmt.Sprintf(VaultsEndpoint, "active")):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/asgard.json")
+		case strings.HasPrefix(req.RequestURI, "/thorchain/vault/"):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/vault.json")
 		}
 	}))
 	s.cfg.ChainHost = s.server.Listener.Addr().String()
@@ -246,3 +251,25 @@ func (s *ThorchainSuite) TestGetAsgards(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(vaults, NotNil)
 }
+
+func (s *ThorchainSuite) TestGetVaults(c *C) {
+	vaults, err := s.bridge.GetVaults("active")
+	c.Assert(err, IsNil)
+	c.Assert(vaults, HasLen, 1)
+	c.Check(vaults[0].Status, Equals, stypes.ActiveVault)
+
+	vaults, err = s.bridge.GetVaults("")
+	c.Assert(err, NotNil)
+	c.Assert(vaults, IsNil)
+}
+
+func (s *ThorchainSuite) TestGetVault(c *C) {
+	pk := "thorpub1addwnpepqwn78ny4tzcwuzs9dj7a35ja655twr2zupsng9xq7flxyhd0vd4f6p885e3"
+	vault, err := s.bridge.GetVault(pk)
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.String(), Equals, pk)
+	c.Check(vault.Membership, HasLen, 1)
+
+	_, err = s.bridge.GetVault("")
+	c.Assert(err, NotNil)
+}
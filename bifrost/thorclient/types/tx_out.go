@@ -2,12 +2,17 @@ package types
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"strings"
 
 	"gitlab.com/thorchain/thornode/common"
 )
 
+// ErrTxNotFound is returned by chain clients when a broadcast tx is not known by the chain , and can never make it to the
+// chain anymore , so it is safe to sign the tx out item again
+var ErrTxNotFound = errors.New("tx not found")
+
 type TxOutItem struct {
 	Chain       common.Chain   `json:"chain"`
 	ToAddress   common.Address `json:"to"`
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gitlab.com/thorchain/thornode/bifrost/config"
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
+	"gitlab.com/thorchain/thornode/bifrost/signer"
+	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+)
+
+// runCommand run the given sub command instead of starting bifrost
+func runCommand(cfg *config.Configuration, args []string) error {
+	if len(args) >= 2 && args[0] == "keysign" && args[1] == "retry" {
+		return keysignRetry(cfg, args[2:])
+	}
+	return fmt.Errorf("unknown command: %s", strings.Join(args, " "))
+}
+
+// keysignRetry handle `bifrost keysign retry <height>`, it fetches the tx out items at the given thorchain block height
+// and save them to the signer storage, so they get signed again once bifrost starts. bifrost must be stopped first
+func keysignRetry(cfg *config.Configuration, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: bifrost keysign retry <thorchain block height>")
+	}
+	height, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || height <= 0 {
+		return fmt.Errorf("invalid thorchain block height: %s", args[0])
+	}
+
+	m, err := metrics.NewMetrics(cfg.Metrics)
+	if err != nil {
+		return fmt.Errorf("fail to create metric instance: %w", err)
+	}
+	thorchainBridge, err := thorclient.NewThorchainBridge(cfg.Thorchain, m)
+	if err != nil {
+		return fmt.Errorf("fail to create new thorchain bridge: %w", err)
+	}
+	thorKeys, err := thorclient.NewKeys(cfg.Thorchain.ChainHomeFolder, cfg.Thorchain.SignerName, cfg.Thorchain.SignerPasswd)
+	if err != nil {
+		return fmt.Errorf("fail to load keys: %w", err)
+	}
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(cfg.Thorchain.ChainHost, m)
+	if err != nil {
+		return fmt.Errorf("fail to create pubkey manager: %w", err)
+	}
+	count, err := signer.RetryTxOutAtHeight(cfg.Signer, thorchainBridge, thorKeys, pubkeyMgr, m, height)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d tx out items at height %d scheduled for retry\n", count, height)
+	return nil
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/config"
+)
+
+type KeysignCommandSuite struct{}
+
+var _ = Suite(&KeysignCommandSuite{})
+
+func (KeysignCommandSuite) TestRunCommand(c *C) {
+	cfg := &config.Configuration{}
+	c.Assert(runCommand(cfg, []string{"whatever"}), NotNil)
+	c.Assert(runCommand(cfg, []string{"keysign"}), NotNil)
+	// missing height
+	c.Assert(runCommand(cfg, []string{"keysign", "retry"}), NotNil)
+	// invalid height
+	c.Assert(runCommand(cfg, []string{"keysign", "retry", "abc"}), NotNil)
+	c.Assert(runCommand(cfg, []string{"keysign", "retry", "0"}), NotNil)
+	c.Assert(runCommand(cfg, []string{"keysign", "retry", "10", "11"}), NotNil)
+}
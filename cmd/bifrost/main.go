@@ -70,6 +70,14 @@ func main() {
 	}
 	cfg.Thorchain.SignerPasswd = os.Getenv("SIGNER_PASSWD")
 
+	// sub commands , e.g. `keysign retry <height>`, run instead of starting bifrost
+	if args := flag.Args(); len(args) > 0 {
+		if err := runCommand(cfg, args); err != nil {
+			log.Fatal().Err(err).Msg("fail to run command")
+		}
+		return
+	}
+
 	// metrics
 	m, err := metrics.NewMetrics(cfg.Metrics)
 	if err != nil {
@@ -161,7 +169,26 @@ func main() {
 		}
 	}
 
-	chains := chainclients.LoadChains(thorKeys, cfg.Chains, tssIns, thorchainBridge, m)
+	// chain clients configured for the signer are loaded along with the others, so the observer and the signer share
+	// the same started instances
+	signerChains, err := cfg.Signer.GetChainConfigurations(chainclients.RegisteredChains())
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid signer chain clients")
+	}
+	chainCfgs := cfg.Chains
+	for _, signerChain := range signerChains {
+		found := false
+		for _, chainCfg := range chainCfgs {
+			if chainCfg.ChainID.Equals(signerChain.ChainID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			chainCfgs = append(chainCfgs, signerChain)
+		}
+	}
+	chains := chainclients.LoadChains(thorKeys, chainCfgs, tssIns, thorchainBridge, m, pubkeyMgr)
 
 	// start observer
 	obs, err := observer.NewObserver(pubkeyMgr, chains, thorchainBridge, m)
@@ -3,6 +3,7 @@ package common
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 
@@ -18,6 +19,12 @@ var NoCoin = Coin{
 	Amount: sdk.ZeroUint(),
 }
 
+var (
+	ErrZeroAmount     = errors.New("coin amount cannot be zero")
+	ErrEmptyAsset     = errors.New("coin asset cannot be empty")
+	ErrAmountOverflow = errors.New("coin amount overflow")
+)
+
 type Coins []Coin
 
 // NewCoin return a new instance of Coin
@@ -59,6 +66,20 @@ func (c Coin) IsValid() error {
 	return nil
 }
 
+// Validate check whether the coin has a known asset, and a non zero amount that fit in an int64
+func (c Coin) Validate() error {
+	if c.Amount.IsZero() {
+		return ErrZeroAmount
+	}
+	if c.Asset.IsEmpty() {
+		return ErrEmptyAsset
+	}
+	if c.Amount.GT(sdk.NewUint(math.MaxInt64)) {
+		return fmt.Errorf("%s: %w", c.Amount, ErrAmountOverflow)
+	}
+	return nil
+}
+
 func (c Coin) IsNative() bool {
 	return c.Asset.Chain.Equals(THORChain)
 }
@@ -87,6 +108,16 @@ func (cs Coins) IsValid() error {
 	return nil
 }
 
+// Validate check every coin in the list, see Coin.Validate
+func (cs Coins) Validate() error {
+	for _, coin := range cs {
+		if err := coin.Validate(); err != nil {
+			return fmt.Errorf("invalid coin(%s): %w", coin.Asset, err)
+		}
+	}
+	return nil
+}
+
 // Check if two lists of coins are equal to each other. Order does not matter
 func (cs1 Coins) Equals(cs2 Coins) bool {
 	if len(cs1) != len(cs2) {
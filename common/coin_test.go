@@ -1,6 +1,9 @@
 package common
 
 import (
+	"errors"
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 )
@@ -27,3 +30,21 @@ func (s CoinSuite) TestCoin(c *C) {
 	c.Check(sdkCoin.Denom, Equals, "rune")
 	c.Check(sdkCoin.Amount.Equal(sdk.NewInt(230)), Equals, true)
 }
+
+func (s CoinSuite) TestCoinValidate(c *C) {
+	c.Check(NewCoin(BNBAsset, sdk.NewUint(230000000)).Validate(), IsNil)
+	c.Check(NewCoin(BNBAsset, sdk.NewUint(math.MaxInt64)).Validate(), IsNil)
+	c.Check(errors.Is(NewCoin(BNBAsset, sdk.ZeroUint()).Validate(), ErrZeroAmount), Equals, true)
+	c.Check(errors.Is(NewCoin(EmptyAsset, sdk.NewUint(230000000)).Validate(), ErrEmptyAsset), Equals, true)
+	overflow := NewCoin(BNBAsset, sdk.NewUint(math.MaxInt64).AddUint64(1))
+	c.Check(errors.Is(overflow.Validate(), ErrAmountOverflow), Equals, true)
+
+	coins := Coins{
+		NewCoin(BNBAsset, sdk.NewUint(230000000)),
+		NewCoin(RuneAsset(), sdk.NewUint(100)),
+	}
+	c.Check(coins.Validate(), IsNil)
+	coins = append(coins, overflow)
+	c.Check(errors.Is(coins.Validate(), ErrAmountOverflow), Equals, true)
+	c.Check(errors.Is(Coins{NewCoin(BTCAsset, sdk.ZeroUint())}.Validate(), ErrZeroAmount), Equals, true)
+}
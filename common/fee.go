@@ -1,6 +1,11 @@
 package common
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type Fee struct {
 	Coins      Coins    `json:"coins"`
@@ -24,3 +29,23 @@ func (fee *Fee) Asset() Asset {
 	}
 	return Asset{}
 }
+
+// Validate check the fee is self-consistent, every coin must be valid with a
+// positive amount, each asset can only appear once, and pool deduct must be set
+func (fee Fee) Validate() error {
+	for i, coin := range fee.Coins {
+		if err := coin.IsValid(); err != nil {
+			return fmt.Errorf("invalid fee coin(%s): %w", coin, err)
+		}
+		for _, other := range fee.Coins[i+1:] {
+			if coin.Asset.Equals(other.Asset) {
+				return fmt.Errorf("duplicate fee coin asset(%s)", coin.Asset)
+			}
+		}
+	}
+	// sdk.Uint can't be negative, but an uninitialised one can't be used either
+	if fee.PoolDeduct == (sdk.Uint{}) {
+		return errors.New("pool deduct cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package common
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+)
+
+type FeeSuite struct{}
+
+var _ = Suite(&FeeSuite{})
+
+func (s FeeSuite) TestValidate(c *C) {
+	fee := NewFee(Coins{NewCoin(BNBAsset, sdk.NewUint(100))}, sdk.NewUint(10))
+	c.Check(fee.Validate(), IsNil)
+	fee = NewFee(Coins{}, sdk.ZeroUint())
+	c.Check(fee.Validate(), IsNil)
+	fee = NewFee(Coins{
+		NewCoin(BNBAsset, sdk.NewUint(100)),
+		NewCoin(RuneAsset(), sdk.NewUint(100)),
+	}, sdk.ZeroUint())
+	c.Check(fee.Validate(), IsNil)
+
+	// zero amount
+	fee = NewFee(Coins{NewCoin(BNBAsset, sdk.ZeroUint())}, sdk.ZeroUint())
+	c.Check(fee.Validate(), NotNil)
+	// empty asset
+	fee = NewFee(Coins{NewCoin(Asset{}, sdk.NewUint(100))}, sdk.ZeroUint())
+	c.Check(fee.Validate(), NotNil)
+	// duplicate asset
+	fee = NewFee(Coins{
+		NewCoin(BNBAsset, sdk.NewUint(100)),
+		NewCoin(BNBAsset, sdk.NewUint(200)),
+	}, sdk.ZeroUint())
+	c.Check(fee.Validate(), NotNil)
+	// pool deduct not set
+	fee = Fee{Coins: Coins{NewCoin(BNBAsset, sdk.NewUint(100))}}
+	c.Check(fee.Validate(), NotNil)
+}
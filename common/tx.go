@@ -92,6 +92,36 @@ func NewTx(txID TxID, from, to Address, coins Coins, gas Gas, memo string) Tx {
 	}
 }
 
+// SetMemo return a copy of the tx with the memo set to the given value
+func (tx Tx) SetMemo(memo string) Tx {
+	tx.Memo = memo
+	return tx
+}
+
+// WithCoin return a copy of the tx with the given coin appended to its coins
+// when the chain of the tx is not set yet , it will be set to the chain of the coin
+func (tx Tx) WithCoin(coin Coin) Tx {
+	coins := make(Coins, len(tx.Coins), len(tx.Coins)+1)
+	copy(coins, tx.Coins)
+	tx.Coins = append(coins, coin)
+	if tx.Chain.IsEmpty() {
+		tx.Chain = coin.Asset.Chain
+	}
+	return tx
+}
+
+// WithFromAddress return a copy of the tx with the from address set to the given value
+func (tx Tx) WithFromAddress(addr Address) Tx {
+	tx.FromAddress = addr
+	return tx
+}
+
+// WithToAddress return a copy of the tx with the to address set to the given value
+func (tx Tx) WithToAddress(addr Address) Tx {
+	tx.ToAddress = addr
+	return tx
+}
+
 func (tx Tx) Hash() string {
 	str := fmt.Sprintf("%s|%s|%s", tx.FromAddress, tx.Coins, tx.ToAddress)
 	return fmt.Sprintf("%X", sha256.Sum256([]byte(str)))
@@ -44,3 +44,25 @@ func (s TxSuite) TestTx(c *C) {
 	c.Check(tx.Coins[0].Equals(NewCoin(BNBAsset, sdk.NewUint(5*One))), Equals, true)
 	c.Check(tx.Memo, Equals, "hello memo")
 }
+
+func (s TxSuite) TestTxBuilders(c *C) {
+	addr := Address("bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	tx := Tx{ID: BlankTxID}
+	tx1 := tx.WithFromAddress(addr).WithToAddress(addr).SetMemo("hello memo")
+	c.Check(tx1.FromAddress.Equals(addr), Equals, true)
+	c.Check(tx1.ToAddress.Equals(addr), Equals, true)
+	c.Check(tx1.Memo, Equals, "hello memo")
+	// original tx is not changed
+	c.Check(tx.FromAddress.IsEmpty(), Equals, true)
+	c.Check(tx.ToAddress.IsEmpty(), Equals, true)
+	c.Check(tx.Memo, Equals, "")
+
+	tx2 := tx1.WithCoin(NewCoin(BNBAsset, sdk.NewUint(5*One)))
+	c.Check(tx2.Chain.Equals(BNBChain), Equals, true)
+	c.Assert(tx2.Coins, HasLen, 1)
+	c.Check(tx1.Coins, HasLen, 0)
+	tx3 := tx2.WithCoin(NewCoin(RuneAsset(), sdk.NewUint(One)))
+	c.Assert(tx3.Coins, HasLen, 2)
+	c.Check(tx2.Coins, HasLen, 1)
+	c.Check(tx3.Chain.Equals(BNBChain), Equals, true)
+}
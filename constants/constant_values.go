@@ -1,7 +1,9 @@
 package constants
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/blang/semver"
 )
@@ -30,7 +32,7 @@ const (
 	SigningTransactionPeriod
 	DoubleSignMaxAge
 	MinimumBondInRune
-	FundMigrationInterval
+	FundMigrationInterval // number of blocks between two attempts to move the funds of a retiring vault to the active vaults
 	WhiteListGasAsset
 	ArtificialRagnarokBlockHeight
 	MaximumStakeRune
@@ -39,6 +41,16 @@ const (
 	FailKeygenSlashPoints
 	FailKeySignSlashPoints
 	StakeLockUpBlocks
+	RotationFactor
+	ObservationHistoryBlocks
+	BadNetworkFeeVoteSlashPoints
+	RetirementGracePeriodBlocks
+	MinReserveContribution
+	ChurnMinimumActiveBlocks
+	ChurnMaximumSlashPoints
+	MinYggFundPercent
+	SlashVoteSlashPoints
+	MaxUnstakeBasisPoints
 )
 
 var nameToString = map[ConstantName]string{
@@ -71,6 +83,16 @@ var nameToString = map[ConstantName]string{
 	FailKeygenSlashPoints:           "FailKeygenSlashPoints",
 	FailKeySignSlashPoints:          "FailKeySignSlashPoints",
 	StakeLockUpBlocks:               "StakeLockUpBlocks",
+	RotationFactor:                  "RotationFactor",
+	ObservationHistoryBlocks:        "ObservationHistoryBlocks",
+	BadNetworkFeeVoteSlashPoints:    "BadNetworkFeeVoteSlashPoints",
+	RetirementGracePeriodBlocks:     "RetirementGracePeriodBlocks",
+	MinReserveContribution:          "MinReserveContribution",
+	ChurnMinimumActiveBlocks:        "ChurnMinimumActiveBlocks",
+	ChurnMaximumSlashPoints:         "ChurnMaximumSlashPoints",
+	MinYggFundPercent:               "MinYggFundPercent",
+	SlashVoteSlashPoints:            "SlashVoteSlashPoints",
+	MaxUnstakeBasisPoints:           "MaxUnstakeBasisPoints",
 }
 
 // String implement fmt.stringer
@@ -82,6 +104,16 @@ func (cn ConstantName) String() string {
 	return val
 }
 
+// GetAllConstantNames return the names of all the constants, sorted alphabetically
+func GetAllConstantNames() []string {
+	names := make([]string, 0, len(nameToString))
+	for _, name := range nameToString {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // ConstantValues define methods used to get constant values
 type ConstantValues interface {
 	fmt.Stringer
@@ -97,3 +129,49 @@ func GetConstantValues(ver semver.Version) ConstantValues {
 	}
 	return nil
 }
+
+// ValidateConstants make sure the given constant values form a consistent set, overrides included
+func ValidateConstants(cv ConstantValues) error {
+	if cv == nil {
+		return errors.New("constant values is nil")
+	}
+	positives := []ConstantName{
+		EmissionCurve,
+		BlocksPerYear,
+		NewPoolCycle,
+		MinimumNodesForBFT,
+		DesireValidatorSet,
+		RotatePerBlockHeight,
+		FundMigrationInterval,
+		LackOfObservationPenalty,
+		ObservationHistoryBlocks,
+	}
+	for _, name := range positives {
+		if cv.GetInt64Value(name) <= 0 {
+			return fmt.Errorf("%s should be greater than zero, got %d", name, cv.GetInt64Value(name))
+		}
+	}
+	if cv.GetInt64Value(MinimumNodesForBFT) > cv.GetInt64Value(MinimumNodesForYggdrasil) {
+		return fmt.Errorf("%s(%d) should not be greater than %s(%d)",
+			MinimumNodesForBFT, cv.GetInt64Value(MinimumNodesForBFT),
+			MinimumNodesForYggdrasil, cv.GetInt64Value(MinimumNodesForYggdrasil))
+	}
+	if cv.GetInt64Value(MinimumNodesForYggdrasil) >= cv.GetInt64Value(DesireValidatorSet) {
+		return fmt.Errorf("%s(%d) should be less than %s(%d)",
+			MinimumNodesForYggdrasil, cv.GetInt64Value(MinimumNodesForYggdrasil),
+			DesireValidatorSet, cv.GetInt64Value(DesireValidatorSet))
+	}
+	if v := cv.GetInt64Value(MaxUnstakeBasisPoints); v != 10_000 {
+		return fmt.Errorf("%s should be 10000, got %d", MaxUnstakeBasisPoints, v)
+	}
+	percentages := []ConstantName{
+		RotationFactor,
+		MinYggFundPercent,
+	}
+	for _, name := range percentages {
+		if v := cv.GetInt64Value(name); v < 0 || v > 100 {
+			return fmt.Errorf("%s should be a percentage between 0 and 100, got %d", name, v)
+		}
+	}
+	return nil
+}
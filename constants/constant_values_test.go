@@ -30,6 +30,13 @@ func (ConstantsTestSuite) TestConstantName_String(c *C) {
 		SigningTransactionPeriod,
 		DoubleSignMaxAge,
 		MinimumBondInRune,
+		RotationFactor,
+		ObservationHistoryBlocks,
+		BadNetworkFeeVoteSlashPoints,
+		MinReserveContribution,
+		MinYggFundPercent,
+		SlashVoteSlashPoints,
+		MaxUnstakeBasisPoints,
 	}
 	for _, item := range constantNames {
 		c.Assert(item.String(), Not(Equals), "NA")
@@ -41,3 +48,43 @@ func (ConstantsTestSuite) TestGetConstantValues(c *C) {
 	c.Assert(GetConstantValues(ver), IsNil)
 	c.Assert(GetConstantValues(SWVersion), NotNil)
 }
+
+func (ConstantsTestSuite) TestValidateConstants(c *C) {
+	c.Assert(ValidateConstants(NewConstantValue010()), IsNil)
+	c.Assert(ValidateConstants(nil), NotNil)
+
+	newConstants := func(name ConstantName, value int64) ConstantValues {
+		values := make(map[ConstantName]int64)
+		for k, v := range NewConstantValue010().int64values {
+			values[k] = v
+		}
+		values[name] = value
+		return NewDummyConstants(values, nil, nil)
+	}
+	c.Assert(ValidateConstants(newConstants(NewPoolCycle, 50000)), IsNil)
+
+	inputs := []struct {
+		name  ConstantName
+		value int64
+	}{
+		{name: FundMigrationInterval, value: 0},
+		{name: FundMigrationInterval, value: -1},
+		{name: LackOfObservationPenalty, value: 0},
+		{name: BlocksPerYear, value: 0},
+		{name: EmissionCurve, value: 0},
+		{name: NewPoolCycle, value: 0},
+		{name: RotatePerBlockHeight, value: 0},
+		{name: ObservationHistoryBlocks, value: 0},
+		{name: MinimumNodesForBFT, value: 0},
+		{name: MinimumNodesForBFT, value: 7},
+		{name: MinimumNodesForYggdrasil, value: 33},
+		{name: DesireValidatorSet, value: 6},
+		{name: RotationFactor, value: 101},
+		{name: MinYggFundPercent, value: 101},
+		{name: MaxUnstakeBasisPoints, value: 5000},
+		{name: LackOfObservationPenalty, value: -1},
+	}
+	for _, item := range inputs {
+		c.Check(ValidateConstants(newConstants(item.name, item.value)), NotNil, Commentf("%s: %d", item.name, item.value))
+	}
+}
@@ -17,7 +17,7 @@ var (
 )
 
 // The version of this software
-var SWVersion, _ = semver.Make("0.2.0")
+var SWVersion, _ = semver.Make("0.3.0")
 
 // ConstantValue010 implement ConstantValues interface for version 0.1.0
 type ConstantValue010 struct {
@@ -53,6 +53,16 @@ func NewConstantValue010() *ConstantValue010 {
 			FailKeygenSlashPoints:           720,                 // slash for 720 blocks , which equals 1 hour
 			FailKeySignSlashPoints:          2,                   // slash for 2 blocks
 			StakeLockUpBlocks:               17280,               // the number of blocks staker can unstake after their stake
+			RotationFactor:                  50,                  // maximum size of the standby pool, as a percentage of the active node count
+			ObservationHistoryBlocks:        17280,               // how many blocks of observations are kept for audit before get pruned
+			BadNetworkFeeVoteSlashPoints:    2,                   // slash point for voting a network fee more than 20% away from the median vote
+			RetirementGracePeriodBlocks:     100,                 // how many blocks a retiring vault stay empty before it get retired, so pending inbound txs can still arrive
+			MinReserveContribution:          100_000_000,         // minimum amount of RUNE a reserve contribution should have, 1 rune
+			ChurnMinimumActiveBlocks:        720,                 // how many blocks a node has to be active before it can be churned out for age, equals 1 hour
+			ChurnMaximumSlashPoints:         720,                 // nodes with more slash points are churned out ahead of older nodes
+			MinYggFundPercent:               50,                  // yggdrasil vaults get topped up once they hold less than this percentage of their target amount of a coin
+			SlashVoteSlashPoints:            720,                 // slash points given to a node account the active validators voted to slash
+			MaxUnstakeBasisPoints:           10_000,              // basis points of a full unstake, the unstake math assumes 10000
 		},
 		boolValues: map[ConstantName]bool{
 			StrictBondStakeRatio: true,
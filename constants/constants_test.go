@@ -1,6 +1,7 @@
 package constants
 
 import (
+	"sort"
 	"testing"
 
 	. "gopkg.in/check.v1"
@@ -16,3 +17,10 @@ func (s *ConstantsSuite) Test010(c *C) {
 	consts := NewConstantValue010()
 	c.Check(consts.GetInt64Value(NewPoolCycle), Equals, int64(50000))
 }
+
+func (s *ConstantsSuite) TestGetAllConstantNames(c *C) {
+	names := GetAllConstantNames()
+	c.Assert(names, HasLen, len(nameToString))
+	c.Check(sort.StringsAreSorted(names), Equals, true)
+	c.Check(names[0], Equals, ArtificialRagnarokBlockHeight.String())
+}
@@ -27,7 +27,8 @@ const (
 	RefundStatus = types.Refund
 
 	// Admin config keys
-	MaxUnstakeBasisPoints = types.MaxUnstakeBasisPoints
+	MaxUnstakeBasisPoints      = types.MaxUnstakeBasisPoints
+	MaxAffiliateFeeBasisPoints = types.MaxAffiliateFeeBasisPoints
 
 	// Vaults
 	AsgardVault    = types.AsgardVault
@@ -52,12 +53,16 @@ const (
 
 var (
 	NewPool                        = types.NewPool
+	NewQueryResPool                = types.NewQueryResPool
 	NewTxMarker                    = types.NewTxMarker
 	NewVaultData                   = types.NewVaultData
 	NewObservedTx                  = types.NewObservedTx
 	NewTssVoter                    = types.NewTssVoter
 	NewBanVoter                    = types.NewBanVoter
 	NewErrataTxVoter               = types.NewErrataTxVoter
+	NewObservedSlashVoter          = types.NewObservedSlashVoter
+	NewNetworkFeeVoter             = types.NewNetworkFeeVoter
+	NewSlashRecord                 = types.NewSlashRecord
 	NewObservedTxVoter             = types.NewObservedTxVoter
 	NewMsgMimir                    = types.NewMsgMimir
 	NewMsgNativeTx                 = types.NewMsgNativeTx
@@ -89,6 +94,7 @@ var (
 	NewEventErrata                 = types.NewEventErrata
 	NewEventFee                    = types.NewEventFee
 	NewEventOutbound               = types.NewEventOutbound
+	NewEventNoOp                   = types.NewEventNoOp
 	NewPoolMod                     = types.NewPoolMod
 	NewMsgRefundTx                 = types.NewMsgRefundTx
 	NewMsgOutboundTx               = types.NewMsgOutboundTx
@@ -98,6 +104,7 @@ var (
 	HasSuperMajority               = types.HasSuperMajority
 	ChooseSignerParty              = types.ChooseSignerParty
 	GetThreshold                   = types.GetThreshold
+	CalcAssetEmission              = types.CalcAssetEmission
 	ModuleCdc                      = types.ModuleCdc
 	RegisterCodec                  = types.RegisterCodec
 	NewNodeAccount                 = types.NewNodeAccount
@@ -106,7 +113,12 @@ var (
 	NewMsgYggdrasil                = types.NewMsgYggdrasil
 	NewMsgReserveContributor       = types.NewMsgReserveContributor
 	NewMsgBond                     = types.NewMsgBond
+	NewMsgPartialUnbond            = types.NewMsgPartialUnbond
+	NewMsgSetChainContract         = types.NewMsgSetChainContract
+	NewChainContract               = types.NewChainContract
 	NewMsgErrataTx                 = types.NewMsgErrataTx
+	NewMsgSlash                    = types.NewMsgSlash
+	NewMsgNetworkFeeVote           = types.NewMsgNetworkFeeVote
 	NewMsgBan                      = types.NewMsgBan
 	NewMsgSwitch                   = types.NewMsgSwitch
 	NewMsgLeave                    = types.NewMsgLeave
@@ -135,6 +147,8 @@ type (
 	MsgNativeTx           = types.MsgNativeTx
 	MsgSwitch             = types.MsgSwitch
 	MsgBond               = types.MsgBond
+	MsgPartialUnbond      = types.MsgPartialUnbond
+	MsgSetChainContract   = types.MsgSetChainContract
 	MsgNoOp               = types.MsgNoOp
 	MsgAdd                = types.MsgAdd
 	MsgSetUnStake         = types.MsgSetUnStake
@@ -145,6 +159,8 @@ type (
 	MsgRagnarok           = types.MsgRagnarok
 	MsgRefundTx           = types.MsgRefundTx
 	MsgErrataTx           = types.MsgErrataTx
+	MsgSlash              = types.MsgSlash
+	MsgNetworkFeeVote     = types.MsgNetworkFeeVote
 	MsgBan                = types.MsgBan
 	MsgSwap               = types.MsgSwap
 	MsgSetVersion         = types.MsgSetVersion
@@ -158,7 +174,9 @@ type (
 	MsgTssPool            = types.MsgTssPool
 	MsgTssKeysignFail     = types.MsgTssKeysignFail
 	QueryResPools         = types.QueryResPools
+	QueryResPool          = types.QueryResPool
 	QueryResHeights       = types.QueryResHeights
+	QueryResReserve       = types.QueryResReserve
 	QueryResTxOut         = types.QueryResTxOut
 	QueryYggdrasilVaults  = types.QueryYggdrasilVaults
 	QueryNodeAccount      = types.QueryNodeAccount
@@ -176,6 +194,10 @@ type (
 	ObservedTxIndex       = types.ObservedTxIndex
 	BanVoter              = types.BanVoter
 	ErrataTxVoter         = types.ErrataTxVoter
+	ObservedSlashVoter    = types.ObservedSlashVoter
+	NetworkFeeVoter       = types.NetworkFeeVoter
+	SlashRecord           = types.SlashRecord
+	SlashRecords          = types.SlashRecords
 	TssVoter              = types.TssVoter
 	TssKeysignFailVoter   = types.TssKeysignFailVoter
 	TxOutItem             = types.TxOutItem
@@ -215,4 +237,7 @@ type (
 	EventFee              = types.EventFee
 	EventSlash            = types.EventSlash
 	EventOutbound         = types.EventOutbound
+	EventNoOp             = types.EventNoOp
+	ChainContract         = types.ChainContract
+	ChainContracts        = types.ChainContracts
 )
@@ -1,12 +1,18 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 
 	"gitlab.com/thorchain/thornode/constants"
+	q "gitlab.com/thorchain/thornode/x/thorchain/query"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
@@ -18,6 +24,27 @@ func (v ver) String() string {
 	return v.Version
 }
 
+// constantValue is the current value of a constant on chain
+type constantValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Mimir bool   `json:"mimir"` // true when the value is overridden by mimir
+}
+
+type constantValues []constantValue
+
+func (cv constantValues) String() string {
+	sb := strings.Builder{}
+	for _, item := range cv {
+		if item.Mimir {
+			sb.WriteString(fmt.Sprintf("%s: %s (mimir)\n", item.Name, item.Value))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s: %s\n", item.Name, item.Value))
+	}
+	return sb.String()
+}
+
 func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	thorchainQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -28,6 +55,7 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	}
 	thorchainQueryCmd.AddCommand(client.GetCommands(
 		GetCmdGetVersion(storeKey, cdc),
+		GetCmdGetConstants(storeKey, cdc),
 	)...)
 	return thorchainQueryCmd
 }
@@ -46,3 +74,70 @@ func GetCmdGetVersion(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdGetConstants queries all the constants and their current values, mimir overrides included
+func GetCmdGetConstants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "constants",
+		Short: "Gets all the constants and their current values, mimir overrides included",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(q.QueryConstantValues.Path(queryRoute), nil)
+			if err != nil {
+				return fmt.Errorf("fail to query constants: %w", err)
+			}
+			var values struct {
+				Int64Values  map[string]int64  `json:"int_64_values"`
+				BoolValues   map[string]bool   `json:"bool_values"`
+				StringValues map[string]string `json:"string_values"`
+			}
+			if err := json.Unmarshal(res, &values); err != nil {
+				return fmt.Errorf("fail to unmarshal constants: %w", err)
+			}
+
+			res, _, err = cliCtx.QueryWithData(q.QueryMimirValues.Path(queryRoute), nil)
+			if err != nil {
+				return fmt.Errorf("fail to query mimir values: %w", err)
+			}
+			// mimir values are encoded by the amino codec, which write int64 as string
+			var mimirs map[string]int64
+			if err := cdc.UnmarshalJSON(res, &mimirs); err != nil {
+				return fmt.Errorf("fail to unmarshal mimir values: %w", err)
+			}
+			// mimir values are keyed by their upper case store key
+			overrides := make(map[string]int64, len(mimirs))
+			for k, v := range mimirs {
+				k = k[strings.Index(k, "/")+1:]
+				overrides[strings.ToUpper(k)] = v
+			}
+
+			out := make(constantValues, 0)
+			for _, name := range constants.GetAllConstantNames() {
+				if v, ok := overrides[strings.ToUpper(name)]; ok {
+					out = append(out, constantValue{Name: name, Value: fmt.Sprintf("%d", v), Mimir: true})
+					delete(overrides, strings.ToUpper(name))
+					continue
+				}
+				if v, ok := values.Int64Values[name]; ok {
+					out = append(out, constantValue{Name: name, Value: fmt.Sprintf("%d", v)})
+				} else if v, ok := values.BoolValues[name]; ok {
+					out = append(out, constantValue{Name: name, Value: fmt.Sprintf("%v", v)})
+				} else if v, ok := values.StringValues[name]; ok {
+					out = append(out, constantValue{Name: name, Value: v})
+				}
+			}
+			// mimir could set values that are not constants
+			others := make([]string, 0, len(overrides))
+			for k := range overrides {
+				others = append(others, k)
+			}
+			sort.Strings(others)
+			for _, k := range others {
+				out = append(out, constantValue{Name: k, Value: fmt.Sprintf("%d", overrides[k]), Mimir: true})
+			}
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
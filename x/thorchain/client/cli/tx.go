@@ -33,6 +33,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdSetIPAddress(cdc),
 		GetCmdBan(cdc),
 		GetCmdMimir(cdc),
+		GetCmdSetChainContract(cdc),
 	)...)
 
 	return thorchainTxCmd
@@ -62,6 +63,34 @@ func GetCmdMimir(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdSetChainContract command to change the smart contract of an EVM chain
+func GetCmdSetChainContract(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-chain-contract [chain] [contract address] [abi]",
+		Short: "updates the smart contract of a chain (admin only)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			chain, err := common.NewChain(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid chain: %w", err)
+			}
+			addr, err := common.NewAddress(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid contract address: %w", err)
+			}
+
+			msg := types.NewMsgSetChainContract(types.NewChainContract(chain, addr, args[2]), cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdBan command to ban a node accounts
 func GetCmdBan(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
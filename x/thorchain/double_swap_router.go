@@ -0,0 +1,59 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// DoubleSwapRouter swap between two non-RUNE assets as one atomic unit, which is
+// made of two hops, source asset -> RUNE -> target asset. The pools are only
+// saved once both hops succeed, so when the second hop fails the user get the
+// original asset refunded instead of RUNE
+type DoubleSwapRouter struct {
+	keeper Keeper
+}
+
+// NewDoubleSwapRouter create a new instance of DoubleSwapRouter
+func NewDoubleSwapRouter(keeper Keeper) DoubleSwapRouter {
+	return DoubleSwapRouter{
+		keeper: keeper,
+	}
+}
+
+// Swap the source asset in the given tx to target asset through RUNE
+func (r DoubleSwapRouter) Swap(ctx sdk.Context,
+	tx common.Tx,
+	target common.Asset,
+	destination common.Address,
+	tradeTarget sdk.Uint,
+	affiliateBps sdk.Uint,
+	transactionFee sdk.Uint) (sdk.Uint, []EventSwap, sdk.Error) {
+	var swapEvents []EventSwap
+	// Here we use a tradeTarget of 0 because the target is for the next swap asset in a double swap
+	runeAmt, sourcePool, swapEvt, swapErr := swapOne(ctx, r.keeper, tx, common.RuneAsset(), destination, sdk.ZeroUint(), transactionFee, true)
+	if swapErr != nil {
+		return sdk.ZeroUint(), swapEvents, swapErr
+	}
+	runeTx := tx
+	runeTx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), runeAmt)}
+	runeTx.Gas = nil
+	swapEvt.OutTxs = common.NewTx(common.BlankTxID, tx.FromAddress, tx.ToAddress, runeTx.Coins, nil, tx.Memo)
+	swapEvents = append(swapEvents, swapEvt)
+
+	assetAmount, targetPool, swapEvt, swapErr := swapOne(ctx, r.keeper, runeTx, target, destination, tradeTarget, transactionFee, true)
+	if swapErr != nil {
+		return sdk.ZeroUint(), swapEvents, swapErr
+	}
+	if err := validateSwapOutput(common.SafeSub(assetAmount, calcAffiliateFee(assetAmount, affiliateBps)), target, tradeTarget, transactionFee); err != nil {
+		return sdk.ZeroUint(), swapEvents, err
+	}
+	swapEvents = append(swapEvents, swapEvt)
+
+	for _, pool := range []Pool{sourcePool, targetPool} {
+		if err := r.keeper.SetPool(ctx, pool); err != nil {
+			return sdk.ZeroUint(), swapEvents, sdk.NewError(DefaultCodespace, CodeSwapFail, err.Error())
+		}
+	}
+	return assetAmount, swapEvents, nil
+}
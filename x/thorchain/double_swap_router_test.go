@@ -0,0 +1,52 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type DoubleSwapRouterSuite struct{}
+
+var _ = Suite(&DoubleSwapRouterSuite{})
+
+func (s *DoubleSwapRouterSuite) TestSwap(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	for _, asset := range []common.Asset{common.BNBAsset, common.BTCAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolEnabled
+		pool.BalanceRune = sdk.NewUint(100 * common.One)
+		pool.BalanceAsset = sdk.NewUint(100 * common.One)
+		c.Assert(k.SetPool(ctx, pool), IsNil)
+	}
+	router := NewDoubleSwapRouter(k)
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(5*common.One))}
+	fee := sdk.NewUint(common.One)
+
+	// second hop fail to meet the trade target, first hop should not be saved
+	amt, _, err := router.Swap(ctx, tx, common.BNBAsset, GetRandomBNBAddress(), sdk.NewUint(5*common.One), sdk.ZeroUint(), fee)
+	c.Assert(err, NotNil)
+	c.Assert(err.Code(), Equals, CodeSwapFailTradeTarget)
+	c.Check(amt.IsZero(), Equals, true)
+	btcPool, poolErr := k.GetPool(ctx, common.BTCAsset)
+	c.Assert(poolErr, IsNil)
+	c.Check(btcPool.BalanceRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(btcPool.BalanceAsset.Equal(sdk.NewUint(100*common.One)), Equals, true)
+
+	// happy path
+	amt, events, err := router.Swap(ctx, tx, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), sdk.ZeroUint(), fee)
+	c.Assert(err, IsNil)
+	c.Check(amt.IsZero(), Equals, false)
+	c.Assert(events, HasLen, 2)
+	c.Check(events[0].DoubleSwap, Equals, true)
+	c.Check(events[1].DoubleSwap, Equals, true)
+	btcPool, poolErr = k.GetPool(ctx, common.BTCAsset)
+	c.Assert(poolErr, IsNil)
+	c.Check(btcPool.BalanceAsset.Equal(sdk.NewUint(105*common.One)), Equals, true)
+	bnbPool, poolErr := k.GetPool(ctx, common.BNBAsset)
+	c.Assert(poolErr, IsNil)
+	c.Check(bnbPool.BalanceAsset.Equal(common.SafeSub(sdk.NewUint(100*common.One), amt)), Equals, true)
+}
@@ -74,6 +74,10 @@ func (m *DummyEventMgr) EmitOutboundEvent(ctx sdk.Context, outbound EventOutboun
 	return nil
 }
 
+func (m *DummyEventMgr) EmitNoOpEvent(ctx sdk.Context, keeper Keeper, noOpEvent EventNoOp) error {
+	return nil
+}
+
 type DummyVersionedEventMgr struct{}
 
 func NewDummyVersionedEventMgr() *DummyVersionedEventMgr {
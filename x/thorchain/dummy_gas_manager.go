@@ -17,8 +17,20 @@ func NewDummyGasManager() *DummyGasManager {
 func (m *DummyGasManager) BeginBlock()                                                        {}
 func (m *DummyGasManager) EndBlock(ctx sdk.Context, keeper Keeper, eventManager EventManager) {}
 func (m *DummyGasManager) AddGasAsset(gas common.Gas)                                         {}
-func (m *DummyGasManager) GetGas() common.Gas                                                 { return nil }
-func (m *DummyGasManager) ProcessGas(ctx sdk.Context, keeper Keeper)                          {}
+func (m *DummyGasManager) RecordGasSpent(ctx sdk.Context, chain common.Chain, gasCoin common.Coin) error {
+	return nil
+}
+func (m *DummyGasManager) GetGasSpent(ctx sdk.Context, chain common.Chain) (common.Coins, error) {
+	return nil, nil
+}
+func (m *DummyGasManager) ReimburseGas(ctx sdk.Context, keeper Keeper, pool common.Asset, gasCoin common.Coin) error {
+	return nil
+}
+func (m *DummyGasManager) TotalGasInRune(ctx sdk.Context, keeper Keeper) (sdk.Uint, error) {
+	return sdk.ZeroUint(), nil
+}
+func (m *DummyGasManager) GetGas() common.Gas                        { return nil }
+func (m *DummyGasManager) ProcessGas(ctx sdk.Context, keeper Keeper) {}
 
 type DummyVersionedGasMgr struct {
 }
@@ -26,6 +26,7 @@ type EventManager interface {
 	EmitFeeEvent(ctx sdk.Context, keeper Keeper, feeEvent EventFee) error
 	EmitSlashEvent(ctx sdk.Context, keeper Keeper, slashEvt EventSlash) error
 	EmitOutboundEvent(ctx sdk.Context, outbound EventOutbound) error
+	EmitNoOpEvent(ctx sdk.Context, keeper Keeper, noOpEvent EventNoOp) error
 }
 
 // EventMgr implement EventManager interface
@@ -208,6 +209,9 @@ func (m *EventMgr) EmitReserveEvent(ctx sdk.Context, keeper Keeper, reserveEvent
 
 // EmitRefundEvent emit refund event , save it to local key value store and also emit through event manager
 func (m *EventMgr) EmitRefundEvent(ctx sdk.Context, keeper Keeper, refundEvt EventRefund, status EventStatus) error {
+	if err := refundEvt.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid refund fee: %w", err)
+	}
 	buf, err := json.Marshal(refundEvt)
 	if err != nil {
 		return fmt.Errorf("fail to marshal refund event: %w", err)
@@ -320,6 +324,9 @@ func (m *EventMgr) EmitSlashEvent(ctx sdk.Context, keeper Keeper, slashEvt Event
 
 // EmitFeeEvent emit a fee event through event manager
 func (m *EventMgr) EmitFeeEvent(ctx sdk.Context, keeper Keeper, feeEvent EventFee) error {
+	if err := feeEvent.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid fee: %w", err)
+	}
 	if err := updateEventFee(ctx, keeper, feeEvent.TxID, feeEvent.Fee); err != nil {
 		return fmt.Errorf("fail to update event fee: %w", err)
 	}
@@ -340,3 +347,21 @@ func (m *EventMgr) EmitOutboundEvent(ctx sdk.Context, outbound EventOutbound) er
 	ctx.EventManager().EmitEvents(events)
 	return nil
 }
+
+// EmitNoOpEvent emit noop event, save it to local key value store and also emit through event manager
+func (m *EventMgr) EmitNoOpEvent(ctx sdk.Context, keeper Keeper, noOpEvent EventNoOp) error {
+	buf, err := json.Marshal(noOpEvent)
+	if err != nil {
+		return fmt.Errorf("fail to marshal noop event: %w", err)
+	}
+	e := NewEvent(noOpEvent.Type(), ctx.BlockHeight(), noOpEvent.InTx, buf, EventSuccess)
+	if err := keeper.UpsertEvent(ctx, e); err != nil {
+		return fmt.Errorf("fail to save noop event: %w", err)
+	}
+	events, err := noOpEvent.Events()
+	if err != nil {
+		return fmt.Errorf("fail to get noop events: %w", err)
+	}
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
@@ -16,7 +16,7 @@ func (s *EventManagerTestSuite) TestEmitPoolEvent(c *C) {
 	eventMgr := NewEventMgr()
 	c.Assert(eventMgr, NotNil)
 	ctx = ctx.WithBlockHeight(1024)
-	c.Assert(eventMgr.EmitPoolEvent(ctx, k, common.BlankTxID, EventSuccess, NewEventPool(common.BNBAsset, PoolEnabled)), IsNil)
+	c.Assert(eventMgr.EmitPoolEvent(ctx, k, common.BlankTxID, EventSuccess, NewEventPool(common.BNBAsset, PoolEnabled, sdk.NewUint(common.One), sdk.NewUint(common.One))), IsNil)
 }
 
 func (s *EventManagerTestSuite) TestEmitErrataEvent(c *C) {
@@ -24,7 +24,7 @@ func (s *EventManagerTestSuite) TestEmitErrataEvent(c *C) {
 	eventMgr := NewEventMgr()
 	c.Assert(eventMgr, NotNil)
 	ctx = ctx.WithBlockHeight(1024)
-	errataEvent := NewEventErrata(GetRandomTxHash(), PoolMods{
+	errataEvent, err := NewEventErrata(GetRandomTxHash(), PoolMods{
 		PoolMod{
 			Asset:    common.BNBAsset,
 			RuneAmt:  sdk.ZeroUint(),
@@ -33,6 +33,7 @@ func (s *EventManagerTestSuite) TestEmitErrataEvent(c *C) {
 			AssetAdd: true,
 		},
 	})
+	c.Assert(err, IsNil)
 	c.Assert(eventMgr.EmitErrataEvent(ctx, k, common.BlankTxID, errataEvent), IsNil)
 }
 
@@ -50,3 +51,18 @@ func (s *EventManagerTestSuite) TestEmitGasEvent(c *C) {
 	})
 	c.Assert(eventMgr.EmitGasEvent(ctx, k, gasEvent), IsNil)
 }
+
+func (s *EventManagerTestSuite) TestEmitFeeEventInvalidFee(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	eventMgr := NewEventMgr()
+	c.Assert(eventMgr, NotNil)
+	ctx = ctx.WithBlockHeight(1024)
+	fee := common.NewFee(common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100)),
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100)),
+	}, sdk.ZeroUint())
+	c.Assert(eventMgr.EmitFeeEvent(ctx, k, NewEventFee(GetRandomTxHash(), fee)), NotNil)
+
+	refundEvt := NewEventRefund(CodeInvalidMemo, "invalid memo", GetRandomTx(), common.Fee{})
+	c.Assert(eventMgr.EmitRefundEvent(ctx, k, refundEvt, RefundStatus), NotNil)
+}
@@ -1,6 +1,9 @@
 package thorchain
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -10,6 +13,10 @@ type GasManager interface {
 	BeginBlock()
 	EndBlock(ctx sdk.Context, keeper Keeper, eventManager EventManager)
 	AddGasAsset(gas common.Gas)
+	RecordGasSpent(ctx sdk.Context, chain common.Chain, gasCoin common.Coin) error
+	GetGasSpent(ctx sdk.Context, chain common.Chain) (common.Coins, error)
+	ReimburseGas(ctx sdk.Context, keeper Keeper, pool common.Asset, gasCoin common.Coin) error
+	TotalGasInRune(ctx sdk.Context, keeper Keeper) (sdk.Uint, error)
 	ProcessGas(ctx sdk.Context, keeper Keeper)
 	GetGas() common.Gas
 }
@@ -46,6 +53,49 @@ func (gm *GasMgr) AddGasAsset(gas common.Gas) {
 	}
 }
 
+// RecordGasSpent record the gas spent on the given chain in the current block, it get reimbursed to the pool at the
+// end of the block
+func (gm *GasMgr) RecordGasSpent(ctx sdk.Context, chain common.Chain, gasCoin common.Coin) error {
+	if gasCoin.Asset.IsEmpty() {
+		return errors.New("gas asset is empty")
+	}
+	if !gasCoin.Asset.Chain.Equals(chain) {
+		return fmt.Errorf("gas coin %s is not on chain %s", gasCoin.Asset, chain)
+	}
+	if gasCoin.Amount.IsZero() {
+		return nil
+	}
+	gm.AddGasAsset(common.Gas{gasCoin})
+	return nil
+}
+
+// GetGasSpent return the gas spent on the given chain in the current block
+func (gm *GasMgr) GetGasSpent(ctx sdk.Context, chain common.Chain) (common.Coins, error) {
+	coins := make(common.Coins, 0)
+	for _, coin := range gm.gas {
+		if coin.Asset.Chain.Equals(chain) {
+			coins = append(coins, coin)
+		}
+	}
+	return coins, nil
+}
+
+// TotalGasInRune return the value in RUNE of all the gas spent in the current block
+func (gm *GasMgr) TotalGasInRune(ctx sdk.Context, keeper Keeper) (sdk.Uint, error) {
+	total := sdk.ZeroUint()
+	for _, gas := range gm.gas {
+		if gas.Amount.IsZero() {
+			continue
+		}
+		pool, err := keeper.GetPool(ctx, gas.Asset)
+		if err != nil {
+			return sdk.ZeroUint(), fmt.Errorf("fail to get pool(%s): %w", gas.Asset, err)
+		}
+		total = total.Add(pool.AssetValueInRune(gas.Amount))
+	}
+	return total, nil
+}
+
 func (gm *GasMgr) GetGas() common.Gas {
 	return gm.gas
 }
@@ -65,62 +115,68 @@ func (gm *GasMgr) EndBlock(ctx sdk.Context, keeper Keeper, eventManager EventMan
 
 // ProcessGas to subsidise the pool with RUNE for the gas they have spent
 func (gm *GasMgr) ProcessGas(ctx sdk.Context, keeper Keeper) {
-	vault, err := keeper.GetVaultData(ctx)
-	if err != nil {
-		ctx.Logger().Error("fail to get vault data", "error", err)
-		return
-	}
 	for _, gas := range gm.gas {
 		// if the coin is zero amount, don't need to do anything
 		if gas.Amount.IsZero() {
 			continue
 		}
-
-		pool, err := keeper.GetPool(ctx, gas.Asset)
-		if err != nil {
-			ctx.Logger().Error("fail to get pool", "pool", gas.Asset, "error", err)
-			continue
-		}
-		if err := pool.Valid(); err != nil {
-			ctx.Logger().Error("fail to get pool", "pool", gas.Asset, "error", err)
-			continue
+		if err := gm.ReimburseGas(ctx, keeper, gas.Asset, gas); err != nil {
+			ctx.Logger().Error("fail to reimburse gas", "pool", gas.Asset, "error", err)
 		}
-		runeGas := pool.AssetValueInRune(gas.Amount) // Convert to Rune (gas will never be RUNE)
-
-		// If Rune owed now exceeds the Total Reserve, return it all
-		if common.RuneAsset().Chain.Equals(common.THORChain) {
-			if runeGas.LT(keeper.GetRuneBalaceOfModule(ctx, ReserveName)) {
-				coin := common.NewCoin(common.RuneNative, runeGas)
-				if err := keeper.SendFromModuleToModule(ctx, ReserveName, AsgardName, coin); err != nil {
-					ctx.Logger().Error("fail to transfer funds from reserve to asgard", "pool", gas.Asset, "error", err)
-					continue
-				}
-				pool.BalanceRune = pool.BalanceRune.Add(runeGas) // Add to the pool
-			}
-		} else {
-			if runeGas.LT(vault.TotalReserve) {
-				vault.TotalReserve = common.SafeSub(vault.TotalReserve, runeGas) // Deduct from the Reserve.
-				pool.BalanceRune = pool.BalanceRune.Add(runeGas)                 // Add to the pool
+	}
+}
+
+// ReimburseGas pay the pool back in RUNE from the reserve for the gas it has spent, the gas asset is deducted from the
+// pool either way
+func (gm *GasMgr) ReimburseGas(ctx sdk.Context, keeper Keeper, asset common.Asset, gasCoin common.Coin) error {
+	if !gasCoin.Asset.Equals(asset) {
+		return fmt.Errorf("gas coin %s doesn't belong to pool %s", gasCoin.Asset, asset)
+	}
+	vault, err := keeper.GetVaultData(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get vault data: %w", err)
+	}
+	pool, err := keeper.GetPool(ctx, asset)
+	if err != nil {
+		return fmt.Errorf("fail to get pool: %w", err)
+	}
+	if err := pool.Valid(); err != nil {
+		return fmt.Errorf("invalid pool: %w", err)
+	}
+	runeGas := pool.AssetValueInRune(gasCoin.Amount) // Convert to Rune (gas will never be RUNE)
+
+	// If Rune owed now exceeds the Total Reserve, return it all
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		if runeGas.LT(keeper.GetRuneBalaceOfModule(ctx, ReserveName)) {
+			coin := common.NewCoin(common.RuneNative, runeGas)
+			if err := keeper.SendFromModuleToModule(ctx, ReserveName, AsgardName, coin); err != nil {
+				return fmt.Errorf("fail to transfer funds from reserve to asgard: %w", err)
 			}
+			pool.BalanceRune = pool.BalanceRune.Add(runeGas) // Add to the pool
+		}
+	} else {
+		if runeGas.LT(vault.TotalReserve) {
+			vault.TotalReserve = common.SafeSub(vault.TotalReserve, runeGas) // Deduct from the Reserve.
+			pool.BalanceRune = pool.BalanceRune.Add(runeGas)                 // Add to the pool
 		}
+	}
 
-		pool.BalanceAsset = common.SafeSub(pool.BalanceAsset, gas.Amount)
+	pool.BalanceAsset = common.SafeSub(pool.BalanceAsset, gasCoin.Amount)
 
-		if err := keeper.SetPool(ctx, pool); err != nil {
-			ctx.Logger().Error("fail to set pool", "pool", gas.Asset, "error", err)
-			continue
-		}
+	if err := keeper.SetPool(ctx, pool); err != nil {
+		return fmt.Errorf("fail to set pool: %w", err)
+	}
 
-		gasPool := GasPool{
-			Asset:    gas.Asset,
-			AssetAmt: gas.Amount,
-			RuneAmt:  runeGas,
-			Count:    gm.gasCount[gas.Asset],
-		}
-		gm.gasEvent.UpsertGasPool(gasPool)
+	gasPool := GasPool{
+		Asset:    asset,
+		AssetAmt: gasCoin.Amount,
+		RuneAmt:  runeGas,
+		Count:    gm.gasCount[asset],
 	}
+	gm.gasEvent.UpsertGasPool(gasPool)
 
 	if err := keeper.SetVaultData(ctx, vault); err != nil {
-		ctx.Logger().Error("fail to set vault data", "error", err)
+		return fmt.Errorf("fail to set vault data: %w", err)
 	}
+	return nil
 }
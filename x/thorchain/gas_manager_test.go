@@ -47,3 +47,59 @@ func (GasManagerTestSuite) TestGasManager(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(event.Type, Equals, gasMgr.gasEvent.Type())
 }
+
+func (GasManagerTestSuite) TestRecordGasSpent(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	gasMgr := NewGasMgr()
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.BNBChain, common.NewCoin(common.BNBAsset, sdk.NewUint(37500))), IsNil)
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.BNBChain, common.NewCoin(common.BNBAsset, sdk.NewUint(37500))), IsNil)
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.BTCChain, common.NewCoin(common.BTCAsset, sdk.NewUint(1000))), IsNil)
+	// zero gas is not recorded
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.ETHChain, common.NewCoin(common.ETHAsset, sdk.ZeroUint())), IsNil)
+	// gas coin on another chain
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.ETHChain, common.NewCoin(common.BNBAsset, sdk.NewUint(1))), NotNil)
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.BNBChain, common.NoCoin), NotNil)
+	c.Assert(gasMgr.GetGas(), HasLen, 2)
+	c.Check(gasMgr.gasCount[common.BNBAsset], Equals, int64(2))
+
+	coins, err := gasMgr.GetGasSpent(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 1)
+	c.Check(coins[0].Equals(common.NewCoin(common.BNBAsset, sdk.NewUint(75000))), Equals, true)
+	coins, err = gasMgr.GetGasSpent(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(coins, HasLen, 0)
+}
+
+func (GasManagerTestSuite) TestReimburseGas(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	gasMgr := NewGasMgr()
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(50 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	vault := NewVaultData()
+	vault.TotalReserve = sdk.NewUint(1000 * common.One)
+	c.Assert(k.SetVaultData(ctx, vault), IsNil)
+
+	gasCoin := common.NewCoin(common.BNBAsset, sdk.NewUint(common.One))
+	c.Assert(gasMgr.RecordGasSpent(ctx, common.BNBChain, gasCoin), IsNil)
+	totalGas, err := gasMgr.TotalGasInRune(ctx, k)
+	c.Assert(err, IsNil)
+	c.Check(totalGas.Equal(sdk.NewUint(2*common.One)), Equals, true)
+
+	c.Assert(gasMgr.ReimburseGas(ctx, k, common.BTCAsset, gasCoin), NotNil)
+	c.Assert(gasMgr.ReimburseGas(ctx, k, common.BNBAsset, gasCoin), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(102*common.One)), Equals, true)
+	c.Check(pool.BalanceAsset.Equal(sdk.NewUint(49*common.One)), Equals, true)
+	vault, err = k.GetVaultData(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vault.TotalReserve.Equal(sdk.NewUint(998*common.One)), Equals, true)
+	c.Assert(gasMgr.gasEvent.Pools, HasLen, 1)
+	c.Check(gasMgr.gasEvent.Pools[0].RuneAmt.Equal(sdk.NewUint(2*common.One)), Equals, true)
+}
@@ -2,11 +2,14 @@ package thorchain
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 // GenesisState strcture that used to store the data THORNode put in genesis
@@ -21,6 +24,9 @@ type GenesisState struct {
 	Vaults           Vaults                `json:"vaults"`
 	Gas              map[string][]sdk.Uint `json:"gas"`
 	Reserve          uint64                `json:"reserve"`
+	KeygenBlocks     []KeygenBlock         `json:"keygen_blocks"`
+	NetworkFees      map[string]sdk.Uint   `json:"network_fees"`
+	VaultData        VaultData             `json:"vault_data"`
 }
 
 // NewGenesisState create a new instance of GenesisState
@@ -81,19 +87,16 @@ func DefaultGenesisState() GenesisState {
 		Vaults:           make(Vaults, 0),
 		ObservedTxVoters: make(ObservedTxVoters, 0),
 		Gas:              make(map[string][]sdk.Uint, 0),
+		KeygenBlocks:     make([]KeygenBlock, 0),
+		NetworkFees:      make(map[string]sdk.Uint, 0),
+		VaultData:        NewVaultData(),
 	}
 }
 
 // InitGenesis read the data in GenesisState and apply it to data store
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
-	for _, record := range data.Pools {
-		if err := keeper.SetPool(ctx, record); err != nil {
-			panic(err)
-		}
-	}
-
-	for _, stake := range data.Stakers {
-		keeper.SetStaker(ctx, stake)
+	if err := constants.ValidateConstants(constants.GetConstantValues(constants.SWVersion)); err != nil {
+		panic(err)
 	}
 
 	validators := make([]abci.ValidatorUpdate, 0, len(data.NodeAccounts))
@@ -110,16 +113,81 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 				Power:  100,
 			})
 		}
+	}
 
-		if err := keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
-			// we should panic
+	if err := ImportGenesis(ctx, keeper, data); err != nil {
+		// we should panic
+		panic(err)
+	}
+
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		// Mint coins into the reserve, when an exported genesis is imported the reserve already has its balance
+		// from the bank state, only mint what is missing
+		amount := common.SafeSub(sdk.NewUint(data.Reserve), keeper.GetRuneBalaceOfModule(ctx, ReserveName))
+		if !amount.IsZero() {
+			coin, err := common.NewCoin(common.RuneNative, amount).Native()
+			if err != nil {
+				panic(err)
+			}
+			coins := sdk.NewCoins(coin)
+			if err := keeper.Supply().MintCoins(ctx, ModuleName, coins); err != nil {
+				panic(err)
+			}
+			if err := keeper.Supply().SendCoinsFromModuleToModule(ctx, ModuleName, ReserveName, coins); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// give mimir gas
+	coinsToMint, err := sdk.ParseCoins("1000thor")
+	if err != nil {
+		panic(err)
+	}
+	// an imported genesis already has the gas of the admin in the bank state
+	if keeper.CoinKeeper().GetCoins(ctx, ADMIN).AmountOf(coinsToMint[0].Denom).IsZero() {
+		// mint some gas asset
+		err = keeper.Supply().MintCoins(ctx, ModuleName, coinsToMint)
+		if err != nil {
+			panic(err)
+		}
+		if err := keeper.Supply().SendCoinsFromModuleToAccount(ctx, ModuleName, ADMIN, coinsToMint); err != nil {
 			panic(err)
 		}
 	}
 
+	return validators
+}
+
+// ImportGenesis save all the data in GenesisState to the data store, node accounts are saved first as the keys of
+// all the other records depend on the version of the active node accounts
+func ImportGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) error {
+	for _, nodeAccount := range data.NodeAccounts {
+		if err := keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
+			return fmt.Errorf("fail to save node account(%s): %w", nodeAccount.NodeAddress, err)
+		}
+	}
+
+	// the thorchain version starts with the lowest version of the active node accounts in genesis
+	if version := keeper.GetLowestActiveVersion(ctx); !version.Equals(semver.Version{}) {
+		if err := keeper.SetThorchainVersion(ctx, version); err != nil {
+			return fmt.Errorf("fail to save thorchain version: %w", err)
+		}
+	}
+
+	for _, record := range data.Pools {
+		if err := keeper.SetPool(ctx, record); err != nil {
+			return fmt.Errorf("fail to save pool(%s): %w", record.Asset, err)
+		}
+	}
+
+	for _, stake := range data.Stakers {
+		keeper.SetStaker(ctx, stake)
+	}
+
 	for _, vault := range data.Vaults {
 		if err := keeper.SetVault(ctx, vault); err != nil {
-			panic(err)
+			return fmt.Errorf("fail to save vault(%s): %w", vault.PubKey, err)
 		}
 	}
 
@@ -127,59 +195,48 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 		keeper.SetObservedTxVoter(ctx, voter)
 	}
 
-	for _, out := range data.TxOuts {
-		if err := keeper.SetTxOut(ctx, &out); err != nil {
-			ctx.Logger().Error("fail to save tx out during genesis", "error", err)
-			panic(err)
+	for i := range data.TxOuts {
+		if err := keeper.SetTxOut(ctx, &data.TxOuts[i]); err != nil {
+			return fmt.Errorf("fail to save tx out: %w", err)
 		}
 	}
 
 	for _, e := range data.Events {
 		if err := keeper.UpsertEvent(ctx, e); err != nil {
-			panic(err)
+			return fmt.Errorf("fail to save event(%d): %w", e.ID, err)
+		}
+	}
+
+	for _, keygen := range data.KeygenBlocks {
+		if err := keeper.SetKeygenBlock(ctx, keygen); err != nil {
+			return fmt.Errorf("fail to save keygen block(%d): %w", keygen.Height, err)
 		}
 	}
 
 	for k, v := range data.Gas {
 		asset, err := common.NewAsset(k)
 		if err != nil {
-			panic(err)
+			return fmt.Errorf("fail to parse gas asset(%s): %w", k, err)
 		}
 		keeper.SetGas(ctx, asset, v)
 	}
 
-	keeper.SetCurrentEventID(ctx, data.CurrentEventID)
-
-	if common.RuneAsset().Chain.Equals(common.THORChain) {
-		// Mint coins into the reserve
-		coin, err := common.NewCoin(common.RuneNative, sdk.NewUint(data.Reserve)).Native()
+	for k, v := range data.NetworkFees {
+		chain, err := common.NewChain(k)
 		if err != nil {
-			panic(err)
-		}
-		coins := sdk.NewCoins(coin)
-		if err := keeper.Supply().MintCoins(ctx, ModuleName, coins); err != nil {
-			panic(err)
+			return fmt.Errorf("fail to parse network fee chain(%s): %w", k, err)
 		}
-		if err := keeper.Supply().SendCoinsFromModuleToModule(ctx, ModuleName, ReserveName, coins); err != nil {
-			panic(err)
+		if err := keeper.SaveNetworkFee(ctx, chain, v); err != nil {
+			return fmt.Errorf("fail to save %s network fee: %w", chain, err)
 		}
 	}
 
-	// give mimir gas
-	coinsToMint, err := sdk.ParseCoins("1000thor")
-	if err != nil {
-		panic(err)
-	}
-	// mint some gas asset
-	err = keeper.Supply().MintCoins(ctx, ModuleName, coinsToMint)
-	if err != nil {
-		panic(err)
-	}
-	if err := keeper.Supply().SendCoinsFromModuleToAccount(ctx, ModuleName, ADMIN, coinsToMint); err != nil {
-		panic(err)
+	if err := keeper.SetVaultData(ctx, data.VaultData); err != nil {
+		return fmt.Errorf("fail to save vault data: %w", err)
 	}
 
-	return validators
+	keeper.SetCurrentEventID(ctx, data.CurrentEventID)
+	return nil
 }
 
 // ExportGenesis export the data in Genesis
@@ -240,12 +297,52 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	}
 
 	gas := make(map[string][]sdk.Uint, 0)
+	gasPrefix := k.GetKey(ctx, prefixGas, "")
 	iterator = k.GetGasIterator(ctx)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var g []sdk.Uint
 		k.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &g)
-		gas[string(iterator.Key())] = g
+		gas[strings.TrimPrefix(string(iterator.Key()), gasPrefix)] = g
+	}
+
+	var vaults Vaults
+	iterator = k.GetVaultIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vault Vault
+		k.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &vault)
+		vaults = append(vaults, vault)
+	}
+
+	var keygens []KeygenBlock
+	iterator = k.GetKeygenBlockIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var keygen KeygenBlock
+		k.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &keygen)
+		keygens = append(keygens, keygen)
+	}
+
+	networkFees := make(map[string]sdk.Uint, 0)
+	networkFeePrefix := k.GetKey(ctx, prefixNetworkFee, "")
+	iterator = k.GetNetworkFeeIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee sdk.Uint
+		k.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &fee)
+		networkFees[strings.TrimPrefix(string(iterator.Key()), networkFeePrefix)] = fee
+	}
+
+	vaultData, err := k.GetVaultData(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// the reserve only holds native RUNE, otherwise it is accounted in the vault data
+	reserve := uint64(0)
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		reserve = k.GetRuneBalaceOfModule(ctx, ReserveName).Uint64()
 	}
 
 	return GenesisState{
@@ -257,5 +354,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		CurrentEventID:   currentEventID,
 		Events:           events,
 		Gas:              gas,
+		Vaults:           vaults,
+		KeygenBlocks:     keygens,
+		NetworkFees:      networkFees,
+		VaultData:        vaultData,
+		Reserve:          reserve,
 	}
 }
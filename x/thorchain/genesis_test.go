@@ -0,0 +1,90 @@
+package thorchain
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type GenesisSuite struct{}
+
+var _ = Suite(&GenesisSuite{})
+
+func (GenesisSuite) TestExportImportGenesis(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	vault := GetRandomVault()
+	vault.AddFunds(common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One))})
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+
+	txOut := NewTxOut(1)
+	txOut.TxArray = append(txOut.TxArray, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(common.One)),
+		Memo:        "hello",
+	})
+	c.Assert(k.SetTxOut(ctx, txOut), IsNil)
+
+	swap := NewEventSwap(common.BNBAsset, sdk.NewUint(5), sdk.NewUint(5), sdk.NewUint(5), sdk.NewUint(5), false, GetRandomTx())
+	swapBytes, err := json.Marshal(swap)
+	c.Assert(err, IsNil)
+	c.Assert(k.UpsertEvent(ctx, NewEvent(swap.Type(), 12, swap.InTx, swapBytes, EventSuccess)), IsNil)
+
+	keygenBlock := NewKeygenBlock(10)
+	keygen, err := NewKeygen(10, common.PubKeys{GetRandomPubKey(), GetRandomPubKey()}, AsgardKeygen)
+	c.Assert(err, IsNil)
+	keygenBlock.Keygens = append(keygenBlock.Keygens, keygen)
+	c.Assert(k.SetKeygenBlock(ctx, keygenBlock), IsNil)
+
+	k.SetGas(ctx, common.BNBAsset, []sdk.Uint{sdk.NewUint(37500), sdk.NewUint(30000)})
+	c.Assert(k.SaveNetworkFee(ctx, common.BNBChain, sdk.NewUint(37500)), IsNil)
+
+	vaultData := NewVaultData()
+	vaultData.TotalReserve = sdk.NewUint(1000 * common.One)
+	vaultData.BondRewardRune = sdk.NewUint(common.One)
+	c.Assert(k.SetVaultData(ctx, vaultData), IsNil)
+
+	state := ExportGenesis(ctx, k)
+	c.Assert(ValidateGenesis(state), IsNil)
+	c.Check(state.Pools, HasLen, 1)
+	c.Check(state.NodeAccounts, HasLen, 1)
+	c.Check(state.Vaults, HasLen, 1)
+	c.Check(state.TxOuts, HasLen, 1)
+	c.Check(state.Events, HasLen, 1)
+	c.Check(state.KeygenBlocks, HasLen, 1)
+	c.Check(state.Gas, HasLen, 1)
+	c.Check(state.Gas[common.BNBAsset.String()], HasLen, 2)
+	c.Check(state.NetworkFees[common.BNBChain.String()].Equal(sdk.NewUint(37500)), Equals, true)
+	c.Check(state.VaultData.TotalReserve.Equal(vaultData.TotalReserve), Equals, true)
+	c.Check(state.VaultData.BondRewardRune.Equal(vaultData.BondRewardRune), Equals, true)
+
+	// import into an empty store and make sure exactly the same state comes out
+	ctx1, k1 := setupKeeperForTest(c)
+	c.Assert(ImportGenesis(ctx1, k1, state), IsNil)
+	c.Check(ExportGenesis(ctx1, k1), DeepEquals, state)
+
+	// the admin gas is minted only once, an imported genesis already has it
+	ctx2, k2 := setupKeeperForTest(c)
+	InitGenesis(ctx2, k2, state)
+	InitGenesis(ctx2, k2, state)
+	c.Check(k2.CoinKeeper().GetCoins(ctx2, ADMIN).AmountOf("thor").Int64(), Equals, int64(1000))
+
+	// gas asset can't be parsed
+	state.Gas["BNB.BNB.BNB"] = []sdk.Uint{sdk.NewUint(1)}
+	c.Check(ImportGenesis(ctx1, k1, state), NotNil)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -36,6 +37,7 @@ const (
 	CodeStakeInvalidPoolAsset  sdk.CodeType = 124
 	CodeStakeRUNEOverLimit     sdk.CodeType = 125
 	CodeStakeRUNEMoreThanBond  sdk.CodeType = 126
+	CodeStakePoolRagnarok      sdk.CodeType = 127
 
 	CodeUnstakeFailValidation sdk.CodeType = 130
 	CodeFailAddOutboundTx     sdk.CodeType = 131
@@ -46,6 +48,7 @@ const (
 	CodeUnstakeFail           sdk.CodeType = 137
 	CodeEmptyChain            sdk.CodeType = 138
 	CodeFailEventManager      sdk.CodeType = 139
+	CodeTxExpired             sdk.CodeType = 141
 )
 
 var (
@@ -69,7 +72,7 @@ func NewExternalHandler(keeper Keeper,
 
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
-		version := keeper.GetLowestActiveVersion(ctx)
+		version := keeper.GetThorchainVersion(ctx)
 		constantValues := constants.GetConstantValues(version)
 		if constantValues == nil {
 			return errConstNotAvailable.Result()
@@ -107,6 +110,10 @@ func getHandlerMapping(keeper Keeper,
 	m[MsgErrataTx{}.Type()] = NewErrataTxHandler(keeper, versionedEventManager)
 	m[MsgSend{}.Type()] = NewSendHandler(keeper)
 	m[MsgMimir{}.Type()] = NewMimirHandler(keeper)
+	m[MsgSlash{}.Type()] = NewSlashHandler(keeper, versionedEventManager)
+	m[MsgPartialUnbond{}.Type()] = NewPartialUnbondHandler(keeper, versionedTxOutStore, versionedEventManager)
+	m[MsgSetChainContract{}.Type()] = NewSetChainContractHandler(keeper)
+	m[MsgNetworkFeeVote{}.Type()] = NewNetworkFeeVoteHandler(keeper)
 	return m
 }
 
@@ -121,7 +128,7 @@ func NewInternalHandler(keeper Keeper,
 	handlerMap := getInternalHandlerMapping(keeper, versionedTxOutStore, validatorMgr, versionedVaultManager, versionedObserverManager, versionedGasMgr, versionedEventManager)
 
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
-		version := keeper.GetLowestActiveVersion(ctx)
+		version := keeper.GetThorchainVersion(ctx)
 		constantValues := constants.GetConstantValues(version)
 		if constantValues == nil {
 			return errConstNotAvailable.Result()
@@ -157,6 +164,7 @@ func getInternalHandlerMapping(keeper Keeper,
 	m[MsgMigrate{}.Type()] = NewMigrateHandler(keeper, versionedEventManager)
 	m[MsgRagnarok{}.Type()] = NewRagnarokHandler(keeper, versionedEventManager)
 	m[MsgSwitch{}.Type()] = NewSwitchHandler(keeper, versionedTxOutStore)
+	m[MsgNoOp{}.Type()] = NewNoOpHandler(keeper, versionedTxOutStore, versionedEventManager)
 	return m
 }
 
@@ -189,12 +197,12 @@ func fetchMemo(ctx sdk.Context, constAccessor constants.ConstantValues, keeper K
 	return memo
 }
 
-func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, sdk.Error) {
+func processOneTxIn(ctx sdk.Context, version semver.Version, keeper Keeper, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, sdk.Error) {
 	if len(tx.Tx.Coins) == 0 {
 		return nil, sdk.ErrUnknownRequest("no coin found")
 	}
 
-	memo, err := ParseMemo(tx.Tx.Memo)
+	memo, err := ParseMemoWithVersion(version, tx.Tx.Memo)
 	if err != nil {
 		ctx.Logger().Error("fail to parse memo", "error", err)
 		return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, err.Error())
@@ -202,9 +210,9 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 	// THORNode should not have one tx across chain, if it is cross chain it should be separate tx
 	var newMsg sdk.Msg
 	// interpret the memo and initialize a corresponding msg event
-	switch m := memo.(type) {
+	switch m := unwrapMemo(memo).(type) {
 	case StakeMemo:
-		newMsg, err = getMsgStakeFromMemo(ctx, m, tx, signer)
+		newMsg, err = getMsgStakeFromMemo(ctx, version, m, tx, signer)
 		if err != nil {
 			return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, "invalid stake memo:%s", err.Error())
 		}
@@ -255,7 +263,9 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 			return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, "invalid ragnarok memo: %s", err.Error())
 		}
 	case LeaveMemo:
-		newMsg = NewMsgLeave(tx.Tx, signer)
+		msg := NewMsgLeave(tx.Tx, signer)
+		msg.NodeAddress = m.GetNodeAddress()
+		newMsg = msg
 	case YggdrasilFundMemo:
 		newMsg = NewMsgYggdrasil(tx.Tx, tx.ObservedPubKey, m.GetBlockHeight(), true, tx.Tx.Coins, signer)
 	case YggdrasilReturnMemo:
@@ -265,6 +275,8 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 		newMsg = NewMsgReserveContributor(tx.Tx, res, signer)
 	case SwitchMemo:
 		newMsg = NewMsgSwitch(tx.Tx, memo.GetDestination(), signer)
+	case NoOpMemo:
+		newMsg = NewMsgNoOp(tx, signer)
 
 	default:
 		return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, "invalid memo")
@@ -299,18 +311,21 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer sdk.AccAddress) (sd
 	}
 
 	// Looks like at the moment THORNode can only process ont ty
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, signer), nil
+	msg := NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, signer)
+	msg.AffiliateAddress = memo.AffiliateAddress
+	msg.AffiliateBps = memo.AffiliateBps
+	return msg, nil
 }
 
 func getMsgUnstakeFromMemo(memo UnstakeMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
-	withdrawAmount := sdk.NewUint(MaxUnstakeBasisPoints)
-	if len(memo.GetAmount()) > 0 {
-		withdrawAmount = sdk.NewUintFromString(memo.GetAmount())
+	withdrawAmount, err := memo.BasisPoints()
+	if err != nil {
+		return nil, err
 	}
 	return NewMsgSetUnStake(tx.Tx, tx.Tx.FromAddress, withdrawAmount, memo.GetAsset(), signer), nil
 }
 
-func getMsgStakeFromMemo(ctx sdk.Context, memo StakeMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
+func getMsgStakeFromMemo(ctx sdk.Context, version semver.Version, memo StakeMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
 	// when staker stake to a pool ,usually it will be two coins, RUNE and the asset of the pool.
 	// if it is multi-chain , like NOT Binance chain , it is using two asymmetric staking
 	if len(tx.Tx.Coins) > 2 {
@@ -348,16 +363,23 @@ func getMsgStakeFromMemo(ctx sdk.Context, memo StakeMemo, tx ObservedTx, signer
 	}
 
 	runeAddr := tx.Tx.FromAddress
-	assetAddr := memo.GetDestination()
+	var assetAddr common.Address
 	// this is to cover multi-chain scenario, for example BTC , staker who would like to stake in BTC pool,  will have to complete
 	// the stake operation by sending in two asymmetric stake tx, one tx on BTC chain with memo stake:BTC:<RUNE address> ,
-	// and another one on Binance chain with stake:BTC , with only RUNE as the coin
+	// and another one on Binance chain with stake:BTC:<BTC address> , with only RUNE as the coin
 	// Thorchain will use the <RUNE address> to match these two together , and consider it as one stake.
+	// Before version 0.3.0 the BTC address could be left out of the memo, and the RUNE address was used as the asset address
 	if !runeAddr.IsChain(common.RuneAsset().Chain) {
 		runeAddr = memo.GetDestination()
 		assetAddr = tx.Tx.FromAddress
+	} else if version.GTE(semver.MustParse("0.3.0")) {
+		var err error
+		assetAddr, err = memo.TargetAddress(runeAddr, common.RuneAsset().Chain)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get the asset address: %w", err)
+		}
 	} else {
-		// if it is on BNB chain , while the asset addr is empty, then the asset addr is runeAddr
+		assetAddr = memo.GetDestination()
 		if assetAddr.IsEmpty() {
 			assetAddr = runeAddr
 		}
@@ -26,12 +26,12 @@ func NewCommonOutboundTxHandler(k Keeper, versionedEventManager VersionedEventMa
 
 func (h CommonOutboundTxHandler) slash(ctx sdk.Context, version semver.Version, tx ObservedTx) error {
 	var returnErr error
-	slasher, err := NewSlasher(h.keeper, version, h.versionedEventManager)
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
 	if err != nil {
-		return fmt.Errorf("fail to create new slasher,error:%w", err)
+		return fmt.Errorf("fail to get event manager: %w", err)
 	}
 	for _, c := range tx.Tx.Coins {
-		if err := slasher.SlashNodeAccount(ctx, tx.ObservedPubKey, c.Asset, c.Amount); err != nil {
+		if err := h.keeper.SlashNodeAccount(ctx, tx.ObservedPubKey, c.Asset, c.Amount, eventMgr); err != nil {
 			ctx.Logger().Error("fail to slash account", "error", err)
 			returnErr = err
 		}
@@ -119,7 +119,21 @@ func (h ErrataTxHandler) handleV1(ctx sdk.Context, msg MsgErrataTx, version semv
 		}
 	}
 
-	memo, _ := ParseMemo(tx.Memo)
+	// only apply errata when the events related to the inbound tx are still pending
+	events, err := h.keeper.GetEventsByInTxID(ctx, msg.TxID)
+	if err != nil {
+		ctx.Logger().Error("fail to get events for errata tx", "error", err)
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	if len(events.FilterByStatus(EventPending)) != len(events) {
+		ctx.Logger().Info("errata tx has events that are not pending, ignore", "txid", msg.TxID.String())
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+
+	memo, _ := ParseMemoWithVersion(version, tx.Memo)
 	if !memo.IsType(TxSwap) && !memo.IsType(TxStake) {
 		// must be a swap transaction
 		return sdk.Result{
@@ -173,7 +187,11 @@ func (h ErrataTxHandler) handleV1(ctx sdk.Context, msg MsgErrataTx, version semv
 		NewPoolMod(pool.Asset, runeAmt, false, assetAmt, false),
 	}
 
-	eventErrata := NewEventErrata(msg.TxID, mods)
+	eventErrata, err := NewEventErrata(msg.TxID, mods)
+	if err != nil {
+		ctx.Logger().Error("fail to create errata event", "error", err)
+		return sdk.ErrInternal(err.Error()).Result()
+	}
 	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
 	if err != nil {
 		return errFailGetEventManager.Result()
@@ -18,6 +18,7 @@ type HandlerErrataTxSuite struct{}
 type TestErrataTxKeeper struct {
 	KVStoreDummy
 	event      Event
+	events     Events
 	observedTx ObservedTxVoter
 	pool       Pool
 	na         NodeAccount
@@ -38,6 +39,10 @@ func (k *TestErrataTxKeeper) UpsertEvent(_ sdk.Context, evt Event) error {
 	return nil
 }
 
+func (k *TestErrataTxKeeper) GetEventsByInTxID(_ sdk.Context, _ common.TxID) (Events, error) {
+	return k.events, k.err
+}
+
 func (k *TestErrataTxKeeper) GetObservedTxVoter(_ sdk.Context, txID common.TxID) (ObservedTxVoter, error) {
 	return k.observedTx, k.err
 }
@@ -161,3 +166,53 @@ func (s *HandlerErrataTxSuite) TestHandle(c *C) {
 	c.Check(evt.Pools[0].AssetAmt.IsZero(), Equals, true)
 	c.Check(evt.Pools[0].AssetAdd, Equals, false)
 }
+
+func (s *HandlerErrataTxSuite) TestHandleWithCompletedEvents(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	ver := constants.SWVersion
+
+	txID := GetRandomTxHash()
+	na := GetRandomNodeAccount(NodeActive)
+	tx := common.Tx{
+		ID:          txID,
+		Chain:       common.BNBChain,
+		FromAddress: GetRandomBNBAddress(),
+		Coins: common.Coins{
+			common.NewCoin(common.RuneAsset(), sdk.NewUint(30*common.One)),
+		},
+		Memo: "SWAP:BNB.BNB",
+	}
+	keeper := &TestErrataTxKeeper{
+		na: na,
+		events: Events{
+			NewEvent("swap", 10, tx, nil, EventPending),
+			NewEvent("swap", 10, tx, nil, EventSuccess),
+		},
+		observedTx: ObservedTxVoter{
+			Tx: ObservedTx{
+				Tx: tx,
+			},
+		},
+		pool: Pool{
+			Asset:        common.BNBAsset,
+			PoolUnits:    sdk.NewUint(1600),
+			BalanceRune:  sdk.NewUint(100 * common.One),
+			BalanceAsset: sdk.NewUint(100 * common.One),
+		},
+	}
+	handler := NewErrataTxHandler(keeper, NewVersionedEventMgr())
+	msg := NewMsgErrataTx(txID, common.BNBChain, na.NodeAddress)
+	result := handler.handle(ctx, msg, ver)
+	c.Assert(result.IsOK(), Equals, true)
+	// tx already has completed events, so errata should not be applied
+	c.Check(keeper.pool.BalanceRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(keeper.pool.BalanceAsset.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(keeper.event.Empty(), Equals, true)
+
+	// only pending events, errata should be applied
+	keeper.events = keeper.events.FilterByStatus(EventPending)
+	result = handler.handle(ctx, msg, ver)
+	c.Assert(result.IsOK(), Equals, true)
+	c.Check(keeper.pool.BalanceRune.Equal(sdk.NewUint(70*common.One)), Equals, true)
+	c.Check(keeper.event.Type, Equals, "errata")
+}
@@ -74,13 +74,26 @@ func (h LeaveHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, _
 }
 
 func (h LeaveHandler) handle(ctx sdk.Context, msg MsgLeave, version semver.Version) sdk.Error {
-	nodeAcc, err := h.keeper.GetNodeAccountByBondAddress(ctx, msg.Tx.FromAddress)
-	if err != nil {
-		return sdk.ErrInternal(fmt.Errorf("fail to get node account by bond address: %w", err).Error())
+	var nodeAcc NodeAccount
+	var err error
+	if !msg.NodeAddress.Empty() {
+		nodeAcc, err = h.keeper.GetNodeAccount(ctx, msg.NodeAddress)
+		if err != nil {
+			return sdk.ErrInternal(fmt.Errorf("fail to get node account(%s): %w", msg.NodeAddress, err).Error())
+		}
+	} else {
+		nodeAcc, err = h.keeper.GetNodeAccountByBondAddress(ctx, msg.Tx.FromAddress)
+		if err != nil {
+			return sdk.ErrInternal(fmt.Errorf("fail to get node account by bond address: %w", err).Error())
+		}
 	}
 	if nodeAcc.IsEmpty() {
 		return sdk.ErrUnknownRequest("node account doesn't exist")
 	}
+	// only the bond address of a node is allowed to ask it to leave
+	if !nodeAcc.BondAddress.Equals(msg.Tx.FromAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not authorized to manage %s", msg.Tx.FromAddress, nodeAcc.NodeAddress))
+	}
 	// THORNode add the node to leave queue
 
 	coin := msg.Tx.Coins.GetCoin(common.RuneAsset())
@@ -71,6 +71,43 @@ func (HandlerLeaveSuite) TestLeaveHandler_ActiveNodeLeave(c *C) {
 	c.Check(acc2.Bond.Equal(sdk.NewUint(10000000001)), Equals, true, Commentf("Bond:%d\n", acc2.Bond.Uint64()))
 }
 
+func (HandlerLeaveSuite) TestLeaveHandler_ExplicitNodeAddress(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, false)
+	leaveHandler := NewLeaveHandler(w.keeper, w.validatorMgr, w.versionedTxOutStore, NewVersionedEventMgr())
+	acc2 := GetRandomNodeAccount(NodeActive)
+	acc2.Bond = sdk.NewUint(100 * common.One)
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, acc2), IsNil)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	// sender is not the bond address of the node
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBNBAddress(),
+		GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), sdk.OneUint())},
+		BNBGasFeeSingleton,
+		"LEAVE:"+acc2.NodeAddress.String(),
+	)
+	msgLeave := NewMsgLeave(tx, w.activeNodeAccount.NodeAddress)
+	msgLeave.NodeAddress = acc2.NodeAddress
+	result := leaveHandler.Run(w.ctx, msgLeave, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// node doesn't exist
+	msgLeave.Tx.FromAddress = acc2.BondAddress
+	msgLeave.NodeAddress = GetRandomBech32Addr()
+	result = leaveHandler.Run(w.ctx, msgLeave, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+
+	msgLeave.NodeAddress = acc2.NodeAddress
+	result = leaveHandler.Run(w.ctx, msgLeave, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%+v", result))
+	acc2, err := w.keeper.GetNodeAccount(w.ctx, acc2.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(acc2.LeaveHeight, Equals, w.ctx.BlockHeight())
+}
+
 func (HandlerLeaveSuite) TestLeaveValidation(c *C) {
 	w := getHandlerTestWrapper(c, 1, true, false)
 	ver := constants.SWVersion
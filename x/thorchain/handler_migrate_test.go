@@ -78,6 +78,7 @@ type TestMigrateKeeperHappyPath struct {
 	retireVault       Vault
 	txout             *TxOut
 	pool              Pool
+	slashed           common.Coins
 }
 
 func (k *TestMigrateKeeperHappyPath) GetTxOut(ctx sdk.Context, blockHeight int64) (*TxOut, error) {
@@ -117,6 +118,11 @@ func (k *TestMigrateKeeperHappyPath) UpsertEvent(_ sdk.Context, _ Event) error {
 	return nil
 }
 
+func (k *TestMigrateKeeperHappyPath) SlashNodeAccount(_ sdk.Context, _ common.PubKey, asset common.Asset, amount sdk.Uint, _ EventManager) error {
+	k.slashed = append(k.slashed, common.NewCoin(asset, amount))
+	return nil
+}
+
 func (HandlerMigrateSuite) TestMigrateHappyPath(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	retireVault := GetRandomVault()
@@ -200,5 +206,5 @@ func (HandlerMigrateSuite) TestSlash(c *C) {
 	msgMigrate := NewMsgMigrate(tx, 1, keeper.activeNodeAccount.NodeAddress)
 	result := handler.handleV1(ctx, constants.SWVersion, msgMigrate)
 	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
-	c.Assert(keeper.activeNodeAccount.Bond.Equal(sdk.NewUint(9999998464)), Equals, true, Commentf("%d", keeper.activeNodeAccount.Bond.Uint64()))
+	c.Assert(keeper.slashed.Equals(tx.Tx.Coins), Equals, true, Commentf("%s", keeper.slashed))
 }
@@ -53,20 +53,26 @@ func (h NativeTxHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version,
 
 func (h NativeTxHandler) validate(ctx sdk.Context, msg MsgNativeTx, version semver.Version) error {
 	if version.GTE(semver.MustParse("0.1.0")) {
-		return h.validateV1(ctx, msg)
+		return h.validateV1(ctx, msg, version)
 	} else {
 		ctx.Logger().Error(errInvalidVersion.Error())
 		return errInvalidVersion
 	}
 }
 
-func (h NativeTxHandler) validateV1(ctx sdk.Context, msg MsgNativeTx) error {
+func (h NativeTxHandler) validateV1(ctx sdk.Context, msg MsgNativeTx, version semver.Version) error {
 	if err := msg.ValidateBasic(); err != nil {
 		ctx.Logger().Error(err.Error())
 		return err
 	}
 
-	memo, _ := ParseMemo(msg.Memo) // ignore err
+	memo, err := ParseMemoWithVersion(version, msg.Memo)
+	if memo.IsEmpty() {
+		if len(msg.Memo) == 0 {
+			return sdk.ErrUnknownRequest("missing memo")
+		}
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid memo: %s", err))
+	}
 	if !memo.IsInbound() {
 		// no one should send an outbound tx to vault
 		return errors.New("transaction is not an inbound transaction")
@@ -158,7 +164,7 @@ func (h NativeTxHandler) handleV1(ctx sdk.Context, msg MsgNativeTx, version semv
 	}
 	// construct msg from memo
 	txIn := ObservedTx{Tx: tx}
-	m, txErr := processOneTxIn(ctx, h.keeper, txIn, msg.Signer)
+	m, txErr := processOneTxIn(ctx, version, h.keeper, txIn, msg.Signer)
 	if txErr != nil {
 		ctx.Logger().Error("fail to process native inbound tx", "error", txErr.Error(), "tx hash", tx.ID.String())
 		if newErr := refundTx(ctx, txIn, txOutStore, h.keeper, constAccessor, txErr.Code(), fmt.Sprint(txErr.Data()), eventMgr); nil != newErr {
@@ -39,6 +39,18 @@ func (s *HandlerNativeTxSuite) TestValidate(c *C) {
 	err = handler.validate(ctx, msg, semver.Version{})
 	c.Assert(err, Equals, errInvalidVersion)
 
+	// missing memo
+	msg = NewMsgNativeTx(coins, "", addr)
+	err = handler.validate(ctx, msg, constants.SWVersion)
+	c.Assert(err, NotNil)
+	c.Check(err.Error(), Equals, sdk.ErrUnknownRequest("missing memo").Error())
+
+	// invalid memo
+	msg = NewMsgNativeTx(coins, "whatever", addr)
+	err = handler.validate(ctx, msg, constants.SWVersion)
+	c.Assert(err, NotNil)
+	c.Check(err.Error(), Equals, sdk.ErrUnknownRequest("invalid memo: invalid tx type: whatever").Error())
+
 	// invalid msg
 	msg = MsgNativeTx{}
 	err = handler.validate(ctx, msg, constants.SWVersion)
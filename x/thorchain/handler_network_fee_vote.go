@@ -0,0 +1,190 @@
+package thorchain
+
+import (
+	"sort"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// NetworkFeeVoteHandler is to handle MsgNetworkFeeVote message, the network fee agreed by the active validators is used
+// as the max gas of the outbound txs of the chain
+type NetworkFeeVoteHandler struct {
+	keeper Keeper
+}
+
+// NewNetworkFeeVoteHandler create new instance of NetworkFeeVoteHandler
+func NewNetworkFeeVoteHandler(keeper Keeper) NetworkFeeVoteHandler {
+	return NetworkFeeVoteHandler{
+		keeper: keeper,
+	}
+}
+
+// Run it the main entry point to execute MsgNetworkFeeVote logic
+func (h NetworkFeeVoteHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgNetworkFeeVote)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg network fee vote failed validation", "error", err)
+		return err.Result()
+	}
+	return h.handle(ctx, msg, version, constAccessor)
+}
+
+func (h NetworkFeeVoteHandler) validate(ctx sdk.Context, msg MsgNetworkFeeVote, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.3.0")) {
+		return h.validateV1(ctx, msg)
+	} else {
+		return errBadVersion
+	}
+}
+
+func (h NetworkFeeVoteHandler) validateV1(ctx sdk.Context, msg MsgNetworkFeeVote) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return sdk.ErrUnauthorized(notAuthorized.Error())
+	}
+
+	return nil
+}
+
+func (h NetworkFeeVoteHandler) handle(ctx sdk.Context, msg MsgNetworkFeeVote, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("handleMsgNetworkFeeVote request", "chain", msg.Chain.String(), "block height", msg.BlockHeight, "fee", msg.Fee.String())
+	if version.GTE(semver.MustParse("0.3.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	} else {
+		ctx.Logger().Error(errInvalidVersion.Error())
+		return errBadVersion.Result()
+	}
+}
+
+func (h NetworkFeeVoteHandler) handleV1(ctx sdk.Context, msg MsgNetworkFeeVote, constAccessor constants.ConstantValues) sdk.Result {
+	active, err := h.keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get list of active node accounts")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	// a node only get one vote on the network fee of a chain at a block height
+	voters, err := h.keeper.GetNetworkFeeVoters(ctx, msg.Chain, msg.BlockHeight)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	for _, v := range voters {
+		if !v.Fee.Equal(msg.Fee) && v.HasSigned(msg.Signer) {
+			return sdk.ErrUnknownRequest("already voted a different network fee").Result()
+		}
+	}
+
+	voter, err := h.keeper.GetNetworkFeeVoter(ctx, msg.Chain, msg.BlockHeight, msg.Fee)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	voter.Sign(msg.Signer)
+	if err := h.keeper.SetNetworkFeeVoter(ctx, voter); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	// doesn't have consensus yet
+	if !voter.HasConsensus(active) {
+		ctx.Logger().Info("not having consensus yet, return")
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+
+	if voter.ConsensusBlockHeight > 0 {
+		// network fee already applied
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+
+	voter.ConsensusBlockHeight = ctx.BlockHeight()
+	if err := h.keeper.SetNetworkFeeVoter(ctx, voter); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	if err := h.keeper.SaveNetworkFee(ctx, msg.Chain, msg.Fee); err != nil {
+		err = wrapError(ctx, err, "fail to save network fee")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	if err := h.slashBadVoters(ctx, msg.Chain, msg.BlockHeight, constAccessor); err != nil {
+		err = wrapError(ctx, err, "fail to slash bad network fee voters")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	// the votes on older network fees of the chain are no longer needed
+	if err := h.keeper.PruneNetworkFeeVoters(ctx, msg.Chain, msg.BlockHeight); err != nil {
+		err = wrapError(ctx, err, "fail to prune network fee voters")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
+
+// slashBadVoters add slash points to the nodes that voted a network fee which is more than 20% away from the median of
+// all the votes on the network fee of the chain at the given block height
+func (h NetworkFeeVoteHandler) slashBadVoters(ctx sdk.Context, chain common.Chain, height int64, constAccessor constants.ConstantValues) error {
+	voters, err := h.keeper.GetNetworkFeeVoters(ctx, chain, height)
+	if err != nil {
+		return err
+	}
+	var fees []sdk.Uint
+	for _, voter := range voters {
+		for range voter.Signers {
+			fees = append(fees, voter.Fee)
+		}
+	}
+	median := getMedianFee(fees)
+	if median.IsZero() {
+		return nil
+	}
+	slashPoints := constAccessor.GetInt64Value(constants.BadNetworkFeeVoteSlashPoints)
+	lower := median.MulUint64(80)
+	upper := median.MulUint64(120)
+	for _, voter := range voters {
+		fee := voter.Fee.MulUint64(100)
+		if fee.GTE(lower) && fee.LTE(upper) {
+			continue
+		}
+		for _, signer := range voter.Signers {
+			ctx.Logger().Info("slash node for bad network fee vote", "node address", signer.String(), "fee", voter.Fee.String(), "median", median.String())
+			if err := h.keeper.IncNodeAccountSlashPoints(ctx, signer, slashPoints); err != nil {
+				ctx.Logger().Error("fail to inc slash points", "error", err)
+			}
+			if err := h.keeper.RecordSlash(ctx, signer, "bad network fee vote", slashPoints); err != nil {
+				ctx.Logger().Error("fail to record slash", "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// getMedianFee return the median of the given fees, zero when there is no fee
+func getMedianFee(fees []sdk.Uint) sdk.Uint {
+	if len(fees) == 0 {
+		return sdk.ZeroUint()
+	}
+	sorted := make([]sdk.Uint, len(fees))
+	copy(sorted, fees)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).QuoUint64(2)
+}
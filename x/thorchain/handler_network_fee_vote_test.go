@@ -0,0 +1,125 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+var _ = Suite(&HandlerNetworkFeeVoteSuite{})
+
+type HandlerNetworkFeeVoteSuite struct{}
+
+func (s *HandlerNetworkFeeVoteSuite) TestValidate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	handler := NewNetworkFeeVoteHandler(k)
+	// happy path
+	ver := constants.SWVersion
+	msg := NewMsgNetworkFeeVote(1024, common.BNBChain, sdk.NewUint(37500), na.NodeAddress)
+	err := handler.validate(ctx, msg, ver)
+	c.Assert(err, IsNil)
+
+	// invalid version
+	err = handler.validate(ctx, msg, semver.Version{})
+	c.Assert(err, Equals, errBadVersion)
+	// network fee votes are not accepted before version 0.3.0
+	err = handler.validate(ctx, msg, semver.MustParse("0.2.0"))
+	c.Assert(err, Equals, errBadVersion)
+
+	// not signed by an active node account
+	msg = NewMsgNetworkFeeVote(1024, common.BNBChain, sdk.NewUint(37500), GetRandomBech32Addr())
+	err = handler.validate(ctx, msg, ver)
+	c.Assert(err, NotNil)
+
+	// invalid msg
+	msg = MsgNetworkFeeVote{}
+	err = handler.validate(ctx, msg, ver)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerNetworkFeeVoteSuite) TestHandle(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	nas := NodeAccounts{
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+	}
+	for _, na := range nas {
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	handler := NewNetworkFeeVoteHandler(k)
+	fee := sdk.NewUint(37500)
+
+	// a vote far away from what other nodes see
+	result := handler.Run(ctx, NewMsgNetworkFeeVote(1024, common.BNBChain, sdk.NewUint(100000), nas[3].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+
+	for i := 0; i < 2; i++ {
+		result = handler.Run(ctx, NewMsgNetworkFeeVote(1024, common.BNBChain, fee, nas[i].NodeAddress), ver, constAccessor)
+		c.Assert(result.Code, Equals, sdk.CodeOK)
+	}
+	// doesn't have consensus yet
+	networkFee, err := k.GetNetworkFee(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(networkFee.IsZero(), Equals, true)
+
+	// reach consensus
+	result = handler.Run(ctx, NewMsgNetworkFeeVote(1024, common.BNBChain, fee, nas[2].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(networkFee.Equal(fee), Equals, true)
+	voter, err := k.GetNetworkFeeVoter(ctx, common.BNBChain, 1024, fee)
+	c.Assert(err, IsNil)
+	c.Check(voter.ConsensusBlockHeight, Equals, ctx.BlockHeight())
+
+	// node voted the bad fee get slashed
+	slashPts, err := k.GetNodeAccountSlashPoints(ctx, nas[3].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(slashPts, Equals, constAccessor.GetInt64Value(constants.BadNetworkFeeVoteSlashPoints))
+	for i := 0; i < 3; i++ {
+		slashPts, err = k.GetNodeAccountSlashPoints(ctx, nas[i].NodeAddress)
+		c.Assert(err, IsNil)
+		c.Check(slashPts, Equals, int64(0))
+	}
+
+	// a node can't vote a different fee at the same height
+	result = handler.Run(ctx, NewMsgNetworkFeeVote(1024, common.BNBChain, fee, nas[3].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+	// voting the same fee again is fine
+	result = handler.Run(ctx, NewMsgNetworkFeeVote(1024, common.BNBChain, fee, nas[0].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+
+	// consensus at a later height prune the older votes
+	newFee := sdk.NewUint(40000)
+	for i := 0; i < 3; i++ {
+		result = handler.Run(ctx, NewMsgNetworkFeeVote(2048, common.BNBChain, newFee, nas[i].NodeAddress), ver, constAccessor)
+		c.Assert(result.Code, Equals, sdk.CodeOK)
+	}
+	networkFee, err = k.GetNetworkFee(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(networkFee.Equal(newFee), Equals, true)
+	voters, err := k.GetNetworkFeeVoters(ctx, common.BNBChain, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voters, HasLen, 0)
+	voters, err = k.GetNetworkFeeVoters(ctx, common.BNBChain, 2048)
+	c.Assert(err, IsNil)
+	c.Check(voters, HasLen, 1)
+}
+
+func (s *HandlerNetworkFeeVoteSuite) TestGetMedianFee(c *C) {
+	c.Check(getMedianFee(nil).IsZero(), Equals, true)
+	c.Check(getMedianFee([]sdk.Uint{sdk.NewUint(3), sdk.NewUint(1), sdk.NewUint(2)}).Equal(sdk.NewUint(2)), Equals, true)
+	c.Check(getMedianFee([]sdk.Uint{sdk.NewUint(4), sdk.NewUint(1), sdk.NewUint(2), sdk.NewUint(3)}).Equal(sdk.NewUint(2)), Equals, true)
+}
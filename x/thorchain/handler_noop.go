@@ -0,0 +1,111 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// NoOpHandler is to handle MsgNoOp, a tx that should be accepted without doing anything
+type NoOpHandler struct {
+	keeper                Keeper
+	versionedTxOutStore   VersionedTxOutStore
+	versionedEventManager VersionedEventManager
+}
+
+// NewNoOpHandler create a new instance of NoOpHandler
+func NewNoOpHandler(keeper Keeper, versionedTxOutStore VersionedTxOutStore, versionedEventManager VersionedEventManager) NoOpHandler {
+	return NoOpHandler{
+		keeper:                keeper,
+		versionedTxOutStore:   versionedTxOutStore,
+		versionedEventManager: versionedEventManager,
+	}
+}
+
+// Run is the main entry point of NoOpHandler
+func (h NoOpHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgNoOp)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg noop failed validation", "error", err)
+		return err.Result()
+	}
+	return h.handle(ctx, msg, version, constAccessor)
+}
+
+func (h NoOpHandler) validate(ctx sdk.Context, msg MsgNoOp, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg, version)
+	}
+	return errBadVersion
+}
+
+func (h NoOpHandler) validateV1(ctx sdk.Context, msg MsgNoOp, version semver.Version) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	memo, ok := parseNoOpMemo(msg)
+	if !ok {
+		// tx with a gas memo used to be refunded, keep doing so until the network runs a version accepting them
+		if version.LT(semver.MustParse("0.3.0")) {
+			return sdk.NewError(DefaultCodespace, CodeInvalidMemo, "gas memo is not accepted before version 0.3.0")
+		}
+		return nil
+	}
+	if err := memo.Validate(); err != nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidMemo, err.Error())
+	}
+	return nil
+}
+
+// parseNoOpMemo return the noop memo of the tx in the given msg, MsgNoOp is also used for tx with a gas memo
+func parseNoOpMemo(msg MsgNoOp) (NoOpMemo, bool) {
+	memo, err := ParseMemo(msg.ObservedTx.Tx.Memo)
+	if err != nil {
+		return NoOpMemo{}, false
+	}
+	m, ok := memo.(NoOpMemo)
+	return m, ok
+}
+
+func (h NoOpHandler) handle(ctx sdk.Context, msg MsgNoOp, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("receive MsgNoOp", "tx id", msg.ObservedTx.Tx.ID.String())
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, version, constAccessor)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errBadVersion.Result()
+}
+
+// handleV1 record the note of a noop memo and refund the coins the tx carries, minus the fee, tx with a gas memo keep
+// their coins
+func (h NoOpHandler) handleV1(ctx sdk.Context, msg MsgNoOp, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("noop, nothing to do", "tx id", msg.ObservedTx.Tx.ID.String(), "memo", msg.ObservedTx.Tx.Memo)
+	if memo, ok := parseNoOpMemo(msg); ok {
+		eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+		if err != nil {
+			ctx.Logger().Error("fail to get event manager", "error", err)
+			return errFailGetEventManager.Result()
+		}
+		if err := eventMgr.EmitNoOpEvent(ctx, h.keeper, NewEventNoOp(memo.Note, msg.ObservedTx.Tx)); err != nil {
+			ctx.Logger().Error("fail to emit noop event", "error", err)
+			return sdk.ErrInternal(err.Error()).Result()
+		}
+		txOutStore, err := h.versionedTxOutStore.GetTxOutStore(ctx, h.keeper, version)
+		if err != nil {
+			ctx.Logger().Error("fail to get txout store", "error", err)
+			return errBadVersion.Result()
+		}
+		if err := refundTx(ctx, msg.ObservedTx, txOutStore, h.keeper, constAccessor, sdk.CodeOK, "noop memo", eventMgr); err != nil {
+			ctx.Logger().Error("fail to refund noop tx", "error", err)
+			return sdk.ErrInternal(err.Error()).Result()
+		}
+	}
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
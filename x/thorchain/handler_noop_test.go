@@ -0,0 +1,109 @@
+package thorchain
+
+import (
+	"strings"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerNoOpSuite struct{}
+
+var _ = Suite(&HandlerNoOpSuite{})
+
+func (s *HandlerNoOpSuite) TestValidate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	handler := NewNoOpHandler(k, NewVersionedTxOutStoreDummy(), NewDummyVersionedEventMgr())
+	ver := constants.SWVersion
+
+	// happy path
+	msg := NewMsgNoOp(GetRandomObservedTx(), GetRandomBech32Addr())
+	msg.ObservedTx.Tx.Memo = "noop"
+	c.Assert(handler.validate(ctx, msg, ver), IsNil)
+
+	// gas memo is only accepted from 0.3.0
+	gasMsg := NewMsgNoOp(GetRandomObservedTx(), GetRandomBech32Addr())
+	c.Assert(handler.validate(ctx, gasMsg, semver.MustParse("0.2.0")), NotNil)
+	c.Assert(handler.validate(ctx, gasMsg, ver), IsNil)
+
+	// invalid version
+	c.Assert(handler.validate(ctx, msg, semver.Version{}), Equals, errBadVersion)
+
+	// invalid msg
+	c.Assert(handler.validate(ctx, MsgNoOp{}, ver), NotNil)
+
+	// note is too long
+	tx := GetRandomObservedTx()
+	tx.Tx.Memo = "noop:" + strings.Repeat("a", MaxNoOpNoteLength+1)
+	c.Assert(handler.validate(ctx, NewMsgNoOp(tx, GetRandomBech32Addr()), ver), NotNil)
+}
+
+func (s *HandlerNoOpSuite) TestRun(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	handler := NewNoOpHandler(k, NewVersionedTxOutStoreDummy(), NewDummyVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	msg := NewMsgNoOp(GetRandomObservedTx(), GetRandomBech32Addr())
+	msg.ObservedTx.Tx.Memo = "noop"
+	result := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+
+	result = handler.Run(ctx, msg, semver.Version{}, constAccessor)
+	c.Assert(result.Code, Equals, CodeBadVersion)
+
+	result = handler.Run(ctx, MsgNoOp{}, ver, constAccessor)
+	c.Assert(result.IsOK(), Equals, false)
+
+	result = handler.Run(ctx, NewMsgMimir("whatever", 1, GetRandomBech32Addr()), ver, constAccessor)
+	c.Assert(result.Code, Equals, CodeInvalidMessage)
+}
+
+func (s *HandlerNoOpSuite) TestNoOpMemoEvent(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	versionedTxOutStore := NewVersionedTxOutStoreDummy()
+	handler := NewNoOpHandler(k, versionedTxOutStore, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	tx := GetRandomObservedTx()
+	tx.Tx.Memo = "noop:gm\tfrens"
+	result := handler.Run(ctx, NewMsgNoOp(tx, GetRandomBech32Addr()), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	// the coins are refunded
+	items, err := versionedTxOutStore.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress.Equals(tx.Tx.FromAddress), Equals, true)
+	c.Check(items[0].Coin.Equals(tx.Tx.Coins[0]), Equals, true)
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type != (EventNoOp{}).Type() {
+			continue
+		}
+		found = true
+		c.Check(string(evt.Attributes[0].Value), Equals, "gmfrens")
+	}
+	c.Check(found, Equals, true)
+
+	// no event for the noop msg of a gas memo
+	ctx, k = setupKeeperForTest(c)
+	versionedTxOutStore = NewVersionedTxOutStoreDummy()
+	handler = NewNoOpHandler(k, versionedTxOutStore, NewVersionedEventMgr())
+	result = handler.Run(ctx, NewMsgNoOp(GetRandomObservedTx(), GetRandomBech32Addr()), semver.MustParse("0.3.0"), constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	c.Check(ctx.EventManager().Events(), HasLen, 0)
+	items, err = versionedTxOutStore.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+}
@@ -73,8 +73,8 @@ func (h ObservedTxInHandler) validateV1(ctx sdk.Context, msg MsgObservedTxIn) (b
 		return false, err
 	}
 
-	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
-		ctx.Logger().Error(notAuthorized.Error())
+	if err := msg.ValidateSigners(ctx, h.keeper); err != nil {
+		ctx.Logger().Error(notAuthorized.Error(), "error", err)
 		return false, notAuthorized
 	}
 
@@ -92,14 +92,17 @@ func (h ObservedTxInHandler) handle(ctx sdk.Context, msg MsgObservedTxIn, versio
 
 func (h ObservedTxInHandler) preflight(ctx sdk.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer sdk.AccAddress) (ObservedTxVoter, bool) {
 	voter.Add(tx, signer)
+	if err := h.keeper.AddObservation(ctx, tx, signer); err != nil {
+		ctx.Logger().Error("fail to add observation to history", "error", err)
+	}
 
 	ok := false
-	if voter.HasConsensus(nas) && !voter.ProcessedIn {
+	if consensusTx, hasConsensus := getObservedTxConsensus(ctx, h.keeper, &voter, nas); hasConsensus && !voter.ProcessedIn {
 		ok = true
 		voter.Height = ctx.BlockHeight()
 		voter.ProcessedIn = true
 		// this is the tx that has consensus
-		voter.Tx = voter.GetTx(nas)
+		voter.Tx = consensusTx
 	}
 	h.keeper.SetObservedTxVoter(ctx, voter)
 
@@ -179,7 +182,7 @@ func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, m
 
 		vault.AddFunds(tx.Tx.Coins)
 		vault.InboundTxCount += 1
-		memo, _ := ParseMemo(tx.Tx.Memo) // ignore err
+		memo, _ := ParseMemoWithVersion(version, tx.Tx.Memo) // ignore err
 		if vault.IsYggdrasil() && memo.IsType(TxYggdrasilFund) {
 			vault.RemovePendingTxBlockHeights(memo.GetBlockHeight())
 		}
@@ -214,9 +217,17 @@ func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, m
 			}
 			continue
 		}
+		// coins are validated per tx, so one bad tx doesn't fail the other txs observed in the same msg
+		if err := tx.Tx.Coins.Validate(); err != nil {
+			ctx.Logger().Error("invalid coins in inbound tx", "error", err, "tx hash", tx.Tx.ID.String())
+			if err := refundTx(ctx, tx, txOutStore, h.keeper, constAccessor, sdk.CodeInvalidCoins, err.Error(), eventMgr); err != nil {
+				return sdk.ErrInternal(err.Error()).Result()
+			}
+			continue
+		}
 
 		// construct msg from memo
-		m, txErr := processOneTxIn(ctx, h.keeper, txIn, msg.Signer)
+		m, txErr := processOneTxIn(ctx, version, h.keeper, txIn, msg.Signer)
 		if txErr != nil {
 			ctx.Logger().Error("fail to process inbound tx", "error", txErr.Error(), "tx hash", tx.Tx.ID.String())
 			if newErr := refundTx(ctx, tx, txOutStore, h.keeper, constAccessor, txErr.Code(), fmt.Sprint(txErr.Data()), eventMgr); nil != newErr {
@@ -252,6 +263,15 @@ func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, m
 			}
 		}
 
+		// check if the tx has expired, memo expiry is only honoured from version 0.3.0
+		if version.GTE(semver.MustParse("0.3.0")) && memo.GetExpiry() > 0 && ctx.BlockHeight() > memo.GetExpiry() {
+			ctx.Logger().Info("tx has expired", "expiry", memo.GetExpiry(), "tx hash", tx.Tx.ID.String())
+			if newErr := refundTx(ctx, tx, txOutStore, h.keeper, constAccessor, CodeTxExpired, "tx expired", eventMgr); nil != newErr {
+				return sdk.ErrInternal(newErr.Error()).Result()
+			}
+			continue
+		}
+
 		// if its a swap, send it to our queue for processing later
 		if isSwap {
 			if err := h.keeper.SetSwapQueueItem(ctx, m.(MsgSwap)); err != nil {
@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"math"
+
 	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
@@ -71,12 +73,20 @@ func (s *HandlerObservedTxInSuite) TestValidate(c *C) {
 	c.Assert(err, Equals, errInvalidVersion)
 	c.Assert(isNewSigner, Equals, false)
 
-	// inactive node account
+	// not a node account
 	msg = NewMsgObservedTxIn(txs, GetRandomBech32Addr())
 	isNewSigner, err = handler.validate(ctx, msg, ver)
 	c.Assert(err, Equals, notAuthorized)
 	c.Assert(isNewSigner, Equals, false)
 
+	// node account that is not active
+	msg = NewMsgObservedTxIn(txs, standbyAccount.NodeAddress)
+	c.Assert(msg.GetSigners(), DeepEquals, []sdk.AccAddress{standbyAccount.NodeAddress})
+	c.Assert(msg.ValidateSigners(ctx, keeper), NotNil)
+	isNewSigner, err = handler.validate(ctx, msg, ver)
+	c.Assert(err, Equals, notAuthorized)
+	c.Assert(isNewSigner, Equals, false)
+
 	// invalid msg
 	msg = MsgObservedTxIn{}
 	isNewSigner, err = handler.validate(ctx, msg, ver)
@@ -197,6 +207,10 @@ func (k *TestObservedTxInHandleKeeper) GetLowestActiveVersion(_ sdk.Context) sem
 	return constants.SWVersion
 }
 
+func (k *TestObservedTxInHandleKeeper) GetThorchainVersion(_ sdk.Context) semver.Version {
+	return constants.SWVersion
+}
+
 func (k *TestObservedTxInHandleKeeper) IsActiveObserver(_ sdk.Context, addr sdk.AccAddress) bool {
 	if addr.Equals(k.nas[0].NodeAddress) {
 		return true
@@ -332,3 +346,108 @@ func (s *HandlerObservedTxInSuite) TestMigrateMemo(c *C) {
 	result := handler.handle(ctx, msg, ver)
 	c.Assert(result.IsOK(), Equals, true)
 }
+
+func (s *HandlerObservedTxInSuite) TestHandleExpired(c *C) {
+	var err error
+	ctx, _ := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(20)
+	w := getHandlerTestWrapper(c, 1, true, false)
+
+	// memo expiry is ignored before version 0.3.0
+	for _, ver := range []semver.Version{semver.MustParse("0.2.0"), constants.SWVersion} {
+		expired := ver.GTE(semver.MustParse("0.3.0"))
+		tx := GetRandomTx()
+		tx.Memo = "SWAP:BTC.BTC:" + GetRandomBTCAddress().String() + "::::10"
+		obTx := NewObservedTx(tx, 12, GetRandomPubKey())
+		txs := ObservedTxs{obTx}
+		pk := GetRandomPubKey()
+		txs[0].Tx.ToAddress, err = pk.GetAddress(txs[0].Tx.Coins[0].Asset.Chain)
+		c.Assert(err, IsNil)
+
+		vault := GetRandomVault()
+		vault.PubKey = obTx.ObservedPubKey
+
+		keeper := &TestObservedTxInHandleKeeper{
+			nas:   NodeAccounts{GetRandomNodeAccount(NodeActive)},
+			voter: NewObservedTxVoter(tx.ID, make(ObservedTxs, 0)),
+			vault: vault,
+			pool: Pool{
+				Asset:        common.BNBAsset,
+				BalanceRune:  sdk.NewUint(200),
+				BalanceAsset: sdk.NewUint(300),
+			},
+			yggExists: true,
+		}
+		versionedTxOutStore := NewVersionedTxOutStoreDummy()
+		versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStore)
+		versionedGasMgr := NewVersionedGasMgr()
+		versionedObMgr := NewVersionedObserverMgr()
+		versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+		handler := NewObservedTxInHandler(keeper, versionedObMgr, versionedTxOutStore, w.validatorMgr, versionedVaultMgrDummy, versionedGasMgr, versionedEventManagerDummy)
+
+		msg := NewMsgObservedTxIn(txs, keeper.nas[0].NodeAddress)
+		result := handler.handle(ctx, msg, ver)
+		c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+		txOutStore, err := versionedTxOutStore.GetTxOutStore(ctx, keeper, ver)
+		c.Assert(err, IsNil)
+		items, err := txOutStore.GetOutboundItems(ctx)
+		c.Assert(err, IsNil)
+		if !expired {
+			// the swap is queued as usual
+			c.Check(keeper.msg.Tx.ID.Equals(tx.ID), Equals, true)
+			c.Check(items, HasLen, 0)
+			continue
+		}
+		// expired swap is refunded rather than queued
+		c.Check(keeper.msg.Tx.ID.IsEmpty(), Equals, true)
+		c.Assert(items, HasLen, 1)
+		c.Check(items[0].Memo, Equals, NewRefundMemo(tx.ID).String())
+	}
+}
+
+func (s *HandlerObservedTxInSuite) TestHandleInvalidCoins(c *C) {
+	var err error
+	ctx, _ := setupKeeperForTest(c)
+	w := getHandlerTestWrapper(c, 1, true, false)
+
+	ver := constants.SWVersion
+
+	tx := GetRandomTx()
+	tx.Memo = "SWAP:BTC.BTC:" + GetRandomBTCAddress().String()
+	tx.Coins[0].Amount = sdk.NewUint(math.MaxInt64).AddUint64(1)
+	obTx := NewObservedTx(tx, 12, GetRandomPubKey())
+	txs := ObservedTxs{obTx}
+	pk := GetRandomPubKey()
+	txs[0].Tx.ToAddress, err = pk.GetAddress(txs[0].Tx.Coins[0].Asset.Chain)
+	c.Assert(err, IsNil)
+
+	vault := GetRandomVault()
+	vault.PubKey = obTx.ObservedPubKey
+
+	keeper := &TestObservedTxInHandleKeeper{
+		nas:   NodeAccounts{GetRandomNodeAccount(NodeActive)},
+		voter: NewObservedTxVoter(tx.ID, make(ObservedTxs, 0)),
+		vault: vault,
+		pool: Pool{
+			Asset:        common.BNBAsset,
+			BalanceRune:  sdk.NewUint(200),
+			BalanceAsset: sdk.NewUint(300),
+		},
+		yggExists: true,
+	}
+	versionedTxOutStore := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStore)
+	versionedGasMgr := NewVersionedGasMgr()
+	versionedObMgr := NewVersionedObserverMgr()
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	handler := NewObservedTxInHandler(keeper, versionedObMgr, versionedTxOutStore, w.validatorMgr, versionedVaultMgrDummy, versionedGasMgr, versionedEventManagerDummy)
+
+	msg := NewMsgObservedTxIn(txs, keeper.nas[0].NodeAddress)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	result := handler.handle(ctx, msg, ver)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+	// the tx is refunded rather than swapped
+	c.Check(keeper.msg.Tx.ID.IsEmpty(), Equals, true)
+}
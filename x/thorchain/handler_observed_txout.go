@@ -83,12 +83,15 @@ func (h ObservedTxOutHandler) handle(ctx sdk.Context, msg MsgObservedTxOut, vers
 
 func (h ObservedTxOutHandler) preflight(ctx sdk.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer sdk.AccAddress) (ObservedTxVoter, bool) {
 	voter.Add(tx, signer)
+	if err := h.keeper.AddObservation(ctx, tx, signer); err != nil {
+		ctx.Logger().Error("fail to add observation to history", "error", err)
+	}
 	ok := false
-	if voter.HasConsensus(nas) && !voter.ProcessedOut {
+	if consensusTx, hasConsensus := getObservedTxConsensus(ctx, h.keeper, &voter, nas); hasConsensus && !voter.ProcessedOut {
 		ok = true
 		voter.Height = ctx.BlockHeight()
 		voter.ProcessedOut = true
-		voter.Tx = voter.GetTx(nas)
+		voter.Tx = consensusTx
 	}
 	h.keeper.SetObservedTxVoter(ctx, voter)
 
@@ -96,6 +99,20 @@ func (h ObservedTxOutHandler) preflight(ctx sdk.Context, voter ObservedTxVoter,
 	return voter, ok
 }
 
+// getTxOutHeight return the block height the tx out item of the outbound memo was scheduled at, outbound and refund
+// are scheduled at the height their inbound tx reached consensus, others carry the height in the memo
+func (h ObservedTxOutHandler) getTxOutHeight(ctx sdk.Context, memo Memo) int64 {
+	if memo.GetTxID().IsEmpty() {
+		return memo.GetBlockHeight()
+	}
+	voter, err := h.keeper.GetObservedTxVoter(ctx, memo.GetTxID())
+	if err != nil {
+		ctx.Logger().Error("fail to get observed tx voter", "error", err)
+		return 0
+	}
+	return voter.Height
+}
+
 // Handle a message to observe outbound tx
 func (h ObservedTxOutHandler) handleV1(ctx sdk.Context, version semver.Version, msg MsgObservedTxOut) sdk.Result {
 	constAccessor := constants.GetConstantValues(version)
@@ -117,6 +134,12 @@ func (h ObservedTxOutHandler) handleV1(ctx sdk.Context, version semver.Version,
 		return sdk.ErrInternal("fail to get gas manager").Result()
 	}
 
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get event manager", "error", err)
+		return errFailGetEventManager.Result()
+	}
+
 	handler := NewInternalHandler(h.keeper, h.versionedTxOutStore, h.validatorMgr, h.versionedVaultManager, h.versionedObserverManager, h.versionedGasMgr, h.versionedEventManager)
 
 	for _, tx := range msg.Txs {
@@ -164,14 +187,9 @@ func (h ObservedTxOutHandler) handleV1(ctx sdk.Context, version semver.Version,
 				continue
 			}
 			if vault.IsYggdrasil() {
-				slash, err := NewSlasher(h.keeper, version, h.versionedEventManager)
-				if err != nil {
-					ctx.Logger().Error("fail to create slasher:%w", err)
-					continue
-				}
 				// a yggdrasil vault has apparently stolen funds, slash them
 				for _, c := range append(tx.Tx.Coins, tx.Tx.Gas.ToCoins()...) {
-					if err := slash.SlashNodeAccount(ctx, tx.ObservedPubKey, c.Asset, c.Amount); err != nil {
+					if err := h.keeper.SlashNodeAccount(ctx, tx.ObservedPubKey, c.Asset, c.Amount, eventMgr); err != nil {
 						ctx.Logger().Error("fail to slash account for sending extra fund", "error", err)
 					}
 				}
@@ -186,7 +204,7 @@ func (h ObservedTxOutHandler) handleV1(ctx sdk.Context, version semver.Version,
 
 		txOut := voter.GetTx(activeNodeAccounts) // get consensus tx, in case our for loop is incorrect
 		txOut.Tx.Memo = tx.Tx.Memo
-		m, err := processOneTxIn(ctx, h.keeper, txOut, msg.Signer)
+		m, err := processOneTxIn(ctx, version, h.keeper, txOut, msg.Signer)
 		if err != nil || tx.Tx.Chain.IsEmpty() {
 			ctx.Logger().Error("fail to process txOut",
 				"error", err,
@@ -194,6 +212,13 @@ func (h ObservedTxOutHandler) handleV1(ctx sdk.Context, version semver.Version,
 			continue
 		}
 
+		// index the outbound by the height its tx out item was scheduled at, so it can be reconciled later
+		if height := h.getTxOutHeight(ctx, memo); height > 0 {
+			if err := h.keeper.AddObservedOutboundTx(ctx, height, txOut.Tx.ID); err != nil {
+				ctx.Logger().Error("fail to index observed outbound tx", "error", err)
+			}
+		}
+
 		// Apply Gas fees
 		if err := AddGasFees(ctx, h.keeper, tx, gasMgr); err != nil {
 			return sdk.ErrInternal(fmt.Errorf("fail to add gas fee: %w", err).Error()).Result()
@@ -85,6 +85,8 @@ type TestObservedTxOutHandleKeeper struct {
 	txOutStore TxOutStore
 	observing  []sdk.AccAddress
 	gas        []sdk.Uint
+	slashed    common.Coins
+	outbounds  map[int64]common.TxIDs
 }
 
 func (k *TestObservedTxOutHandleKeeper) ListActiveNodeAccounts(_ sdk.Context) (NodeAccounts, error) {
@@ -104,6 +106,19 @@ func (k *TestObservedTxOutHandleKeeper) SetNodeAccount(_ sdk.Context, na NodeAcc
 	return nil
 }
 
+func (k *TestObservedTxOutHandleKeeper) SlashNodeAccount(_ sdk.Context, _ common.PubKey, asset common.Asset, amount sdk.Uint, _ EventManager) error {
+	k.slashed = append(k.slashed, common.NewCoin(asset, amount))
+	return nil
+}
+
+func (k *TestObservedTxOutHandleKeeper) AddObservedOutboundTx(_ sdk.Context, height int64, txID common.TxID) error {
+	if k.outbounds == nil {
+		k.outbounds = make(map[int64]common.TxIDs)
+	}
+	k.outbounds[height] = append(k.outbounds[height], txID)
+	return nil
+}
+
 func (k *TestObservedTxOutHandleKeeper) GetObservedTxVoter(_ sdk.Context, _ common.TxID) (ObservedTxVoter, error) {
 	return k.voter, nil
 }
@@ -242,6 +257,9 @@ func (s *HandlerObservedTxOutSuite) TestHandle(c *C) {
 	c.Check(keeper.observing, HasLen, 1)
 	// make sure the coin has been subtract from the vault
 	c.Check(ygg.Coins.GetCoin(common.BNBAsset).Amount.Equal(sdk.NewUint(19999962499)), Equals, true, Commentf("%d", ygg.Coins.GetCoin(common.BNBAsset).Amount.Uint64()))
+	// the outbound is indexed by the height its inbound reached consensus
+	c.Assert(keeper.outbounds[ctx.BlockHeight()], HasLen, 1)
+	c.Check(keeper.outbounds[ctx.BlockHeight()][0].Equals(tx.ID), Equals, true)
 }
 
 func (s *HandlerObservedTxOutSuite) TestGasUpdate(c *C) {
@@ -355,5 +373,7 @@ func (s *HandlerObservedTxOutSuite) TestHandleStolenFunds(c *C) {
 	c.Assert(result.IsOK(), Equals, true)
 	// make sure the coin has been subtract from the vault
 	c.Check(ygg.Coins.GetCoin(common.BNBAsset).Amount.Equal(sdk.NewUint(9999962500)), Equals, true, Commentf("%d", ygg.Coins.GetCoin(common.BNBAsset).Amount.Uint64()))
-	c.Assert(keeper.na.Bond.LT(sdk.NewUint(1000000*common.One)), Equals, true, Commentf("%d", keeper.na.Bond.Uint64()))
+	// make sure the node has been slashed for the stolen fund
+	c.Check(keeper.slashed.GetCoin(common.RuneAsset()).Amount.Equal(sdk.NewUint(300*common.One)), Equals, true, Commentf("%s", keeper.slashed))
+	c.Check(keeper.slashed.GetCoin(common.BNBAsset).Amount.Equal(sdk.NewUint(100*common.One)), Equals, true, Commentf("%s", keeper.slashed))
 }
@@ -173,6 +173,12 @@ func (k *outboundTxHandlerKeeperHelper) SetVault(ctx sdk.Context, v Vault) error
 	return nil
 }
 
+// SlashNodeAccount goes through slashNodeAccount with the helper as the keeper so the
+// error injection on the node account, pool and vault data accessors applies
+func (k *outboundTxHandlerKeeperHelper) SlashNodeAccount(ctx sdk.Context, pk common.PubKey, asset common.Asset, amount sdk.Uint, eventMgr EventManager) error {
+	return slashNodeAccount(ctx, k, pk, asset, amount, eventMgr)
+}
+
 func (k *outboundTxHandlerKeeperHelper) GetVaultData(ctx sdk.Context) (VaultData, error) {
 	if k.errGetVaultData {
 		return VaultData{}, kaboom
@@ -242,7 +248,7 @@ func newOutboundTxHandlerTestHelper(c *C) outboundTxHandlerTestHelper {
 	c.Assert(err, IsNil)
 	c.Check(result, Equals, true)
 
-	swapEvent := NewEventSwap(common.BNBAsset, sdk.NewUint(common.One), sdk.NewUint(common.One), sdk.NewUint(common.One), sdk.NewUint(common.One), GetRandomTx())
+	swapEvent := NewEventSwap(common.BNBAsset, sdk.NewUint(common.One), sdk.NewUint(common.One), sdk.NewUint(common.One), sdk.NewUint(common.One), false, GetRandomTx())
 	buf, err := json.Marshal(swapEvent)
 	c.Assert(err, IsNil)
 	e := NewEvent(swapEvent.Type(), ctx.BlockHeight(), tx.Tx, buf, EventPending)
@@ -0,0 +1,178 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// PartialUnbondHandler a handler to process partial bond withdrawal of an active node
+type PartialUnbondHandler struct {
+	keeper                Keeper
+	versionedTxOutStore   VersionedTxOutStore
+	versionedEventManager VersionedEventManager
+}
+
+// NewPartialUnbondHandler create new PartialUnbondHandler
+func NewPartialUnbondHandler(keeper Keeper, versionedTxOutStore VersionedTxOutStore, versionedEventManager VersionedEventManager) PartialUnbondHandler {
+	return PartialUnbondHandler{
+		keeper:                keeper,
+		versionedTxOutStore:   versionedTxOutStore,
+		versionedEventManager: versionedEventManager,
+	}
+}
+
+func (h PartialUnbondHandler) validate(ctx sdk.Context, msg MsgPartialUnbond, version semver.Version, constAccessor constants.ConstantValues) sdk.Error {
+	if version.GTE(semver.MustParse("0.3.0")) {
+		return h.validateV1(ctx, msg, constAccessor)
+	}
+	return errBadVersion
+}
+
+func (h PartialUnbondHandler) validateV1(ctx sdk.Context, msg MsgPartialUnbond, constAccessor constants.ConstantValues) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	// only the node itself is allowed to withdraw its bond
+	if !msg.Signer.Equals(msg.NodeAddress) {
+		return sdk.ErrUnauthorized("msg is not signed by the node account")
+	}
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return sdk.ErrUnauthorized("msg is not signed by an active node account")
+	}
+
+	nodeAccount, err := h.keeper.GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("fail to get node account(%s): %s", msg.NodeAddress, err))
+	}
+	if nodeAccount.Status != NodeActive {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("node account(%s) is not active", msg.NodeAddress))
+	}
+
+	minBond, err := h.keeper.GetMimir(ctx, constants.MinimumBondInRune.String())
+	if minBond < 0 || err != nil {
+		minBond = constAccessor.GetInt64Value(constants.MinimumBondInRune)
+	}
+	minValidatorBond := sdk.NewUint(uint64(minBond))
+	if nodeAccount.Bond.LT(msg.Amount) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("not enough bond, bond(%s), withdraw(%s)", nodeAccount.Bond, msg.Amount))
+	}
+	remaining := nodeAccount.Bond.Sub(msg.Amount)
+	if remaining.LT(minValidatorBond) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("remaining bond(%s) is less than minimum validator bond (%s)", remaining, minValidatorBond))
+	}
+	// the remaining bond still need to cover the funds the node holds in its yggdrasil vault
+	if h.keeper.VaultExists(ctx, nodeAccount.PubKeySet.Secp256k1) {
+		ygg, err := h.keeper.GetVault(ctx, nodeAccount.PubKeySet.Secp256k1)
+		if err != nil {
+			return sdk.ErrInternal(fmt.Sprintf("fail to get yggdrasil vault(%s): %s", nodeAccount.PubKeySet.Secp256k1, err))
+		}
+		yggRune, err := getTotalYggValueInRune(ctx, h.keeper, ygg)
+		if err != nil {
+			return sdk.ErrInternal(fmt.Sprintf("fail to get total ygg value in RUNE: %s", err))
+		}
+		if remaining.LT(yggRune) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("remaining bond(%s) is less than the value of the yggdrasil vault (%s)", remaining, yggRune))
+		}
+	}
+	return nil
+}
+
+// Run execute the handler
+func (h PartialUnbondHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgPartialUnbond)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	ctx.Logger().Info("receive MsgPartialUnbond",
+		"node address", msg.NodeAddress,
+		"amount", msg.Amount)
+	if err := h.validate(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("msg partial unbond fail validation", "error", err)
+		return err.Result()
+	}
+	if err := h.handle(ctx, msg, version); err != nil {
+		ctx.Logger().Error("fail to process msg partial unbond", "error", err)
+		return err.Result()
+	}
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
+
+func (h PartialUnbondHandler) handle(ctx sdk.Context, msg MsgPartialUnbond, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.3.0")) {
+		return h.handleV1(ctx, msg, version)
+	}
+	return errBadVersion
+}
+
+func (h PartialUnbondHandler) handleV1(ctx sdk.Context, msg MsgPartialUnbond, version semver.Version) sdk.Error {
+	nodeAccount, err := h.keeper.GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("fail to get node account(%s): %s", msg.NodeAddress, err))
+	}
+
+	active, err := h.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to get active vaults: %w", err).Error())
+	}
+	vault := active.SelectByMinCoin(common.RuneAsset())
+	if vault.IsEmpty() {
+		return sdk.ErrInternal("unable to determine asgard vault to send funds")
+	}
+
+	hash := tmtypes.Tx(ctx.TxBytes()).Hash()
+	txID, err := common.NewTxID(fmt.Sprintf("%X", hash))
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to get tx hash: %w", err).Error())
+	}
+	refundAddress := nodeAccount.BondAddress
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		refundAddress = common.Address(nodeAccount.NodeAddress.String())
+	}
+	coin := common.NewCoin(common.RuneAsset(), msg.Amount)
+	tx := common.NewTx(txID, common.Address(msg.NodeAddress.String()), refundAddress, common.Coins{coin}, common.Gas{}, "")
+
+	txOutStore, err := h.versionedTxOutStore.GetTxOutStore(ctx, h.keeper, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get txout store", "error", err)
+		return errBadVersion
+	}
+	txOutItem := &TxOutItem{
+		Chain:       common.RuneAsset().Chain,
+		ToAddress:   refundAddress,
+		VaultPubKey: vault.PubKey,
+		InHash:      txID,
+		Coin:        coin,
+	}
+	ok, err := txOutStore.TryAddTxOutItem(ctx, txOutItem)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to add outbound tx: %w", err).Error())
+	}
+	if !ok {
+		return sdk.ErrInternal("fail to add outbound tx")
+	}
+
+	nodeAccount.Bond = common.SafeSub(nodeAccount.Bond, msg.Amount)
+	if err := h.keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to save node account(%s): %w", nodeAccount.NodeAddress, err).Error())
+	}
+
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get event manager", "error", err)
+		return errFailGetEventManager
+	}
+	bondEvent := NewEventBond(msg.Amount, BondReturned, tx)
+	if err := eventMgr.EmitBondEvent(ctx, h.keeper, bondEvent); err != nil {
+		return sdk.NewError(DefaultCodespace, CodeFailSaveEvent, "fail to emit bond event")
+	}
+	return nil
+}
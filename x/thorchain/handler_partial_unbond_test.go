@@ -0,0 +1,133 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerPartialUnbondSuite struct{}
+
+var _ = Suite(&HandlerPartialUnbondSuite{})
+
+func (HandlerPartialUnbondSuite) TestPartialUnbondHandler(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	minBond := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.MinimumBondInRune)))
+
+	c.Assert(k.SetVault(ctx, GetRandomVault()), IsNil)
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = minBond.Add(sdk.NewUint(100 * common.One))
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	standby := GetRandomNodeAccount(NodeStandby)
+	standby.Bond = minBond.Add(sdk.NewUint(100 * common.One))
+	c.Assert(k.SetNodeAccount(ctx, standby), IsNil)
+
+	versionedTxOutStore := NewVersionedTxOutStoreDummy()
+	handler := NewPartialUnbondHandler(k, versionedTxOutStore, NewVersionedEventMgr())
+
+	testCases := []struct {
+		name         string
+		msg          sdk.Msg
+		version      semver.Version
+		expectedCode sdk.CodeType
+	}{
+		{
+			name:         "invalid message",
+			msg:          NewMsgNoOp(GetRandomObservedTx(), na.NodeAddress),
+			version:      ver,
+			expectedCode: CodeInvalidMessage,
+		},
+		{
+			name:         "bad version",
+			msg:          NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(common.One), na.NodeAddress),
+			version:      semver.Version{},
+			expectedCode: CodeBadVersion,
+		},
+		{
+			name:         "partial unbond is not accepted before version 0.3.0",
+			msg:          NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(common.One), na.NodeAddress),
+			version:      semver.MustParse("0.2.0"),
+			expectedCode: CodeBadVersion,
+		},
+		{
+			name:         "zero amount",
+			msg:          NewMsgPartialUnbond(na.NodeAddress, sdk.ZeroUint(), na.NodeAddress),
+			version:      ver,
+			expectedCode: sdk.CodeUnknownRequest,
+		},
+		{
+			name:         "not signed by the node itself",
+			msg:          NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(common.One), GetRandomBech32Addr()),
+			version:      ver,
+			expectedCode: sdk.CodeUnauthorized,
+		},
+		{
+			name:         "node is not active",
+			msg:          NewMsgPartialUnbond(standby.NodeAddress, sdk.NewUint(common.One), standby.NodeAddress),
+			version:      ver,
+			expectedCode: sdk.CodeUnauthorized,
+		},
+		{
+			name:         "more than bond",
+			msg:          NewMsgPartialUnbond(na.NodeAddress, na.Bond.Add(sdk.OneUint()), na.NodeAddress),
+			version:      ver,
+			expectedCode: sdk.CodeUnknownRequest,
+		},
+		{
+			name:         "remaining bond under minimum bond",
+			msg:          NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(100*common.One+1), na.NodeAddress),
+			version:      ver,
+			expectedCode: sdk.CodeUnknownRequest,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler.Run(ctx, tc.msg, tc.version, constAccessor)
+		c.Assert(result.Code, Equals, tc.expectedCode, Commentf(tc.name))
+	}
+	items, err := versionedTxOutStore.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	// minimum bond set by mimir
+	k.SetMimir(ctx, constants.MinimumBondInRune.String(), int64(na.Bond.Uint64()))
+	msg := NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(common.One), na.NodeAddress)
+	result := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+	k.SetMimir(ctx, constants.MinimumBondInRune.String(), -1)
+
+	// the remaining bond doesn't cover the funds in the yggdrasil vault
+	ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, common.Chains{common.RuneAsset().Chain})
+	ygg.AddFunds(common.Coins{common.NewCoin(common.RuneAsset(), minBond.Add(sdk.NewUint(common.One)))})
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+	msg = NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(100*common.One), na.NodeAddress)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+	items, err = versionedTxOutStore.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+	ygg.SubFunds(common.Coins{common.NewCoin(common.RuneAsset(), minBond.Add(sdk.NewUint(common.One)))})
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+
+	// happy path, the remaining bond is exactly the minimum bond
+	msg = NewMsgPartialUnbond(na.NodeAddress, sdk.NewUint(100*common.One), na.NodeAddress)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(minBond), Equals, true)
+	c.Check(na.Status, Equals, NodeActive)
+	items, err = versionedTxOutStore.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))), Equals, true)
+	refundAddress := na.BondAddress
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		refundAddress = common.Address(na.NodeAddress.String())
+	}
+	c.Check(items[0].ToAddress.Equals(refundAddress), Equals, true)
+}
@@ -77,6 +77,7 @@ type TestRagnarokKeeperHappyPath struct {
 	retireVault       Vault
 	txout             *TxOut
 	pool              Pool
+	slashed           common.Coins
 }
 
 func (k *TestRagnarokKeeperHappyPath) GetTxOut(ctx sdk.Context, blockHeight int64) (*TxOut, error) {
@@ -116,6 +117,11 @@ func (k *TestRagnarokKeeperHappyPath) UpsertEvent(_ sdk.Context, _ Event) error
 	return nil
 }
 
+func (k *TestRagnarokKeeperHappyPath) SlashNodeAccount(_ sdk.Context, _ common.PubKey, asset common.Asset, amount sdk.Uint, _ EventManager) error {
+	k.slashed = append(k.slashed, common.NewCoin(asset, amount))
+	return nil
+}
+
 func (HandlerRagnarokSuite) TestRagnarokHappyPath(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	retireVault := GetRandomVault()
@@ -200,5 +206,5 @@ func (HandlerRagnarokSuite) TestSlash(c *C) {
 	msgRagnarok := NewMsgRagnarok(tx, 1, keeper.activeNodeAccount.NodeAddress)
 	result := handler.handleV1(ctx, constants.SWVersion, msgRagnarok)
 	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
-	c.Assert(keeper.activeNodeAccount.Bond.Equal(sdk.NewUint(9999998464)), Equals, true, Commentf("%d", keeper.activeNodeAccount.Bond.Uint64()))
+	c.Assert(keeper.slashed.Equals(tx.Tx.Coins), Equals, true, Commentf("%s", keeper.slashed))
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/constants"
 )
 
@@ -23,26 +24,26 @@ func NewReserveContributorHandler(keeper Keeper, versionedEventManager Versioned
 	}
 }
 
-func (h ReserveContributorHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, _ constants.ConstantValues) sdk.Result {
+func (h ReserveContributorHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
 	msg, ok := m.(MsgReserveContributor)
 	if !ok {
 		return errInvalidMessage.Result()
 	}
-	if err := h.Validate(ctx, msg, version); err != nil {
+	if err := h.Validate(ctx, msg, version, constAccessor); err != nil {
 		ctx.Logger().Error("MsgReserveContributor failed validation", "error", err)
 		return err.Result()
 	}
 	return h.Handle(ctx, msg, version)
 }
 
-func (h ReserveContributorHandler) Validate(ctx sdk.Context, msg MsgReserveContributor, version semver.Version) sdk.Error {
+func (h ReserveContributorHandler) Validate(ctx sdk.Context, msg MsgReserveContributor, version semver.Version, constAccessor constants.ConstantValues) sdk.Error {
 	if version.GTE(semver.MustParse("0.1.0")) {
-		return h.ValidateV1(ctx, msg)
+		return h.ValidateV1(ctx, msg, constAccessor)
 	}
 	return errBadVersion
 }
 
-func (h ReserveContributorHandler) ValidateV1(ctx sdk.Context, msg MsgReserveContributor) sdk.Error {
+func (h ReserveContributorHandler) ValidateV1(ctx sdk.Context, msg MsgReserveContributor, constAccessor constants.ConstantValues) sdk.Error {
 	if err := msg.ValidateBasic(); err != nil {
 		return err
 	}
@@ -51,6 +52,11 @@ func (h ReserveContributorHandler) ValidateV1(ctx sdk.Context, msg MsgReserveCon
 		return sdk.ErrUnauthorized("not authorized")
 	}
 
+	minContribution := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.MinReserveContribution)))
+	if msg.Contributor.Amount.LT(minContribution) {
+		return sdk.NewError(DefaultCodespace, CodeValidationError, "reserve contribution (%s) is less than the minimum (%s)", msg.Contributor.Amount, minContribution)
+	}
+
 	return nil
 }
 
@@ -78,6 +84,7 @@ func (h ReserveContributorHandler) HandleV1(ctx sdk.Context, msg MsgReserveContr
 		return err
 	}
 
+	// contributions from an address that contributed before are accumulated
 	reses = reses.Add(msg.Contributor)
 	if err := h.keeper.SetReserveContributors(ctx, reses); err != nil {
 		ctx.Logger().Error("fail to save reserve contributors", "error", err)
@@ -90,7 +97,14 @@ func (h ReserveContributorHandler) HandleV1(ctx sdk.Context, msg MsgReserveContr
 		return err
 	}
 
-	vault.TotalReserve = vault.TotalReserve.Add(msg.Contributor.Amount)
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		coin := common.NewCoin(common.RuneNative, msg.Contributor.Amount)
+		if err := h.keeper.SendFromModuleToModule(ctx, AsgardName, ReserveName, coin); err != nil {
+			return fmt.Errorf("fail to transfer funds from asgard to reserve: %w", err)
+		}
+	} else {
+		vault.TotalReserve = vault.TotalReserve.Add(msg.Contributor.Amount)
+	}
 	if err := h.keeper.SetVaultData(ctx, vault); err != nil {
 		ctx.Logger().Error("fail to save vault data", "error", err)
 		return err
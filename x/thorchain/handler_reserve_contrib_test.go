@@ -185,6 +185,20 @@ func (h HandlerReserveContributorSuite) TestReserveContributorHandler(c *C) {
 			},
 			expectedResult: sdk.CodeUnknownRequest,
 		},
+		{
+			name: "contribution less than the minimum should return an error",
+			messageCreator: func(helper reserveContributorHandlerHelper) sdk.Msg {
+				minContribution := helper.constAccessor.GetInt64Value(constants.MinReserveContribution)
+				return NewMsgReserveContributor(GetRandomTx(), ReserveContributor{
+					Address: GetRandomBNBAddress(),
+					Amount:  sdk.NewUint(uint64(minContribution - 1)),
+				}, helper.nodeAccount.NodeAddress)
+			},
+			runner: func(handler ReserveContributorHandler, helper reserveContributorHandlerHelper, msg sdk.Msg) sdk.Result {
+				return handler.Run(helper.ctx, msg, constants.SWVersion, helper.constAccessor)
+			},
+			expectedResult: CodeValidationError,
+		},
 		{
 			name: "fail to get reserve contributor should return an error",
 			messageCreator: func(helper reserveContributorHandlerHelper) sdk.Msg {
@@ -258,6 +272,30 @@ func (h HandlerReserveContributorSuite) TestReserveContributorHandler(c *C) {
 			},
 			expectedResult: sdk.CodeOK,
 		},
+		{
+			name: "multiple contributions from the same address should accumulate",
+			messageCreator: func(helper reserveContributorHandlerHelper) sdk.Msg {
+				return NewMsgReserveContributor(GetRandomTx(), helper.reserveContributor, helper.nodeAccount.NodeAddress)
+			},
+			runner: func(handler ReserveContributorHandler, helper reserveContributorHandlerHelper, msg sdk.Msg) sdk.Result {
+				result := handler.Run(helper.ctx, msg, constants.SWVersion, helper.constAccessor)
+				if !result.IsOK() {
+					return result
+				}
+				return handler.Run(helper.ctx, NewMsgReserveContributor(GetRandomTx(), helper.reserveContributor, helper.nodeAccount.NodeAddress), constants.SWVersion, helper.constAccessor)
+			},
+			validator: func(helper reserveContributorHandlerHelper, msg sdk.Msg, result sdk.Result, c *C) {
+				reses, err := helper.keeper.GetReservesContributors(helper.ctx)
+				c.Assert(err, IsNil)
+				c.Assert(reses, HasLen, 1)
+				c.Check(reses[0].Address.Equals(helper.reserveContributor.Address), Equals, true)
+				c.Check(reses[0].Amount.Equal(helper.reserveContributor.Amount.MulUint64(2)), Equals, true)
+				vault, err := helper.keeper.GetVaultData(helper.ctx)
+				c.Assert(err, IsNil)
+				c.Check(vault.TotalReserve.Equal(helper.reserveContributor.Amount.MulUint64(2)), Equals, true)
+			},
+			expectedResult: sdk.CodeOK,
+		},
 	}
 	for _, tc := range testCases {
 		helper := newReserveContributorHandlerHelper(c)
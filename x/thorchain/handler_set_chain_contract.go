@@ -0,0 +1,85 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// SetChainContractHandler is to handle admin messages to update the smart contract of an EVM chain
+type SetChainContractHandler struct {
+	keeper Keeper
+}
+
+// NewSetChainContractHandler create new instance of SetChainContractHandler
+func NewSetChainContractHandler(keeper Keeper) SetChainContractHandler {
+	return SetChainContractHandler{
+		keeper: keeper,
+	}
+}
+
+// Run it the main entry point to execute set chain contract logic
+func (h SetChainContractHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, _ constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgSetChainContract)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	ctx.Logger().Info("receive MsgSetChainContract", "chain", msg.Contract.Chain, "contract address", msg.Contract.ContractAddress)
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg set chain contract failed validation", "error", err)
+		return err.Result()
+	}
+	if err := h.handle(ctx, msg, version); err != nil {
+		ctx.Logger().Error("fail to process msg set chain contract", "error", err)
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
+
+func (h SetChainContractHandler) validate(ctx sdk.Context, msg MsgSetChainContract, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h SetChainContractHandler) validateV1(ctx sdk.Context, msg MsgSetChainContract) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !msg.Signer.Equals(ADMIN) {
+		ctx.Logger().Error("unauthorized account", "address", msg.Signer.String())
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not authorizaed", msg.Signer))
+	}
+
+	return nil
+}
+
+func (h SetChainContractHandler) handle(ctx sdk.Context, msg MsgSetChainContract, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errBadVersion
+}
+
+func (h SetChainContractHandler) handleV1(ctx sdk.Context, msg MsgSetChainContract) sdk.Error {
+	if err := h.keeper.SetChainContract(ctx, msg.Contract); err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to save chain contract: %w", err).Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("set_chain_contract",
+			sdk.NewAttribute("chain", msg.Contract.Chain.String()),
+			sdk.NewAttribute("contract_address", msg.Contract.ContractAddress.String())))
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerSetChainContractSuite struct{}
+
+var _ = Suite(&HandlerSetChainContractSuite{})
+
+func (s *HandlerSetChainContractSuite) TestSetChainContractHandler(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	handler := NewSetChainContractHandler(k)
+	addr := common.Address("0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473")
+	contract := NewChainContract(common.ETHChain, addr, "[]")
+
+	testCases := []struct {
+		name         string
+		msg          sdk.Msg
+		version      semver.Version
+		expectedCode sdk.CodeType
+	}{
+		{
+			name:         "invalid message",
+			msg:          NewMsgNoOp(GetRandomObservedTx(), ADMIN),
+			version:      ver,
+			expectedCode: CodeInvalidMessage,
+		},
+		{
+			name:         "bad version",
+			msg:          NewMsgSetChainContract(contract, ADMIN),
+			version:      semver.Version{},
+			expectedCode: CodeBadVersion,
+		},
+		{
+			name:         "invalid contract",
+			msg:          NewMsgSetChainContract(ChainContract{}, ADMIN),
+			version:      ver,
+			expectedCode: sdk.CodeUnknownRequest,
+		},
+		{
+			name:         "not signed by admin",
+			msg:          NewMsgSetChainContract(contract, GetRandomBech32Addr()),
+			version:      ver,
+			expectedCode: sdk.CodeUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler.Run(ctx, tc.msg, tc.version, constAccessor)
+		c.Assert(result.Code, Equals, tc.expectedCode, Commentf(tc.name))
+	}
+	result, err := k.GetChainContract(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(result.IsEmpty(), Equals, true)
+
+	// happy path
+	res := handler.Run(ctx, NewMsgSetChainContract(contract, ADMIN), ver, constAccessor)
+	c.Assert(res.Code, Equals, sdk.CodeOK)
+	result, err = k.GetChainContract(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(result.ContractAddress.Equals(addr), Equals, true)
+
+	// keeper fail to save the contract
+	handler = NewSetChainContractHandler(KVStoreDummy{})
+	res = handler.Run(ctx, NewMsgSetChainContract(contract, ADMIN), ver, constAccessor)
+	c.Assert(res.Code, Equals, sdk.CodeInternal)
+}
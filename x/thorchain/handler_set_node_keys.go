@@ -32,14 +32,14 @@ func (h SetNodeKeysHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Versi
 
 func (h SetNodeKeysHandler) validate(ctx sdk.Context, msg MsgSetNodeKeys, version semver.Version) error {
 	if version.GTE(semver.MustParse("0.1.0")) {
-		return h.validateV1(ctx, msg)
+		return h.validateV1(ctx, msg, version)
 	} else {
 		ctx.Logger().Error(errInvalidVersion.Error())
 		return errInvalidVersion
 	}
 }
 
-func (h SetNodeKeysHandler) validateV1(ctx sdk.Context, msg MsgSetNodeKeys) error {
+func (h SetNodeKeysHandler) validateV1(ctx sdk.Context, msg MsgSetNodeKeys, version semver.Version) error {
 	if err := msg.ValidateBasic(); err != nil {
 		ctx.Logger().Error(err.Error())
 		return err
@@ -66,6 +66,13 @@ func (h SetNodeKeysHandler) validateV1(ctx sdk.Context, msg MsgSetNodeKeys) erro
 		ctx.Logger().Error(err.Error())
 		return err
 	}
+	// setting the node keys put the node account back to standby
+	if version.GTE(semver.MustParse("0.3.0")) {
+		if err := NewNodeStatusTransitionManager().Transition(nodeAccount.Status, NodeStandby); err != nil {
+			ctx.Logger().Error("invalid node status transition", "error", err)
+			return err
+		}
+	}
 	if err := h.keeper.EnsureNodeKeysUnique(ctx, msg.ValidatorConsPubKey, msg.PubKeySetSet); err != nil {
 		return err
 	}
@@ -0,0 +1,179 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// SlashHandler is to handle MsgSlash message
+type SlashHandler struct {
+	keeper                Keeper
+	versionedEventManager VersionedEventManager
+}
+
+// NewSlashHandler create new instance of SlashHandler
+func NewSlashHandler(keeper Keeper, versionedEventManager VersionedEventManager) SlashHandler {
+	return SlashHandler{
+		keeper:                keeper,
+		versionedEventManager: versionedEventManager,
+	}
+}
+
+// Run it the main entry point to execute MsgSlash logic
+func (h SlashHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgSlash)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg slash failed validation", "error", err)
+		return err.Result()
+	}
+	return h.handle(ctx, msg, version, constAccessor)
+}
+
+func (h SlashHandler) validate(ctx sdk.Context, msg MsgSlash, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.3.0")) {
+		return h.validateV1(ctx, msg)
+	} else {
+		return errBadVersion
+	}
+}
+
+func (h SlashHandler) validateV1(ctx sdk.Context, msg MsgSlash) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return sdk.ErrUnauthorized(notAuthorized.Error())
+	}
+
+	return nil
+}
+
+func (h SlashHandler) handle(ctx sdk.Context, msg MsgSlash, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("handleMsgSlash request", "node address", msg.NodeAddress.String(), "reason", msg.Reason)
+	if version.GTE(semver.MustParse("0.3.0")) {
+		return h.handleV1(ctx, msg, version, constAccessor)
+	} else {
+		ctx.Logger().Error(errInvalidVersion.Error())
+		return errBadVersion.Result()
+	}
+}
+
+func (h SlashHandler) handleV1(ctx sdk.Context, msg MsgSlash, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	active, err := h.keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get list of active node accounts")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	voter, err := h.keeper.GetObservedSlashVoter(ctx, msg.NodeAddress, msg.BlockHeight, msg.Reason, msg.Amount)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	voter.Sign(msg.Signer)
+	h.keeper.SetObservedSlashVoter(ctx, voter)
+	// doesn't have consensus yet
+	if !voter.HasConsensus(active) {
+		ctx.Logger().Info("not having consensus yet, return")
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+
+	if voter.ConsensusBlockHeight > 0 {
+		// slash already processed
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+
+	voter.ConsensusBlockHeight = ctx.BlockHeight()
+	h.keeper.SetObservedSlashVoter(ctx, voter)
+
+	na, err := h.keeper.GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get node account")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	if na.IsEmpty() {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("node account(%s) not found", msg.NodeAddress)).Result()
+	}
+
+	slashPoints := constAccessor.GetInt64Value(constants.SlashVoteSlashPoints)
+	if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
+		ctx.Logger().Error("fail to inc slash points", "error", err)
+	}
+	if err := h.keeper.RecordSlash(ctx, na.NodeAddress, msg.Reason, slashPoints); err != nil {
+		ctx.Logger().Error("fail to record slash", "error", err)
+	}
+
+	// can't slash more than the node has bonded, unclaimed bond rewards are slashed first
+	slashAmount, err := subtractNodeBond(ctx, h.keeper, &na, msg.Amount)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to subtract node bond")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	if slashAmount.IsZero() {
+		ctx.Logger().Info("node account has no bond to slash", "node address", msg.NodeAddress.String())
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+
+	// slashed bond goes to the reserve
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		coin := common.NewCoin(common.RuneNative, slashAmount)
+		if err := h.keeper.SendFromModuleToModule(ctx, BondName, ReserveName, coin); err != nil {
+			err = wrapError(ctx, err, "fail to transfer funds from bond to reserve")
+			return sdk.ErrInternal(err.Error()).Result()
+		}
+	} else {
+		vaultData, err := h.keeper.GetVaultData(ctx)
+		if err != nil {
+			err = wrapError(ctx, err, "fail to get vault data")
+			return sdk.ErrInternal(err.Error()).Result()
+		}
+		vaultData.TotalReserve = vaultData.TotalReserve.Add(slashAmount)
+		if err := h.keeper.SetVaultData(ctx, vaultData); err != nil {
+			err = wrapError(ctx, err, "fail to save vault data")
+			return sdk.ErrInternal(err.Error()).Result()
+		}
+	}
+
+	if err := h.keeper.SetNodeAccount(ctx, na); err != nil {
+		err = wrapError(ctx, err, "fail to save node account")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	eventSlash := NewEventSlash(common.RuneAsset(), []PoolAmt{
+		{
+			Asset:  common.RuneAsset(),
+			Amount: 0 - int64(slashAmount.Uint64()),
+		},
+	})
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+	if err != nil {
+		return errFailGetEventManager.Result()
+	}
+	if err := eventMgr.EmitSlashEvent(ctx, h.keeper, eventSlash); err != nil {
+		ctx.Logger().Error("fail to emit slash event", "error", err)
+		return sdk.ErrInternal("fail to emit slash event").Result()
+	}
+
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
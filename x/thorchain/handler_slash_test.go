@@ -0,0 +1,130 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+var _ = Suite(&HandlerSlashSuite{})
+
+type HandlerSlashSuite struct{}
+
+func (s *HandlerSlashSuite) TestValidate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	handler := NewSlashHandler(k, NewDummyVersionedEventMgr())
+	// happy path
+	ver := constants.SWVersion
+	msg := NewMsgSlash(1024, GetRandomBech32Addr(), "misbehave", sdk.NewUint(common.One), na.NodeAddress)
+	err := handler.validate(ctx, msg, ver)
+	c.Assert(err, IsNil)
+
+	// invalid version
+	err = handler.validate(ctx, msg, semver.Version{})
+	c.Assert(err, Equals, errBadVersion)
+
+	// slash votes are not accepted before version 0.3.0
+	err = handler.validate(ctx, msg, semver.MustParse("0.2.0"))
+	c.Assert(err, Equals, errBadVersion)
+
+	// not signed by an active node account
+	msg = NewMsgSlash(1024, GetRandomBech32Addr(), "misbehave", sdk.NewUint(common.One), GetRandomBech32Addr())
+	err = handler.validate(ctx, msg, ver)
+	c.Assert(err, NotNil)
+
+	// invalid msg
+	msg = MsgSlash{}
+	err = handler.validate(ctx, msg, ver)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerSlashSuite) TestHandle(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+
+	nas := NodeAccounts{
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+	}
+	for _, na := range nas {
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	target := GetRandomNodeAccount(NodeStandby)
+	target.Bond = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, target), IsNil)
+
+	handler := NewSlashHandler(k, NewDummyVersionedEventMgr())
+	slashAmt := sdk.NewUint(10 * common.One)
+
+	// first vote doesn't reach consensus
+	msg := NewMsgSlash(1024, target.NodeAddress, "misbehave", slashAmt, nas[0].NodeAddress)
+	result := handler.Run(ctx, msg, ver, constants.GetConstantValues(ver))
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	na, err := k.GetNodeAccount(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(target.Bond), Equals, true)
+
+	// second vote reaches 2/3 consensus and applies the slash
+	msg = NewMsgSlash(1024, target.NodeAddress, "misbehave", slashAmt, nas[1].NodeAddress)
+	result = handler.Run(ctx, msg, ver, constants.GetConstantValues(ver))
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	na, err = k.GetNodeAccount(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(90*common.One)), Equals, true)
+	slashPoints := constants.GetConstantValues(ver).GetInt64Value(constants.SlashVoteSlashPoints)
+	pts, err := k.GetNodeAccountSlashPoints(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, slashPoints)
+	records, err := k.GetNodeAccountSlashHistory(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Reason, Equals, "misbehave")
+	c.Check(records[0].Amount, Equals, slashPoints)
+
+	// further votes don't slash again
+	msg = NewMsgSlash(1024, target.NodeAddress, "misbehave", slashAmt, nas[2].NodeAddress)
+	result = handler.Run(ctx, msg, ver, constants.GetConstantValues(ver))
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	na, err = k.GetNodeAccount(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(90*common.One)), Equals, true)
+
+	// the same misbehaviour at another block height is a new slash
+	for _, signer := range nas[:2] {
+		msg = NewMsgSlash(1025, target.NodeAddress, "misbehave", slashAmt, signer.NodeAddress)
+		result = handler.Run(ctx, msg, ver, constants.GetConstantValues(ver))
+		c.Assert(result.Code, Equals, sdk.CodeOK)
+	}
+	na, err = k.GetNodeAccount(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(80*common.One)), Equals, true)
+
+	// unclaimed bond rewards can be slashed too
+	target = GetRandomNodeAccount(NodeStandby)
+	target.Bond = sdk.NewUint(5 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, target), IsNil)
+	c.Assert(k.SetNodeBondRewards(ctx, target.PubKeySet.Secp256k1, sdk.NewUint(3*common.One)), IsNil)
+	for _, signer := range nas[:2] {
+		msg = NewMsgSlash(1024, target.NodeAddress, "misbehave", slashAmt, signer.NodeAddress)
+		result = handler.Run(ctx, msg, ver, constants.GetConstantValues(ver))
+		c.Assert(result.Code, Equals, sdk.CodeOK)
+	}
+	na, err = k.GetNodeAccount(ctx, target.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.IsZero(), Equals, true)
+	rewards, err := k.GetNodeBondRewards(ctx, target.PubKeySet.Secp256k1)
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+
+	// invalid message type
+	result = handler.Run(ctx, NewMsgMimir("foo", 1, nas[0].NodeAddress), ver, constants.GetConstantValues(ver))
+	c.Check(result.Code, Equals, CodeInvalidMessage)
+}
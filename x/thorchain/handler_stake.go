@@ -39,6 +39,15 @@ func (h StakeHandler) validateV1(ctx sdk.Context, msg MsgSetStakeData, constAcce
 		return sdk.ErrUnauthorized("msg is not signed by an active node account")
 	}
 
+	ragnarok, err := h.keeper.IsPoolRagnarokInProgress(ctx, msg.Asset)
+	if err != nil {
+		ctx.Logger().Error("fail to check pool ragnarok", "error", err)
+		return sdk.ErrInternal("fail to check pool ragnarok")
+	}
+	if ragnarok {
+		return sdk.NewError(DefaultCodespace, CodeStakePoolRagnarok, "pool %s ragnarok in progress, can't stake", msg.Asset)
+	}
+
 	ensureStakeNoLargerThanBond := constAccessor.GetBoolValue(constants.StrictBondStakeRatio)
 	// the following  only applicable for chaosnet
 	totalStakeRUNE, err := h.getTotalStakeRUNE(ctx)
@@ -148,6 +157,7 @@ func (h StakeHandler) processStakeEvent(ctx sdk.Context, version semver.Version,
 	stakeEvt := NewEventStake(
 		msg.Asset,
 		stakeUnits,
+		msg.Tx.FromAddress,
 		msg.Tx)
 	return eventMgr.EmitStakeEvent(ctx, h.keeper, msg.Tx, stakeEvt)
 }
@@ -22,6 +22,15 @@ type MockStackKeeper struct {
 	failGetPool        bool
 	failGetNextEventID bool
 	addedEvent         bool
+	poolRagnarok       bool
+	failPoolRagnarok   bool
+}
+
+func (m *MockStackKeeper) IsPoolRagnarokInProgress(_ sdk.Context, _ common.Asset) (bool, error) {
+	if m.failPoolRagnarok {
+		return false, kaboom
+	}
+	return m.poolRagnarok, nil
 }
 
 func (m *MockStackKeeper) PoolExist(_ sdk.Context, asset common.Asset) bool {
@@ -123,6 +132,50 @@ func (HandlerStakeSuite) TestStakeHandler(c *C) {
 	c.Check(k.addedEvent, Equals, true)
 }
 
+func (HandlerStakeSuite) TestStakeHandler_PoolRagnarok(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	k := &MockStackKeeper{
+		activeNodeAccount: activeNodeAccount,
+		currentPool: Pool{
+			BalanceRune:  sdk.ZeroUint(),
+			BalanceAsset: sdk.ZeroUint(),
+			Asset:        common.BNBAsset,
+			PoolUnits:    sdk.ZeroUint(),
+			Status:       PoolEnabled,
+		},
+		poolRagnarok: true,
+	}
+	stakeHandler := NewStakeHandler(k, NewVersionedEventMgr())
+	bnbAddr := GetRandomBNBAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		bnbAddr,
+		GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(common.One*5))},
+		BNBGasFeeSingleton,
+		"stake:BNB",
+	)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	msgSetStake := NewMsgSetStakeData(
+		tx,
+		common.BNBAsset,
+		sdk.NewUint(100*common.One),
+		sdk.NewUint(100*common.One),
+		bnbAddr,
+		bnbAddr,
+		activeNodeAccount.NodeAddress)
+	result := stakeHandler.Run(ctx, msgSetStake, ver, constAccessor)
+	c.Assert(result.Code, Equals, CodeStakePoolRagnarok)
+	c.Check(k.addedEvent, Equals, false)
+
+	k.poolRagnarok = false
+	k.failPoolRagnarok = true
+	result = stakeHandler.Run(ctx, msgSetStake, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeInternal)
+}
+
 func (HandlerStakeSuite) TestStakeHandler_NoPool_ShouldCreateNewPool(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	activeNodeAccount := GetRandomNodeAccount(NodeActive)
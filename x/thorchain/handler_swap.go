@@ -1,6 +1,7 @@
 package thorchain
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/blang/semver"
@@ -30,6 +31,9 @@ func (h SwapHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, con
 		return errInvalidMessage.Result()
 	}
 	if err := h.validate(ctx, msg, version); err != nil {
+		if sdkErr, ok := err.(sdk.Error); ok && sdkErr.Code() == CodeSwapFailTradeTarget {
+			return sdkErr.Result()
+		}
 		return sdk.ErrInternal(err.Error()).Result()
 	}
 	return h.handle(ctx, msg, version, constAccessor)
@@ -54,9 +58,62 @@ func (h SwapHandler) validateV1(ctx sdk.Context, msg MsgSwap) error {
 		ctx.Logger().Error(notAuthorized.Error())
 		return notAuthorized
 	}
+
+	// screen out the swap that can't meet the trade target before touching any pool, so it get refunded straight away
+	expected, err := h.getExpectedOutput(ctx, msg)
+	if err != nil {
+		// let the swap itself fail with the proper reason
+		ctx.Logger().Debug("fail to get expected swap output", "error", err)
+		return nil
+	}
+	// the trade target applies to the output left to the customer after the affiliate fee
+	expected = common.SafeSub(expected, calcAffiliateFee(expected, msg.GetAffiliateBps()))
+	if !msg.TradeTarget.IsZero() && expected.LT(msg.TradeTarget) {
+		err := sdk.NewError(DefaultCodespace, CodeSwapFailTradeTarget, "expected emit asset %s less than price limit %s", expected, msg.TradeTarget)
+		ctx.Logger().Error(err.Error())
+		return err
+	}
 	return nil
 }
 
+// getExpectedOutput calculate the expected output of the swap from the current pools, a double swap goes through two pools
+func (h SwapHandler) getExpectedOutput(ctx sdk.Context, msg MsgSwap) (sdk.Uint, error) {
+	if msg.TradeTarget.IsZero() {
+		return sdk.ZeroUint(), nil
+	}
+	source := msg.Tx.Coins[0].Asset
+	if !source.IsRune() && !msg.TargetAsset.IsRune() {
+		pool, err := h.getPool(ctx, source)
+		if err != nil {
+			return sdk.ZeroUint(), err
+		}
+		firstHop := msg
+		firstHop.TargetAsset = common.RuneAsset()
+		runeAmt, err := firstHop.ExpectedOutput(pool)
+		if err != nil {
+			return sdk.ZeroUint(), err
+		}
+		msg.Tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), runeAmt)}
+		source = common.RuneAsset()
+	}
+	asset := source
+	if source.IsRune() {
+		asset = msg.TargetAsset
+	}
+	pool, err := h.getPool(ctx, asset)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	return msg.ExpectedOutput(pool)
+}
+
+func (h SwapHandler) getPool(ctx sdk.Context, asset common.Asset) (Pool, error) {
+	if !h.keeper.PoolExist(ctx, asset) {
+		return Pool{}, fmt.Errorf("pool %s doesn't exist", asset)
+	}
+	return h.keeper.GetPool(ctx, asset)
+}
+
 func (h SwapHandler) handle(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
 	ctx.Logger().Info("receive MsgSwap", "request tx hash", msg.Tx.ID, "source asset", msg.Tx.Coins[0].Asset, "target asset", msg.TargetAsset, "signer", msg.Signer.String())
 	if version.GTE(semver.MustParse("0.1.0")) {
@@ -68,6 +125,10 @@ func (h SwapHandler) handle(ctx sdk.Context, msg MsgSwap, version semver.Version
 }
 
 func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	source := msg.Tx.Coins[0].Asset
+	if !source.IsRune() && !msg.TargetAsset.IsRune() {
+		return h.processDoubleSwap(ctx, msg, version, constAccessor)
+	}
 	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
 	amount, events, swapErr := swap(
 		ctx,
@@ -76,11 +137,50 @@ func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Versi
 		msg.TargetAsset,
 		msg.Destination,
 		msg.TradeTarget,
+		msg.GetAffiliateBps(),
 		sdk.NewUint(uint64(transactionFee)))
 	if swapErr != nil {
 		ctx.Logger().Error("fail to process swap message", "error", swapErr)
 		return swapErr.Result()
 	}
+	return h.completeSwap(ctx, msg, version, amount, events)
+}
+
+// processDoubleSwap swap between two non-RUNE assets, source asset -> RUNE -> target asset.
+// The output of the first hop is the input of the second hop, the trade target only applies to the second hop, as the
+// expected output of both hops has been screened in validate already. When the second hop fails neither pool is
+// saved, so the failed swap get refunded with the original asset instead of the intermediate RUNE
+func (h SwapHandler) processDoubleSwap(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	supportedChains, err := h.getSupportedChains(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get supported chains", "error", err)
+		return sdk.ErrInternal("fail to get supported chains").Result()
+	}
+	memo := NewSwapMemo(msg.TargetAsset, msg.Destination, msg.TradeTarget)
+	if err := memo.IsValidForDoubleSwap(msg.Tx.Coins[0].Asset, supportedChains); err != nil {
+		ctx.Logger().Error("invalid double swap", "error", err)
+		return sdk.NewError(DefaultCodespace, CodeValidationError, err.Error()).Result()
+	}
+	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
+	amount, events, swapErr := swap(
+		ctx,
+		h.keeper,
+		msg.Tx,
+		msg.TargetAsset,
+		msg.Destination,
+		msg.TradeTarget,
+		msg.GetAffiliateBps(),
+		sdk.NewUint(uint64(transactionFee)))
+	if swapErr != nil {
+		ctx.Logger().Error("fail to process double swap message", "error", swapErr)
+		return swapErr.Result()
+	}
+	return h.completeSwap(ctx, msg, version, amount, events)
+}
+
+// completeSwap emit the events of a successful swap, record the fees and the volume of the swapped pools and schedule
+// the outbound tx to the customer, and to the affiliate when the swap has one
+func (h SwapHandler) completeSwap(ctx sdk.Context, msg MsgSwap, version semver.Version, amount sdk.Uint, events []EventSwap) sdk.Result {
 	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
 	if err != nil {
 		ctx.Logger().Error("fail to get event manager", "error", err)
@@ -93,7 +193,17 @@ func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Versi
 		if err := h.keeper.AddToLiquidityFees(ctx, evt.Pool, evt.LiquidityFeeInRune); err != nil {
 			return sdk.ErrInternal(err.Error()).Result()
 		}
+		if err := h.recordSwapVolume(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to record swap volume", "error", err)
+		}
+	}
+
+	txOutStore, err := h.versionedTxOutStore.GetTxOutStore(ctx, h.keeper, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get txout store", "error", err)
+		return errBadVersion.Result()
 	}
+	amount = h.payAffiliate(ctx, msg, txOutStore, amount)
 
 	res, err := h.keeper.Cdc().MarshalBinaryLengthPrefixed(
 		struct {
@@ -105,11 +215,6 @@ func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Versi
 		ctx.Logger().Error("fail to encode result to json", "error", err)
 		return sdk.ErrInternal("fail to encode result to json").Result()
 	}
-	txOutStore, err := h.versionedTxOutStore.GetTxOutStore(ctx, h.keeper, version)
-	if err != nil {
-		ctx.Logger().Error("fail to get txout store", "error", err)
-		return errBadVersion.Result()
-	}
 	toi := &TxOutItem{
 		Chain:     msg.TargetAsset.Chain,
 		InHash:    msg.Tx.ID,
@@ -131,3 +236,54 @@ func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Versi
 		Codespace: DefaultCodespace,
 	}
 }
+
+// payAffiliate schedule the outbound tx of the affiliate fee and return the amount left to the customer. When the
+// affiliate outbound can't be scheduled, the customer get the whole amount instead
+func (h SwapHandler) payAffiliate(ctx sdk.Context, msg MsgSwap, txOutStore TxOutStore, amount sdk.Uint) sdk.Uint {
+	fee := calcAffiliateFee(amount, msg.GetAffiliateBps())
+	if fee.IsZero() {
+		return amount
+	}
+	toi := &TxOutItem{
+		Chain:     msg.TargetAsset.Chain,
+		InHash:    msg.Tx.ID,
+		ToAddress: msg.AffiliateAddress,
+		Coin:      common.NewCoin(msg.TargetAsset, fee),
+	}
+	ok, err := txOutStore.TryAddTxOutItem(ctx, toi)
+	if err != nil || !ok {
+		ctx.Logger().Error("fail to add affiliate outbound tx", "error", err, "affiliate", msg.AffiliateAddress)
+		return amount
+	}
+	return common.SafeSub(amount, fee)
+}
+
+// getSupportedChains return the chains supported by the active asgard vaults
+func (h SwapHandler) getSupportedChains(ctx sdk.Context) (common.Chains, error) {
+	active, err := h.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get active asgard vaults: %w", err)
+	}
+	var chains common.Chains
+	for _, vault := range active {
+		chains = append(chains, vault.Chains...)
+	}
+	return chains.Distinct(), nil
+}
+
+// recordSwapVolume record the input of the given swap in RUNE as the volume of the swapped pool
+func (h SwapHandler) recordSwapVolume(ctx sdk.Context, evt EventSwap) error {
+	if len(evt.InTx.Coins) == 0 {
+		return errors.New("swap event has no input coin")
+	}
+	coin := evt.InTx.Coins[0]
+	volume := coin.Amount
+	if !coin.Asset.IsRune() {
+		pool, err := h.keeper.GetPool(ctx, evt.Pool)
+		if err != nil {
+			return fmt.Errorf("fail to get pool(%s): %w", evt.Pool, err)
+		}
+		volume = pool.AssetValueInRune(coin.Amount)
+	}
+	return h.keeper.RecordSwapVolume(ctx, evt.Pool, volume, ctx.BlockHeight())
+}
@@ -66,6 +66,7 @@ type TestSwapHandleKeeper struct {
 	activeNodeAccount NodeAccount
 	event             []Event
 	hasEvent          bool
+	volumes           map[common.Asset]sdk.Uint
 }
 
 func (k *TestSwapHandleKeeper) PoolExist(_ sdk.Context, asset common.Asset) bool {
@@ -98,11 +99,29 @@ func (k *TestSwapHandleKeeper) AddToLiquidityFees(_ sdk.Context, _ common.Asset,
 	return nil
 }
 
+func (k *TestSwapHandleKeeper) RecordSwapVolume(_ sdk.Context, asset common.Asset, runeVolume sdk.Uint, _ int64) error {
+	if k.volumes == nil {
+		k.volumes = make(map[common.Asset]sdk.Uint)
+	}
+	if volume, ok := k.volumes[asset]; ok {
+		runeVolume = volume.Add(runeVolume)
+	}
+	k.volumes[asset] = runeVolume
+	return nil
+}
+
 func (k *TestSwapHandleKeeper) UpsertEvent(ctx sdk.Context, event Event) error {
 	k.event = append(k.event, event)
 	return nil
 }
 
+func (k *TestSwapHandleKeeper) GetAsgardVaultsByStatus(_ sdk.Context, status VaultStatus) (Vaults, error) {
+	vault := GetRandomVault()
+	vault.Status = status
+	vault.Chains = common.Chains{common.BNBChain, common.BTCChain}
+	return Vaults{vault}, nil
+}
+
 func (k *TestSwapHandleKeeper) clearEvent() {
 	k.event = nil
 }
@@ -254,12 +273,18 @@ func (s *HandlerSwapSuite) TestDoubleSwap(c *C) {
 	c.Assert(res.Code, Equals, sdk.CodeOK)
 	c.Assert(keeper.event, NotNil)
 	c.Assert(len(keeper.event), Equals, 2)
+	// volume is recorded for both pools
+	c.Assert(keeper.volumes, HasLen, 2)
+	c.Check(keeper.volumes[tCanAsset].IsZero(), Equals, false)
+	c.Check(keeper.volumes[common.BNBAsset].IsZero(), Equals, false)
 
 	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 1)
 	keeper.clearEvent()
 	// double swap , RUNE not enough to pay for transaction fee
+	tCanBalanceAsset := keeper.pools[tCanAsset].BalanceAsset
+	tCanBalanceRune := keeper.pools[tCanAsset].BalanceRune
 
 	m1, err := ParseMemo("swap:BNB.BNB:bnb18jtza8j86hfyuj2f90zec0g5gvjh823e5psn2u")
 	txIn1 := NewObservedTx(
@@ -280,8 +305,144 @@ func (s *HandlerSwapSuite) TestDoubleSwap(c *C) {
 	c.Assert(res1.IsOK(), Equals, false)
 	c.Assert(res1.Code, Equals, CodeSwapFailNotEnoughFee)
 	c.Assert(keeper.event, IsNil)
+	// first hop should not be saved
+	tCanPool, err := keeper.GetPool(ctx, tCanAsset)
+	c.Assert(err, IsNil)
+	c.Check(tCanPool.BalanceAsset.Equal(tCanBalanceAsset), Equals, true)
+	c.Check(tCanPool.BalanceRune.Equal(tCanBalanceRune), Equals, true)
 
 	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 0)
+
+	// double swap to a chain that is not supported
+	ethAsset, err := common.NewAsset("ETH.ETH")
+	c.Assert(err, IsNil)
+	txIn2 := NewObservedTx(
+		common.NewTx(GetRandomTxHash(), signerBNBAddr, GetRandomBNBAddress(),
+			common.Coins{
+				common.NewCoin(tCanAsset, sdk.NewUint(20000000)),
+			},
+			BNBGasFeeSingleton,
+			"swap:ETH.ETH",
+		),
+		1,
+		GetRandomPubKey(),
+	)
+	msgSwap2 := NewMsgSwap(txIn2.Tx, ethAsset, GetRandomBNBAddress(), sdk.ZeroUint(), observerAddr)
+	res2 := handler.handle(ctx, msgSwap2, ver, constAccessor)
+	c.Assert(res2.Code, Equals, CodeValidationError)
+	c.Check(keeper.pools[tCanAsset].BalanceAsset.Equal(tCanBalanceAsset), Equals, true)
+
+	// second hop can't meet the trade target, neither pool is saved so the original asset get refunded
+	msgSwap3 := NewMsgSwap(txIn.Tx, common.BNBAsset, GetRandomBNBAddress(), sdk.NewUint(100*common.One), observerAddr)
+	msgSwap3.Tx.ID = GetRandomTxHash()
+	res3 := handler.processDoubleSwap(ctx, msgSwap3, ver, constAccessor)
+	c.Assert(res3.Code, Equals, CodeSwapFailTradeTarget)
+	c.Assert(keeper.event, IsNil)
+	c.Check(keeper.pools[tCanAsset].BalanceAsset.Equal(tCanBalanceAsset), Equals, true)
+	c.Check(keeper.pools[tCanAsset].BalanceRune.Equal(tCanBalanceRune), Equals, true)
+	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+}
+
+func (s *HandlerSwapSuite) TestSwapAffiliate(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	keeper := &TestSwapHandleKeeper{
+		pools:             make(map[common.Asset]Pool),
+		activeNodeAccount: GetRandomNodeAccount(NodeActive),
+	}
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	handler := NewSwapHandler(keeper, versionedTxOutStoreDummy, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	versionedTxOutStoreDummy.txoutStore.NewBlock(1, constAccessor)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Assert(keeper.SetPool(ctx, pool), IsNil)
+
+	observerAddr := keeper.activeNodeAccount.NodeAddress
+	tx := common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))}, BNBGasFeeSingleton, "")
+	expected := calcAssetEmission(pool.BalanceRune, sdk.NewUint(common.One), pool.BalanceAsset)
+	fee := calcAffiliateFee(expected, sdk.NewUint(100))
+	c.Check(fee.Equal(expected.QuoUint64(100)), Equals, true)
+
+	// the trade target applies to the output after the affiliate fee
+	msg := NewMsgSwap(tx, common.BNBAsset, GetRandomBNBAddress(), common.SafeSub(expected, fee).AddUint64(1), observerAddr)
+	msg.AffiliateAddress = GetRandomBNBAddress()
+	msg.AffiliateBps = sdk.NewUint(100)
+	result := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, CodeSwapFailTradeTarget)
+
+	msg.TradeTarget = common.SafeSub(expected, fee)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+	items, err := versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(msg.AffiliateAddress), Equals, true)
+	c.Check(items[1].ToAddress.Equals(msg.Destination), Equals, true)
+	c.Check(items[0].Coin.Amount.Add(items[1].Coin.Amount).Equal(expected), Equals, true)
+}
+
+func (s *HandlerSwapSuite) TestValidateExpectedOutput(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	keeper := &TestSwapHandleKeeper{
+		pools:             make(map[common.Asset]Pool),
+		activeNodeAccount: GetRandomNodeAccount(NodeActive),
+	}
+	handler := NewSwapHandler(keeper, NewVersionedTxOutStoreDummy(), NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Assert(keeper.SetPool(ctx, pool), IsNil)
+	poolBTC := NewPool()
+	poolBTC.Asset = common.BTCAsset
+	poolBTC.BalanceAsset = sdk.NewUint(100 * common.One)
+	poolBTC.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Assert(keeper.SetPool(ctx, poolBTC), IsNil)
+
+	observerAddr := keeper.activeNodeAccount.NodeAddress
+	newTx := func(coin common.Coin) common.Tx {
+		return common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(), common.Coins{coin}, BNBGasFeeSingleton, "")
+	}
+	runeTx := newTx(common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One)))
+
+	// trade target can be met
+	msg := NewMsgSwap(runeTx, common.BNBAsset, GetRandomBNBAddress(), sdk.NewUint(98029604), observerAddr)
+	c.Assert(handler.validate(ctx, msg, ver), IsNil)
+
+	// trade target can't be met , rejected without touching the pool
+	msg = NewMsgSwap(runeTx, common.BNBAsset, GetRandomBNBAddress(), sdk.NewUint(98029605), observerAddr)
+	result := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, CodeSwapFailTradeTarget)
+	c.Check(keeper.pools[common.BNBAsset].BalanceRune.Equal(pool.BalanceRune), Equals, true)
+	c.Check(keeper.pools[common.BNBAsset].BalanceAsset.Equal(pool.BalanceAsset), Equals, true)
+	c.Check(keeper.event, IsNil)
+
+	// double swap goes through both pools
+	btcTx := newTx(common.NewCoin(common.BTCAsset, sdk.NewUint(common.One)))
+	runeAmt := calcAssetEmission(poolBTC.BalanceAsset, sdk.NewUint(common.One), poolBTC.BalanceRune)
+	expected := calcAssetEmission(pool.BalanceRune, runeAmt, pool.BalanceAsset)
+	msg = NewMsgSwap(btcTx, common.BNBAsset, GetRandomBNBAddress(), expected, observerAddr)
+	c.Assert(handler.validate(ctx, msg, ver), IsNil)
+	msg = NewMsgSwap(btcTx, common.BNBAsset, GetRandomBNBAddress(), expected.AddUint64(1), observerAddr)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, CodeSwapFailTradeTarget)
+	c.Check(keeper.pools[common.BTCAsset].BalanceRune.Equal(poolBTC.BalanceRune), Equals, true)
+
+	// pool doesn't exist , leave it to the swap to fail
+	tCanAsset, err := common.NewAsset("BNB.TCAN-014")
+	c.Assert(err, IsNil)
+	msg = NewMsgSwap(runeTx, tCanAsset, GetRandomBNBAddress(), sdk.NewUint(common.One), observerAddr)
+	c.Assert(handler.validate(ctx, msg, ver), IsNil)
 }
@@ -3,6 +3,7 @@ package thorchain
 import (
 	"fmt"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -366,7 +367,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 		common.EmptyPubKey,
 	)
 
-	msg, err := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg, err := getMsgStakeFromMemo(w.ctx, constants.SWVersion, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg, IsNil)
 	c.Assert(err, NotNil)
 
@@ -377,7 +378,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	}
 
 	// stake only rune should be fine
-	msg1, err1 := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg1, err1 := getMsgStakeFromMemo(w.ctx, constants.SWVersion, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg1, NotNil)
 	c.Assert(err1, IsNil)
 
@@ -389,7 +390,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	}
 
 	// stake only token(BNB) should be fine
-	msg2, err2 := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg2, err2 := getMsgStakeFromMemo(w.ctx, constants.SWVersion, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg2, NotNil)
 	c.Assert(err2, IsNil)
 
@@ -402,7 +403,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	}
 
 	// stake only token should be fine
-	msg3, err3 := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg3, err3 := getMsgStakeFromMemo(w.ctx, constants.SWVersion, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg3, IsNil)
 	c.Assert(err3, NotNil)
 
@@ -416,11 +417,34 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 
 	lokiStakeMemo, err := ParseMemo("stake:BNB.LOKI")
 	c.Assert(err, IsNil)
-	msg4, err4 := getMsgStakeFromMemo(w.ctx, lokiStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
+	msg4, err4 := getMsgStakeFromMemo(w.ctx, constants.SWVersion, lokiStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
 	c.Assert(err4, IsNil)
 	c.Assert(msg4, NotNil)
 	msgStake := msg4.(MsgSetStakeData)
 	c.Assert(msgStake, NotNil)
 	c.Assert(msgStake.RuneAddress, Equals, txin.Tx.FromAddress)
 	c.Assert(msgStake.AssetAddress, Equals, txin.Tx.FromAddress)
+
+	// the RUNE side of a stake into a pool on another chain must carry the asset address
+	txin.Tx.Coins = common.Coins{
+		common.NewCoin(runeAsset,
+			sdk.NewUint(100*common.One)),
+	}
+	btcStakeMemo, err := ParseMemo("stake:BTC.BTC")
+	c.Assert(err, IsNil)
+	msg5, err5 := getMsgStakeFromMemo(w.ctx, constants.SWVersion, btcStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
+	c.Assert(err5, NotNil)
+	c.Assert(msg5, IsNil)
+	btcAddr := GetRandomBTCAddress()
+	btcStakeMemo, err = ParseMemo("stake:BTC.BTC:" + btcAddr.String())
+	c.Assert(err, IsNil)
+	msg5, err5 = getMsgStakeFromMemo(w.ctx, constants.SWVersion, btcStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
+	c.Assert(err5, IsNil)
+	c.Assert(msg5.(MsgSetStakeData).AssetAddress, Equals, btcAddr)
+	// before version 0.3.0 the RUNE address is used as the asset address when it is not in the memo
+	btcStakeMemo, err = ParseMemo("stake:BTC.BTC")
+	c.Assert(err, IsNil)
+	msg6, err6 := getMsgStakeFromMemo(w.ctx, semver.MustParse("0.2.0"), btcStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
+	c.Assert(err6, IsNil)
+	c.Assert(msg6.(MsgSetStakeData).AssetAddress, Equals, txin.Tx.FromAddress)
 }
@@ -147,6 +147,9 @@ func (h TssHandler) handleV1(ctx sdk.Context, msg MsgTssPool, version semver.Ver
 					if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
 						ctx.Logger().Error("fail to inc slash points", "error", err)
 					}
+					if err := h.keeper.RecordSlash(ctx, na.NodeAddress, "fail to keygen", slashPoints); err != nil {
+						ctx.Logger().Error("fail to record slash", "error", err)
+					}
 				} else {
 					// take out bond from the node account and add it to vault bond reward RUNE
 					// thus good behaviour node will get reward
@@ -110,6 +110,9 @@ func (h TssKeysignHandler) handleV1(ctx sdk.Context, msg MsgTssKeysignFail, vers
 			if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
 				ctx.Logger().Error("fail to inc slash points", "error", err)
 			}
+			if err := h.keeper.RecordSlash(ctx, na.NodeAddress, "fail to keysign", slashPoints); err != nil {
+				ctx.Logger().Error("fail to record slash", "error", err)
+			}
 		}
 	}
 
@@ -79,12 +79,12 @@ func (h YggdrasilHandler) handle(ctx sdk.Context, msg MsgYggdrasil, version semv
 
 func (h YggdrasilHandler) slash(ctx sdk.Context, version semver.Version, pk common.PubKey, coins common.Coins) error {
 	var returnErr error
-	slasher, err := NewSlasher(h.keeper, version, h.versionedEventManager)
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
 	if err != nil {
-		return fmt.Errorf("fail to create new slasher,error:%w", err)
+		return fmt.Errorf("fail to get event manager: %w", err)
 	}
 	for _, c := range coins {
-		if err := slasher.SlashNodeAccount(ctx, pk, c.Asset, c.Amount); err != nil {
+		if err := h.keeper.SlashNodeAccount(ctx, pk, c.Asset, c.Amount, eventMgr); err != nil {
 			ctx.Logger().Error("fail to slash account", "error", err)
 			returnErr = err
 		}
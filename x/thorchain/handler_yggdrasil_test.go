@@ -19,7 +19,7 @@ type yggdrasilTestKeeper struct {
 	errGetVault        bool
 	errGetAsgardVaults bool
 	errGetNodeAccount  sdk.AccAddress
-	errGetPool         bool
+	errSlash           bool
 }
 
 func (k yggdrasilTestKeeper) GetAsgardVaultsByStatus(ctx sdk.Context, vs VaultStatus) (Vaults, error) {
@@ -41,11 +41,11 @@ func (k *yggdrasilTestKeeper) SetNodeAccount(ctx sdk.Context, na NodeAccount) er
 	return k.Keeper.SetNodeAccount(ctx, na)
 }
 
-func (k yggdrasilTestKeeper) GetPool(ctx sdk.Context, asset common.Asset) (Pool, error) {
-	if k.errGetPool {
-		return Pool{}, kaboom
+func (k yggdrasilTestKeeper) SlashNodeAccount(ctx sdk.Context, pk common.PubKey, asset common.Asset, amount sdk.Uint, eventMgr EventManager) error {
+	if k.errSlash {
+		return kaboom
 	}
-	return k.Keeper.GetPool(ctx, asset)
+	return k.Keeper.SlashNodeAccount(ctx, pk, asset, amount, eventMgr)
 }
 
 func (k *yggdrasilTestKeeper) SetPool(ctx sdk.Context, p Pool) error {
@@ -310,7 +310,7 @@ func (s *HandlerYggdrasilSuite) TestYggdrasilHandler(c *C) {
 			expectedResult: sdk.CodeInternal,
 		},
 		{
-			name: "yggdrasil return fund to asgard but fail to get pool should return an error",
+			name: "yggdrasil return fund to asgard but fail to slash node account should return an error",
 			messageCreator: func(helper yggdrasilHandlerTestHelper) sdk.Msg {
 				fromAddr, _ := helper.yggVault.PubKey.GetAddress(common.BNBChain)
 				coins := common.Coins{
@@ -329,7 +329,7 @@ func (s *HandlerYggdrasilSuite) TestYggdrasilHandler(c *C) {
 				return NewMsgYggdrasil(tx, helper.yggVault.PubKey, 12, false, coins, helper.nodeAccount.NodeAddress)
 			},
 			runner: func(handler YggdrasilHandler, msg sdk.Msg, helper yggdrasilHandlerTestHelper) sdk.Result {
-				helper.keeper.errGetPool = true
+				helper.keeper.errSlash = true
 				return handler.Run(helper.ctx, msg, constants.SWVersion, helper.constAccessor)
 			},
 			expectedResult: sdk.CodeInternal,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -75,11 +76,12 @@ func getFee(input, output common.Coins, transactionFee int64) common.Fee {
 				break
 			}
 		}
-		if outCoin.IsEmpty() {
-			fee.Coins = append(fee.Coins, common.NewCoin(in.Asset, in.Amount))
-		} else {
-			fee.Coins = append(fee.Coins, common.NewCoin(in.Asset, in.Amount.Sub(outCoin.Amount)))
+		amount := common.SafeSub(in.Amount, outCoin.Amount)
+		// skip coins that are refunded in full, a fee coin must have a positive amount
+		if amount.IsZero() {
+			continue
 		}
+		fee.Coins = append(fee.Coins, common.NewCoin(in.Asset, amount))
 	}
 	fee.PoolDeduct = sdk.NewUint(uint64(transactionFee) * uint64(assetTxCount))
 	return fee
@@ -161,6 +163,73 @@ func getTotalYggValueInRune(ctx sdk.Context, keeper Keeper, ygg Vault) (sdk.Uint
 	return yggRune, nil
 }
 
+// claimNodeBondRewards move the bond rewards accumulated by the given node account into its bond, and reset the accumulator.
+// The caller is responsible to save the node account
+func claimNodeBondRewards(ctx sdk.Context, keeper Keeper, nodeAcc *NodeAccount) error {
+	rewards, err := keeper.GetNodeBondRewards(ctx, nodeAcc.PubKeySet.Secp256k1)
+	if err != nil {
+		return fmt.Errorf("fail to get node bond rewards: %w", err)
+	}
+	if rewards.IsZero() {
+		return nil
+	}
+	nodeAcc.Bond = nodeAcc.Bond.Add(rewards)
+	if err := keeper.SetNodeBondRewards(ctx, nodeAcc.PubKeySet.Secp256k1, sdk.ZeroUint()); err != nil {
+		return fmt.Errorf("fail to reset node bond rewards: %w", err)
+	}
+	return nil
+}
+
+// getNodeBondWithRewards returns the bond of the given node account plus the bond rewards it has accumulated but not
+// claimed yet, the rewards are paid out along with the bond, so they count as bond
+func getNodeBondWithRewards(ctx sdk.Context, keeper Keeper, nodeAcc NodeAccount) (sdk.Uint, error) {
+	rewards, err := keeper.GetNodeBondRewards(ctx, nodeAcc.PubKeySet.Secp256k1)
+	if err != nil {
+		return sdk.ZeroUint(), fmt.Errorf("fail to get node bond rewards: %w", err)
+	}
+	return nodeAcc.Bond.Add(rewards), nil
+}
+
+// sortByBondWithRewards sort the given node accounts by bond plus unclaimed bond rewards, highest first
+func sortByBondWithRewards(ctx sdk.Context, keeper Keeper, nodeAccs NodeAccounts) error {
+	bonds := make(map[string]sdk.Uint, len(nodeAccs))
+	for _, na := range nodeAccs {
+		bond, err := getNodeBondWithRewards(ctx, keeper, na)
+		if err != nil {
+			return err
+		}
+		bonds[na.NodeAddress.String()] = bond
+	}
+	sort.SliceStable(nodeAccs, func(i, j int) bool {
+		return bonds[nodeAccs[i].NodeAddress.String()].GT(bonds[nodeAccs[j].NodeAddress.String()])
+	})
+	return nil
+}
+
+// subtractNodeBond take the given amount of RUNE from the unclaimed bond rewards of the node first, and then from its
+// bond, it returns the amount actually taken. The caller is responsible to save the node account
+func subtractNodeBond(ctx sdk.Context, keeper Keeper, nodeAcc *NodeAccount, amount sdk.Uint) (sdk.Uint, error) {
+	rewards, err := keeper.GetNodeBondRewards(ctx, nodeAcc.PubKeySet.Secp256k1)
+	if err != nil {
+		return sdk.ZeroUint(), fmt.Errorf("fail to get node bond rewards: %w", err)
+	}
+	fromRewards := amount
+	if fromRewards.GT(rewards) {
+		fromRewards = rewards
+	}
+	if !fromRewards.IsZero() {
+		if err := keeper.SetNodeBondRewards(ctx, nodeAcc.PubKeySet.Secp256k1, common.SafeSub(rewards, fromRewards)); err != nil {
+			return sdk.ZeroUint(), fmt.Errorf("fail to save node bond rewards: %w", err)
+		}
+	}
+	fromBond := common.SafeSub(amount, fromRewards)
+	if fromBond.GT(nodeAcc.Bond) {
+		fromBond = nodeAcc.Bond
+	}
+	nodeAcc.Bond = common.SafeSub(nodeAcc.Bond, fromBond)
+	return fromRewards.Add(fromBond), nil
+}
+
 func refundBond(ctx sdk.Context, tx common.Tx, nodeAcc NodeAccount, keeper Keeper, txOut TxOutStore, eventMgr EventManager) error {
 	if nodeAcc.Status == NodeActive {
 		ctx.Logger().Info("node still active , cannot refund bond", "node address", nodeAcc.NodeAddress, "node pub key", nodeAcc.PubKeySet.Secp256k1)
@@ -185,6 +254,11 @@ func refundBond(ctx sdk.Context, tx common.Tx, nodeAcc NodeAccount, keeper Keepe
 		return fmt.Errorf("fail to get total ygg value in RUNE: %w", err)
 	}
 
+	// pay out the bond rewards the node has accumulated, they are refunded along with the bond
+	if err := claimNodeBondRewards(ctx, keeper, &nodeAcc); err != nil {
+		return err
+	}
+
 	if nodeAcc.Bond.LT(yggRune) {
 		ctx.Logger().Error(fmt.Sprintf("Node Account (%s) left with more funds in their Yggdrasil vault than their bond's value (%s / %s)", nodeAcc.NodeAddress, yggRune, nodeAcc.Bond))
 	}
@@ -282,6 +356,28 @@ func isSignedByActiveNodeAccounts(ctx sdk.Context, keeper Keeper, signers []sdk.
 	return true
 }
 
+// getObservedTxConsensus return the observation of the voter the given active node accounts reached consensus on, and
+// whether there is one. Votes are weighted by bond when mimir WeightedVoting is enabled, otherwise every node has one
+func getObservedTxConsensus(ctx sdk.Context, keeper Keeper, voter *ObservedTxVoter, nas NodeAccounts) (ObservedTx, bool) {
+	weightedVoting, err := keeper.GetMimir(ctx, "WeightedVoting")
+	if err != nil || weightedVoting <= 0 {
+		if !voter.HasConsensus(nas) {
+			return ObservedTx{}, false
+		}
+		return voter.GetTx(nas), true
+	}
+	bondByAddress := make(map[string]sdk.Uint, len(nas))
+	totalBond := sdk.ZeroUint()
+	for _, na := range nas {
+		bondByAddress[na.NodeAddress.String()] = na.Bond
+		totalBond = totalBond.Add(na.Bond)
+	}
+	if !voter.GetWeightedConsensus(bondByAddress, totalBond) {
+		return ObservedTx{}, false
+	}
+	return voter.GetWeightedTx(bondByAddress, totalBond), true
+}
+
 func updateEventStatus(ctx sdk.Context, keeper Keeper, eventID int64, txs common.Txs, eventStatus EventStatus) error {
 	event, err := keeper.GetEvent(ctx, eventID)
 	if err != nil {
@@ -393,12 +489,13 @@ func enableNextPool(ctx sdk.Context, keeper Keeper, eventManager EventManager) e
 		}
 	}
 
-	poolEvt := NewEventPool(pool.Asset, PoolEnabled)
+	poolEvt := NewEventPool(pool.Asset, PoolEnabled, pool.BalanceRune, pool.BalanceAsset)
 	if err := eventManager.EmitPoolEvent(ctx, keeper, common.BlankTxID, EventSuccess, poolEvt); err != nil {
 		return fmt.Errorf("fail to emit pool event: %w", err)
 	}
 
 	pool.Status = PoolEnabled
+	keeper.ClearPoolRagnarokInProgress(ctx, pool.Asset)
 	return keeper.SetPool(ctx, pool)
 }
 
@@ -427,7 +524,11 @@ func AddGasFees(ctx sdk.Context, keeper Keeper, tx ObservedTx, gasManager GasMan
 		}
 	}
 
-	gasManager.AddGasAsset(tx.Tx.Gas)
+	for _, coin := range tx.Tx.Gas {
+		if err := gasManager.RecordGasSpent(ctx, coin.Asset.Chain, coin); err != nil {
+			return fmt.Errorf("fail to record gas spent: %w", err)
+		}
+	}
 
 	// Subtract from the vault
 	if keeper.VaultExists(ctx, tx.ObservedPubKey) {
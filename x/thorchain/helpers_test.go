@@ -60,6 +60,10 @@ func (k *TestRefundBondKeeper) SetPool(_ sdk.Context, p Pool) error {
 	return kaboom
 }
 
+func (k *TestRefundBondKeeper) GetNodeBondRewards(_ sdk.Context, _ common.PubKey) (sdk.Uint, error) {
+	return sdk.ZeroUint(), nil
+}
+
 func (k *TestRefundBondKeeper) DeleteVault(_ sdk.Context, key common.PubKey) error {
 	if k.ygg.PubKey.Equals(key) {
 		k.ygg = NewVault(1, InactiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
@@ -243,6 +247,55 @@ func (s *HelperSuite) TestRefundBondHappyPath(c *C) {
 	c.Assert(p.BalanceAsset.Equal(expectedPoolBNB), Equals, true, Commentf("expected BNB in pool %s , however we got %s", expectedPoolBNB, p.BalanceAsset))
 }
 
+func (s *HelperSuite) TestSubtractNodeBond(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetNodeBondRewards(ctx, na.PubKeySet.Secp256k1, sdk.NewUint(10*common.One)), IsNil)
+
+	bond, err := getNodeBondWithRewards(ctx, k, na)
+	c.Assert(err, IsNil)
+	c.Check(bond.Equal(sdk.NewUint(110*common.One)), Equals, true)
+
+	// unclaimed rewards are taken first
+	taken, err := subtractNodeBond(ctx, k, &na, sdk.NewUint(4*common.One))
+	c.Assert(err, IsNil)
+	c.Check(taken.Equal(sdk.NewUint(4*common.One)), Equals, true)
+	c.Check(na.Bond.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	rewards, err := k.GetNodeBondRewards(ctx, na.PubKeySet.Secp256k1)
+	c.Assert(err, IsNil)
+	c.Check(rewards.Equal(sdk.NewUint(6*common.One)), Equals, true)
+
+	// then the bond
+	taken, err = subtractNodeBond(ctx, k, &na, sdk.NewUint(10*common.One))
+	c.Assert(err, IsNil)
+	c.Check(taken.Equal(sdk.NewUint(10*common.One)), Equals, true)
+	c.Check(na.Bond.Equal(sdk.NewUint(96*common.One)), Equals, true)
+	rewards, err = k.GetNodeBondRewards(ctx, na.PubKeySet.Secp256k1)
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+
+	// can't take more than the node has
+	taken, err = subtractNodeBond(ctx, k, &na, sdk.NewUint(200*common.One))
+	c.Assert(err, IsNil)
+	c.Check(taken.Equal(sdk.NewUint(96*common.One)), Equals, true)
+	c.Check(na.Bond.IsZero(), Equals, true)
+}
+
+func (s *HelperSuite) TestSortByBondWithRewards(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na1 := GetRandomNodeAccount(NodeReady)
+	na1.Bond = sdk.NewUint(100 * common.One)
+	na2 := GetRandomNodeAccount(NodeReady)
+	na2.Bond = sdk.NewUint(90 * common.One)
+	c.Assert(k.SetNodeBondRewards(ctx, na2.PubKeySet.Secp256k1, sdk.NewUint(20*common.One)), IsNil)
+
+	nas := NodeAccounts{na1, na2}
+	c.Assert(sortByBondWithRewards(ctx, k, nas), IsNil)
+	c.Check(nas[0].NodeAddress.Equals(na2.NodeAddress), Equals, true)
+	c.Check(nas[1].NodeAddress.Equals(na1.NodeAddress), Equals, true)
+}
+
 func (s *HelperSuite) TestEnableNextPool(c *C) {
 	var err error
 	ctx, k := setupKeeperForTest(c)
@@ -290,10 +343,14 @@ func (s *HelperSuite) TestEnableNextPool(c *C) {
 	pool.BalanceRune = sdk.NewUint(140 * common.One)
 	pool.BalanceAsset = sdk.NewUint(0 * common.One)
 	c.Assert(k.SetPool(ctx, pool), IsNil)
-	// should enable BTC
+	c.Assert(k.SetPoolRagnarokInProgress(ctx, common.BTCAsset), IsNil)
+	// should enable BTC, and clear its ragnarok mark
 	c.Assert(enableNextPool(ctx, k, eventMgr), IsNil)
 	pool, err = k.GetPool(ctx, common.BTCAsset)
 	c.Check(pool.Status, Equals, PoolEnabled)
+	ragnarok, err := k.IsPoolRagnarokInProgress(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, false)
 
 	// should enable ETH
 	c.Assert(enableNextPool(ctx, k, eventMgr), IsNil)
@@ -505,3 +562,47 @@ func (s *HelperSuite) TestAddGasFees(c *C) {
 		}
 	}
 }
+
+func (s *HelperSuite) TestGetFee(c *C) {
+	input := common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100)),
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(100)),
+	}
+	output := common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(60)),
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(100)),
+	}
+	fee := getFee(input, output, 10)
+	c.Assert(fee.Validate(), IsNil)
+	c.Assert(fee.Coins, HasLen, 1)
+	c.Check(fee.Coins[0].Equals(common.NewCoin(common.BNBAsset, sdk.NewUint(40))), Equals, true)
+	c.Check(fee.PoolDeduct.Equal(sdk.NewUint(10)), Equals, true)
+}
+
+func (s *HelperSuite) TestGetObservedTxConsensus(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	whale := GetRandomNodeAccount(NodeActive)
+	whale.Bond = sdk.NewUint(10000 * common.One)
+	nas := NodeAccounts{whale, GetRandomNodeAccount(NodeActive), GetRandomNodeAccount(NodeActive)}
+
+	tx := GetRandomObservedTx()
+	voter := NewObservedTxVoter(tx.Tx.ID, nil)
+	voter.Add(tx, nas[1].NodeAddress)
+	voter.Add(tx, nas[2].NodeAddress)
+
+	// one node one vote
+	consensusTx, ok := getObservedTxConsensus(ctx, k, &voter, nas)
+	c.Check(ok, Equals, true)
+	c.Check(consensusTx.Equals(tx), Equals, true)
+
+	// bond weighted, the two small nodes don't hold 2/3 of the bond
+	k.SetMimir(ctx, "WeightedVoting", 1)
+	voter.Tx = ObservedTx{}
+	_, ok = getObservedTxConsensus(ctx, k, &voter, nas)
+	c.Check(ok, Equals, false)
+
+	voter.Add(tx, whale.NodeAddress)
+	consensusTx, ok = getObservedTxConsensus(ctx, k, &voter, nas)
+	c.Check(ok, Equals, true)
+	c.Check(consensusTx.Equals(tx), Equals, true)
+}
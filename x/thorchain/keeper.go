@@ -46,6 +46,13 @@ type Keeper interface {
 	KeeperBanVoter
 	KeeperSwapQueue
 	KeeperMimir
+	KeeperObservedSlashVoter
+	KeeperObservationHistory
+	KeeperNetworkFee
+	KeeperSlashHistory
+	KeeperChainContract
+	KeeperVersion
+	KeeperMigration
 }
 
 // NOTE: Always end a dbPrefix with a slash ("/"). This is to ensure that there
@@ -60,6 +67,7 @@ const (
 	prefixObservedTx         dbPrefix = "observed_tx/"
 	prefixPool               dbPrefix = "pool/"
 	prefixTxOut              dbPrefix = "txout/"
+	prefixTxOutBySigner      dbPrefix = "txout_signer/"
 	prefixTotalLiquidityFee  dbPrefix = "total_liquidity_fee/"
 	prefixPoolLiquidityFee   dbPrefix = "pool_liquidity_fee/"
 	prefixStaker             dbPrefix = "staker/"
@@ -86,6 +94,22 @@ const (
 	prefixNodeSlashPoints    dbPrefix = "slash/"
 	prefixSwapQueueItem      dbPrefix = "swapitem/"
 	prefixMimir              dbPrefix = "mimir/"
+	prefixSlashVoter         dbPrefix = "slash_voter/"
+	prefixObservationHistory dbPrefix = "observation_history/"
+	prefixEventByStatus      dbPrefix = "event_status/"
+	prefixVaultRuneRank      dbPrefix = "vault_rune_rank/"
+	prefixYggdrasilVault     dbPrefix = "vault_yggdrasil_index/"
+	prefixNetworkFeeVoter    dbPrefix = "network_fee_voter/"
+	prefixNetworkFee         dbPrefix = "network_fee/"
+	prefixSlashHistory       dbPrefix = "slash_history/"
+	prefixPoolVolume         dbPrefix = "pool_volume/"
+	prefixNodeBondRewards    dbPrefix = "node_bond_rewards/"
+	prefixChainContract      dbPrefix = "chain_contract/"
+	prefixOutboundByHeight   dbPrefix = "outbound_height/"
+	prefixPoolRagnarok       dbPrefix = "pool_ragnarok/"
+	prefixThorchainVersion   dbPrefix = "thorchain_version/"
+	prefixNodeAccountBond    dbPrefix = "node_account_bond/"
+	prefixMigration          dbPrefix = "migration/"
 )
 
 func dbError(ctx sdk.Context, wrapper string, err error) error {
@@ -129,7 +153,7 @@ func (k KVStore) Logger(ctx sdk.Context) log.Logger {
 }
 
 func (k KVStore) GetKey(ctx sdk.Context, prefix dbPrefix, key string) string {
-	version := getVersion(k.GetLowestActiveVersion(ctx), prefix)
+	version := getVersion(k.GetThorchainVersion(ctx), prefix)
 	return fmt.Sprintf("%s%d/%s", prefix, version.Minor, strings.ToUpper(key))
 }
 
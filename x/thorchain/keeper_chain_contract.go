@@ -0,0 +1,57 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperChainContract interface {
+	SetChainContract(ctx sdk.Context, contract ChainContract) error
+	GetChainContract(ctx sdk.Context, chain common.Chain) (ChainContract, error)
+	GetAllChainContracts(ctx sdk.Context) (ChainContracts, error)
+}
+
+// SetChainContract save the smart contract of a chain to key value store
+func (k KVStore) SetChainContract(ctx sdk.Context, contract ChainContract) error {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixChainContract, contract.Chain.String())
+	buf, err := k.cdc.MarshalBinaryBare(contract)
+	if err != nil {
+		return dbError(ctx, "fail to marshal chain contract to binary", err)
+	}
+	store.Set([]byte(key), buf)
+	return nil
+}
+
+// GetChainContract get the smart contract of the given chain, an empty
+// ChainContract will be returned when it doesn't exist
+func (k KVStore) GetChainContract(ctx sdk.Context, chain common.Chain) (ChainContract, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixChainContract, chain.String())
+	if !store.Has([]byte(key)) {
+		return ChainContract{}, nil
+	}
+	var contract ChainContract
+	buf := store.Get([]byte(key))
+	if err := k.cdc.UnmarshalBinaryBare(buf, &contract); err != nil {
+		return contract, dbError(ctx, "fail to unmarshal chain contract", err)
+	}
+	return contract, nil
+}
+
+// GetAllChainContracts get the smart contracts of all chains
+func (k KVStore) GetAllChainContracts(ctx sdk.Context) (ChainContracts, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(prefixChainContract))
+	defer iter.Close()
+	contracts := make(ChainContracts, 0)
+	for ; iter.Valid(); iter.Next() {
+		var contract ChainContract
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &contract); err != nil {
+			return nil, dbError(ctx, "fail to unmarshal chain contract", err)
+		}
+		contracts = append(contracts, contract)
+	}
+	return contracts, nil
+}
@@ -0,0 +1,41 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperChainContractSuite struct{}
+
+var _ = Suite(&KeeperChainContractSuite{})
+
+func (s *KeeperChainContractSuite) TestChainContract(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	contract, err := k.GetChainContract(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(contract.IsEmpty(), Equals, true)
+	contracts, err := k.GetAllChainContracts(ctx)
+	c.Assert(err, IsNil)
+	c.Check(contracts, HasLen, 0)
+
+	addr := common.Address("0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473")
+	c.Assert(k.SetChainContract(ctx, NewChainContract(common.ETHChain, addr, "[]")), IsNil)
+	contract, err = k.GetChainContract(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(contract.Chain.Equals(common.ETHChain), Equals, true)
+	c.Check(contract.ContractAddress.Equals(addr), Equals, true)
+	c.Check(contract.ABI, Equals, "[]")
+
+	// update the contract address
+	newAddr := common.Address("0xE65e9d372F8cAcc7b6dfcd4af6507851Ed31bb44")
+	c.Assert(k.SetChainContract(ctx, NewChainContract(common.ETHChain, newAddr, "[]")), IsNil)
+	contract, err = k.GetChainContract(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(contract.ContractAddress.Equals(newAddr), Equals, true)
+	contracts, err = k.GetAllChainContracts(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(contracts, HasLen, 1)
+	c.Check(contracts[0].ContractAddress.Equals(newAddr), Equals, true)
+}
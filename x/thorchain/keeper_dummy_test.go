@@ -67,6 +67,13 @@ func (k KVStoreDummy) GetRagnarokBlockHeight(_ sdk.Context) (int64, error) {
 }
 func (k KVStoreDummy) SetRagnarokBlockHeight(_ sdk.Context, _ int64) {}
 func (k KVStoreDummy) RagnarokInProgress(_ sdk.Context) bool         { return false }
+func (k KVStoreDummy) SetPoolRagnarokInProgress(_ sdk.Context, _ common.Asset) error {
+	return kaboom
+}
+func (k KVStoreDummy) IsPoolRagnarokInProgress(_ sdk.Context, _ common.Asset) (bool, error) {
+	return false, nil
+}
+func (k KVStoreDummy) ClearPoolRagnarokInProgress(_ sdk.Context, _ common.Asset) {}
 func (k KVStoreDummy) GetPoolBalances(_ sdk.Context, _, _ common.Asset) (sdk.Uint, sdk.Uint) {
 	return sdk.ZeroUint(), sdk.ZeroUint()
 }
@@ -81,9 +88,24 @@ func (k KVStoreDummy) EnableAPool(_ sdk.Context)                               {
 func (k KVStoreDummy) GetPool(_ sdk.Context, _ common.Asset) (Pool, error) {
 	return Pool{}, kaboom
 }
-func (k KVStoreDummy) GetPools(_ sdk.Context) (Pools, error)                        { return nil, kaboom }
-func (k KVStoreDummy) SetPool(_ sdk.Context, _ Pool) error                          { return kaboom }
-func (k KVStoreDummy) PoolExist(_ sdk.Context, _ common.Asset) bool                 { return false }
+func (k KVStoreDummy) GetPools(_ sdk.Context) (Pools, error)          { return nil, kaboom }
+func (k KVStoreDummy) GetAllPoolsSorted(_ sdk.Context) (Pools, error) { return nil, kaboom }
+func (k KVStoreDummy) SetPool(_ sdk.Context, _ Pool) error            { return kaboom }
+func (k KVStoreDummy) PoolExist(_ sdk.Context, _ common.Asset) bool   { return false }
+func (k KVStoreDummy) RecordSwapVolume(_ sdk.Context, _ common.Asset, _ sdk.Uint, _ int64) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetPoolHistoricalVolume(_ sdk.Context, _ common.Asset, _, _ int64) (sdk.Uint, error) {
+	return sdk.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) GetPool24hVolume(_ sdk.Context, _ common.Asset) (sdk.Uint, error) {
+	return sdk.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) GetPoolCyclePoint(_ sdk.Context) (int64, error) { return 0, kaboom }
+func (k KVStoreDummy) IsPoolCycleEnd(_ sdk.Context) (bool, error)     { return false, kaboom }
+func (k KVStoreDummy) ComputePoolDepthInRune(_ sdk.Context, _ common.Asset) (sdk.Uint, error) {
+	return sdk.ZeroUint(), kaboom
+}
 func (k KVStoreDummy) GetStakerIterator(_ sdk.Context, _ common.Asset) sdk.Iterator { return nil }
 func (k KVStoreDummy) GetStaker(_ sdk.Context, _ common.Asset, _ common.Address) (Staker, error) {
 	return Staker{}, kaboom
@@ -104,6 +126,11 @@ func (k KVStoreDummy) ListActiveNodeAccounts(_ sdk.Context) (NodeAccounts, error
 }
 func (k KVStoreDummy) GetLowestActiveVersion(_ sdk.Context) semver.Version { return semver.Version{} }
 func (k KVStoreDummy) GetMinJoinVersion(_ sdk.Context) semver.Version      { return semver.Version{} }
+func (k KVStoreDummy) SetThorchainVersion(_ sdk.Context, _ semver.Version) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetThorchainVersion(_ sdk.Context) semver.Version { return semver.Version{} }
+func (k KVStoreDummy) RunMigrations(_ sdk.Context) error                { return kaboom }
 func (k KVStoreDummy) GetNodeAccount(_ sdk.Context, _ sdk.AccAddress) (NodeAccount, error) {
 	return NodeAccount{}, kaboom
 }
@@ -115,6 +142,9 @@ func (k KVStoreDummy) GetNodeAccountByPubKey(_ sdk.Context, _ common.PubKey) (No
 func (k KVStoreDummy) GetNodeAccountByBondAddress(_ sdk.Context, _ common.Address) (NodeAccount, error) {
 	return NodeAccount{}, kaboom
 }
+func (k KVStoreDummy) GetNodeAccountsByBond(_ sdk.Context, _, _ sdk.Uint) (NodeAccounts, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) SetNodeAccount(_ sdk.Context, _ NodeAccount) error { return kaboom }
 func (k KVStoreDummy) EnsureNodeKeysUnique(_ sdk.Context, _ string, _ common.PubKeySet) error {
 	return kaboom
@@ -129,10 +159,25 @@ func (k KVStoreDummy) ResetNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddres
 func (k KVStoreDummy) IncNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error {
 	return kaboom
 }
+func (k KVStoreDummy) RecordSlash(_ sdk.Context, _ sdk.AccAddress, _ string, _ int64) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetNodeAccountSlashHistory(_ sdk.Context, _ sdk.AccAddress) (SlashRecords, error) {
+	return nil, kaboom
+}
 
 func (k KVStoreDummy) DecNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error {
 	return kaboom
 }
+func (k KVStoreDummy) GetNodeBondRewards(_ sdk.Context, _ common.PubKey) (sdk.Uint, error) {
+	return sdk.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) SetNodeBondRewards(_ sdk.Context, _ common.PubKey, _ sdk.Uint) error {
+	return kaboom
+}
+func (k KVStoreDummy) SlashNodeAccount(_ sdk.Context, _ common.PubKey, _ common.Asset, _ sdk.Uint, _ EventManager) error {
+	return kaboom
+}
 func (k KVStoreDummy) SetActiveObserver(_ sdk.Context, _ sdk.AccAddress)     {}
 func (k KVStoreDummy) RemoveActiveObserver(_ sdk.Context, _ sdk.AccAddress)  {}
 func (k KVStoreDummy) IsActiveObserver(_ sdk.Context, _ sdk.AccAddress) bool { return false }
@@ -146,6 +191,18 @@ func (k KVStoreDummy) GetObservedTxVoterIterator(_ sdk.Context) sdk.Iterator
 func (k KVStoreDummy) GetObservedTxVoter(_ sdk.Context, _ common.TxID) (ObservedTxVoter, error) {
 	return ObservedTxVoter{}, kaboom
 }
+func (k KVStoreDummy) AddObservedOutboundTx(_ sdk.Context, _ int64, _ common.TxID) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetObservedOutboundTxByHeight(_ sdk.Context, _ int64) (common.TxIDs, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) PruneObservedOutboundTx(_ sdk.Context, _ int64) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetMissingOutbounds(_ sdk.Context, _ int64, _ []TxOutItem) ([]TxOutItem, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) SetTssVoter(_ sdk.Context, _ TssVoter)          {}
 func (k KVStoreDummy) GetTssVoterIterator(_ sdk.Context) sdk.Iterator { return nil }
 func (k KVStoreDummy) GetTssVoter(_ sdk.Context, _ string) (TssVoter, error) {
@@ -161,6 +218,9 @@ func (k KVStoreDummy) GetTxOut(_ sdk.Context, _ int64) (*TxOut, error)        {
 func (k KVStoreDummy) SetTxOut(_ sdk.Context, _ *TxOut) error                 { return kaboom }
 func (k KVStoreDummy) AppendTxOut(_ sdk.Context, _ int64, _ *TxOutItem) error { return kaboom }
 func (k KVStoreDummy) GetTxOutIterator(_ sdk.Context) sdk.Iterator            { return nil }
+func (k KVStoreDummy) GetTxOutsForSigner(_ sdk.Context, _ common.PubKey, _ semver.Version) ([]TxOutItem, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) AddToLiquidityFees(_ sdk.Context, _ common.Asset, _ sdk.Uint) error {
 	return kaboom
 }
@@ -183,6 +243,13 @@ func (k KVStoreDummy) GetPendingEventID(_ sdk.Context, _ common.TxID) ([]int64,
 func (k KVStoreDummy) GetEventsIDByTxHash(ctx sdk.Context, txID common.TxID) ([]int64, error) {
 	return nil, kaboom
 }
+
+func (k KVStoreDummy) GetEventsByInTxID(_ sdk.Context, _ common.TxID) (Events, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetEventsByStatus(_ sdk.Context, _ EventStatus) (Events, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) GetCurrentEventID(_ sdk.Context) (int64, error)    { return 0, kaboom }
 func (k KVStoreDummy) SetCurrentEventID(_ sdk.Context, _ int64)          {}
 func (k KVStoreDummy) GetAllPendingEvents(_ sdk.Context) (Events, error) { return nil, kaboom }
@@ -195,6 +262,22 @@ func (k KVStoreDummy) FindPubKeyOfAddress(_ sdk.Context, _ common.Address, _ com
 	return common.EmptyPubKey, kaboom
 }
 func (k KVStoreDummy) SetVault(_ sdk.Context, _ Vault) error { return kaboom }
+func (k KVStoreDummy) SetVaultStatus(_ sdk.Context, _ common.PubKey, _ VaultStatus, _ int64) error {
+	return kaboom
+}
+func (k KVStoreDummy) TransferFunds(_ sdk.Context, _, _ common.PubKey, _ common.Coin) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetAsgardVaultWithMostRune(_ sdk.Context) (Vault, error) {
+	return Vault{}, kaboom
+}
+func (k KVStoreDummy) GetYggdrasilVaults(_ sdk.Context) (Vaults, error) { return nil, kaboom }
+func (k KVStoreDummy) GetYggdrasilVaultByPubKey(_ sdk.Context, _ common.PubKey) (Vault, error) {
+	return Vault{}, kaboom
+}
+func (k KVStoreDummy) GetTotalYggdrasilBalance(_ sdk.Context, _ common.Asset) (sdk.Uint, error) {
+	return sdk.ZeroUint(), kaboom
+}
 func (k KVStoreDummy) GetVault(_ sdk.Context, _ common.PubKey) (Vault, error) {
 	return Vault{}, kaboom
 }
@@ -212,10 +295,13 @@ func (k KVStoreDummy) SetReserveContributors(_ sdk.Context, _ ReserveContributor
 	return kaboom
 }
 
-func (k KVStoreDummy) HasValidVaultPools(_ sdk.Context) (bool, error)  { return false, kaboom }
-func (k KVStoreDummy) AddFeeToReserve(_ sdk.Context, _ sdk.Uint) error { return kaboom }
-func (k KVStoreDummy) GetVaultData(_ sdk.Context) (VaultData, error)   { return VaultData{}, kaboom }
-func (k KVStoreDummy) SetVaultData(_ sdk.Context, _ VaultData) error   { return kaboom }
+func (k KVStoreDummy) HasValidVaultPools(_ sdk.Context) (bool, error)    { return false, kaboom }
+func (k KVStoreDummy) AddFeeToReserve(_ sdk.Context, _ sdk.Uint) error   { return kaboom }
+func (k KVStoreDummy) GetReserveBalance(_ sdk.Context) sdk.Uint          { return sdk.ZeroUint() }
+func (k KVStoreDummy) AddToReserve(_ sdk.Context, _ sdk.Uint) error      { return kaboom }
+func (k KVStoreDummy) DeductFromReserve(_ sdk.Context, _ sdk.Uint) error { return kaboom }
+func (k KVStoreDummy) GetVaultData(_ sdk.Context) (VaultData, error)     { return VaultData{}, kaboom }
+func (k KVStoreDummy) SetVaultData(_ sdk.Context, _ VaultData) error     { return kaboom }
 func (k KVStoreDummy) UpdateVaultData(_ sdk.Context, _ constants.ConstantValues, gasManager GasManager, manager EventManager) error {
 	return kaboom
 }
@@ -248,7 +334,40 @@ func (k KVStoreDummy) GetErrataTxVoterIterator(_ sdk.Context) sdk.Iterator { ret
 func (k KVStoreDummy) GetErrataTxVoter(_ sdk.Context, _ common.TxID, _ common.Chain) (ErrataTxVoter, error) {
 	return ErrataTxVoter{}, kaboom
 }
-func (k KVStoreDummy) SetBanVoter(_ sdk.Context, _ BanVoter) {}
+
+func (k KVStoreDummy) AddObservation(_ sdk.Context, _ ObservedTx, _ sdk.AccAddress) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetObservationHistory(_ sdk.Context, _, _ int64) (ObservedTxs, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) PruneObservationHistory(_ sdk.Context, _ int64) error      { return kaboom }
+func (k KVStoreDummy) SetObservedSlashVoter(_ sdk.Context, _ ObservedSlashVoter) {}
+func (k KVStoreDummy) GetObservedSlashVoterIterator(_ sdk.Context) sdk.Iterator  { return nil }
+func (k KVStoreDummy) GetObservedSlashVoter(_ sdk.Context, _ sdk.AccAddress, _ int64, _ string, _ sdk.Uint) (ObservedSlashVoter, error) {
+	return ObservedSlashVoter{}, kaboom
+}
+func (k KVStoreDummy) SetNetworkFeeVoter(_ sdk.Context, _ NetworkFeeVoter) error { return kaboom }
+func (k KVStoreDummy) GetNetworkFeeVoter(_ sdk.Context, _ common.Chain, _ int64, _ sdk.Uint) (NetworkFeeVoter, error) {
+	return NetworkFeeVoter{}, kaboom
+}
+func (k KVStoreDummy) GetNetworkFeeVoters(_ sdk.Context, _ common.Chain, _ int64) ([]NetworkFeeVoter, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) PruneNetworkFeeVoters(_ sdk.Context, _ common.Chain, _ int64) error {
+	return kaboom
+}
+func (k KVStoreDummy) SaveNetworkFee(_ sdk.Context, _ common.Chain, _ sdk.Uint) error { return kaboom }
+func (k KVStoreDummy) GetNetworkFee(_ sdk.Context, _ common.Chain) (sdk.Uint, error) {
+	return sdk.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) GetNetworkFeeIterator(_ sdk.Context) sdk.Iterator      { return nil }
+func (k KVStoreDummy) SetChainContract(_ sdk.Context, _ ChainContract) error { return kaboom }
+func (k KVStoreDummy) GetChainContract(_ sdk.Context, _ common.Chain) (ChainContract, error) {
+	return ChainContract{}, kaboom
+}
+func (k KVStoreDummy) GetAllChainContracts(_ sdk.Context) (ChainContracts, error) { return nil, kaboom }
+func (k KVStoreDummy) SetBanVoter(_ sdk.Context, _ BanVoter)                      {}
 func (k KVStoreDummy) GetBanVoter(_ sdk.Context, _ sdk.AccAddress) (BanVoter, error) {
 	return BanVoter{}, kaboom
 }
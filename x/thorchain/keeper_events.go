@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -19,6 +20,8 @@ type KeeperEvents interface {
 	SetCurrentEventID(ctx sdk.Context, eventID int64)
 	GetAllPendingEvents(ctx sdk.Context) (Events, error)
 	GetEventsIDByTxHash(ctx sdk.Context, txID common.TxID) ([]int64, error)
+	GetEventsByInTxID(ctx sdk.Context, txID common.TxID) (Events, error)
+	GetEventsByStatus(ctx sdk.Context, status EventStatus) (Events, error)
 }
 
 var ErrEventNotFound = errors.New("event not found")
@@ -57,11 +60,23 @@ func (k KVStore) UpsertEvent(ctx sdk.Context, event Event) error {
 
 	key := k.GetKey(ctx, prefixEvents, strconv.FormatInt(event.ID, 10))
 	store := ctx.KVStore(k.storeKey)
+	// the status index is only maintained from version 0.3.0, the events saved before are indexed by a store migration
+	index := k.GetThorchainVersion(ctx).GTE(semver.MustParse("0.3.0"))
+	if index && store.Has([]byte(key)) {
+		old, err := k.GetEvent(ctx, event.ID)
+		if err != nil {
+			return fmt.Errorf("fail to get existing event: %w", err)
+		}
+		k.removeEventStatusIndex(ctx, old)
+	}
 	buf, err := k.cdc.MarshalBinaryBare(&event)
 	if err != nil {
 		return fmt.Errorf("fail to marshal event: %w", err)
 	}
 	store.Set([]byte(key), buf)
+	if index {
+		k.setEventStatusIndex(ctx, event)
+	}
 	if event.Status == EventPending {
 		return k.setEventPending(ctx, event)
 	}
@@ -69,6 +84,21 @@ func (k KVStore) UpsertEvent(ctx sdk.Context, event Event) error {
 	return nil
 }
 
+func (k KVStore) getEventStatusIndexKey(ctx sdk.Context, event Event) string {
+	return k.GetKey(ctx, prefixEventByStatus, fmt.Sprintf("%s/%020d", event.Status, event.ID))
+}
+
+// setEventStatusIndex index the event id by the status of the event
+func (k KVStore) setEventStatusIndex(ctx sdk.Context, event Event) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getEventStatusIndexKey(ctx, event)), k.cdc.MustMarshalBinaryBare(event.ID))
+}
+
+func (k KVStore) removeEventStatusIndex(ctx sdk.Context, event Event) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(k.getEventStatusIndexKey(ctx, event)))
+}
+
 func (k KVStore) removeEventPending(ctx sdk.Context, event Event) {
 	key := k.GetKey(ctx, prefixPendingEvents, event.InTx.ID.String())
 	store := ctx.KVStore(k.storeKey)
@@ -193,6 +223,26 @@ func (k KVStore) GetEventsIDByTxHash(ctx sdk.Context, txID common.TxID) ([]int64
 	return eventIDs, nil
 }
 
+// GetEventsByInTxID given an inbound tx id, return all the events that is related to the tx
+func (k KVStore) GetEventsByInTxID(ctx sdk.Context, txID common.TxID) (Events, error) {
+	eventIDs, err := k.GetEventsIDByTxHash(ctx, txID)
+	if err != nil {
+		if errors.Is(err, ErrEventNotFound) {
+			return Events{}, nil
+		}
+		return nil, fmt.Errorf("fail to get events id by tx hash id: %w", err)
+	}
+	events := make(Events, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		evt, err := k.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get event: %w", err)
+		}
+		events = append(events, evt)
+	}
+	return events, nil
+}
+
 func (k KVStore) upsertEventTxHash(ctx sdk.Context, event Event) error {
 	key := k.GetKey(ctx, prefixTxHashEvents, event.InTx.ID.String())
 	store := ctx.KVStore(k.storeKey)
@@ -208,3 +258,25 @@ func (k KVStore) upsertEventTxHash(ctx sdk.Context, event Event) error {
 	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(eventIDs))
 	return nil
 }
+
+// GetEventsByStatus return all the events in the given status, ordered by event id. The status index it reads is only
+// maintained from version 0.3.0
+func (k KVStore) GetEventsByStatus(ctx sdk.Context, status EventStatus) (Events, error) {
+	key := k.GetKey(ctx, prefixEventByStatus, status.String()+"/")
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(key))
+	defer iter.Close()
+	events := Events{}
+	for ; iter.Valid(); iter.Next() {
+		var eventID int64
+		if err := k.Cdc().UnmarshalBinaryBare(iter.Value(), &eventID); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal event id: %w", err)
+		}
+		evt, err := k.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get event: %w", err)
+		}
+		events = append(events, evt)
+	}
+	return events, nil
+}
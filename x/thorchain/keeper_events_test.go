@@ -7,6 +7,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperEventsSuite struct{}
@@ -34,6 +35,7 @@ func (s *KeeperEventsSuite) TestEvents(c *C) {
 		sdk.NewUint(5),
 		sdk.NewUint(5),
 		sdk.NewUint(5),
+		false,
 		inTx,
 	)
 	swapBytes, _ := json.Marshal(swap)
@@ -63,3 +65,60 @@ func (s *KeeperEventsSuite) TestEvents(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(e.Empty(), Equals, true)
 }
+
+func (s *KeeperEventsSuite) TestGetEventsByInTxID(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	inTx := GetRandomTx()
+	events, err := k.GetEventsByInTxID(ctx, inTx.ID)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 0)
+
+	c.Assert(k.UpsertEvent(ctx, NewEvent("swap", 12, inTx, nil, EventPending)), IsNil)
+	c.Assert(k.UpsertEvent(ctx, NewEvent("swap", 12, inTx, nil, EventSuccess)), IsNil)
+	c.Assert(k.UpsertEvent(ctx, NewEvent("swap", 12, GetRandomTx(), nil, EventPending)), IsNil)
+	events, err = k.GetEventsByInTxID(ctx, inTx.ID)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 2)
+	c.Assert(events.FilterByStatus(EventPending), HasLen, 1)
+	c.Assert(events.FilterByStatus(EventSuccess), HasLen, 1)
+}
+
+func (s *KeeperEventsSuite) TestGetEventsByStatus(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	for i := 0; i < 3; i++ {
+		evt := NewEvent(EventRefund{}.Type(), 12, GetRandomTx(), nil, EventPending)
+		c.Assert(k.UpsertEvent(ctx, evt), IsNil)
+	}
+	evt := NewEvent(EventRefund{}.Type(), 12, GetRandomTx(), nil, EventSuccess)
+	c.Assert(k.UpsertEvent(ctx, evt), IsNil)
+
+	pending, err := k.GetEventsByStatus(ctx, EventPending)
+	c.Assert(err, IsNil)
+	c.Assert(pending, HasLen, 3)
+	c.Check(pending[0].ID, Equals, int64(1))
+	c.Check(pending[2].ID, Equals, int64(3))
+	success, err := k.GetEventsByStatus(ctx, EventSuccess)
+	c.Assert(err, IsNil)
+	c.Assert(success, HasLen, 1)
+	c.Check(success[0].ID, Equals, int64(4))
+
+	// move one pending event to success
+	evt = pending[1]
+	evt.Status = EventSuccess
+	c.Assert(k.UpsertEvent(ctx, evt), IsNil)
+	pending, err = k.GetEventsByStatus(ctx, EventPending)
+	c.Assert(err, IsNil)
+	c.Assert(pending, HasLen, 2)
+	c.Check(pending[0].ID, Equals, int64(1))
+	c.Check(pending[1].ID, Equals, int64(3))
+	success, err = k.GetEventsByStatus(ctx, EventSuccess)
+	c.Assert(err, IsNil)
+	c.Assert(success, HasLen, 2)
+	c.Check(success[0].ID, Equals, int64(2))
+	c.Check(success[0].Status, Equals, EventSuccess)
+
+	failed, err := k.GetEventsByStatus(ctx, EventFail)
+	c.Assert(err, IsNil)
+	c.Assert(failed, HasLen, 0)
+}
@@ -0,0 +1,118 @@
+package thorchain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type KeeperMigration interface {
+	RunMigrations(ctx sdk.Context) error
+}
+
+// migrations backfill the indexes that were added after the store already had data, each of them runs once, in
+// order. Never remove or reorder them, append new ones at the end
+var migrations = []func(k KVStore, ctx sdk.Context) error{
+	KVStore.backfillYggdrasilVaultIndex,
+	KVStore.backfillVaultRuneRank,
+	KVStore.backfillEventStatusIndex,
+	KVStore.backfillNodeAccountBondIndex,
+}
+
+// RunMigrations run the migrations that haven't been run yet, and save the number of migrations done
+func (k KVStore) RunMigrations(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(k.GetKey(ctx, prefixMigration, ""))
+	var done int64
+	if store.Has(key) {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &done); err != nil {
+			return dbError(ctx, "Unmarshal: migration", err)
+		}
+	}
+	for i := done; i < int64(len(migrations)); i++ {
+		if err := migrations[i](k, ctx); err != nil {
+			return fmt.Errorf("fail to run migration %d: %w", i+1, err)
+		}
+		store.Set(key, k.cdc.MustMarshalBinaryBare(i+1))
+	}
+	return nil
+}
+
+// getAllVaults return all the vaults in the store
+func (k KVStore) getAllVaults(ctx sdk.Context) (Vaults, error) {
+	iter := k.GetVaultIterator(ctx)
+	defer iter.Close()
+	var vaults Vaults
+	for ; iter.Valid(); iter.Next() {
+		var vault Vault
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &vault); err != nil {
+			return nil, dbError(ctx, "Unmarshal: vault", err)
+		}
+		vaults = append(vaults, vault)
+	}
+	return vaults, nil
+}
+
+// backfillYggdrasilVaultIndex add the yggdrasil vaults saved before SetVault indexed them
+func (k KVStore) backfillYggdrasilVaultIndex(ctx sdk.Context) error {
+	vaults, err := k.getAllVaults(ctx)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, vault := range vaults {
+		if vault.IsYggdrasil() {
+			store.Set([]byte(k.GetKey(ctx, prefixYggdrasilVault, vault.PubKey.String())), k.cdc.MustMarshalBinaryBare(vault.PubKey))
+		}
+	}
+	return nil
+}
+
+// backfillVaultRuneRank rank the active asgard vaults saved before SetVault ranked them
+func (k KVStore) backfillVaultRuneRank(ctx sdk.Context) error {
+	vaults, err := k.getAllVaults(ctx)
+	if err != nil {
+		return err
+	}
+	for _, vault := range vaults {
+		k.setVaultRuneRank(ctx, vault)
+	}
+	return nil
+}
+
+// backfillEventStatusIndex index the events saved before UpsertEvent indexed them by status
+func (k KVStore) backfillEventStatusIndex(ctx sdk.Context) error {
+	iter := k.GetEventsIterator(ctx)
+	defer iter.Close()
+	var events Events
+	for ; iter.Valid(); iter.Next() {
+		var evt Event
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &evt); err != nil {
+			return dbError(ctx, "Unmarshal: event", err)
+		}
+		events = append(events, evt)
+	}
+	for _, evt := range events {
+		k.setEventStatusIndex(ctx, evt)
+	}
+	return nil
+}
+
+// backfillNodeAccountBondIndex index the node accounts saved before SetNodeAccount indexed them by bond
+func (k KVStore) backfillNodeAccountBondIndex(ctx sdk.Context) error {
+	iter := k.GetNodeAccountIterator(ctx)
+	defer iter.Close()
+	var nodeAccounts NodeAccounts
+	for ; iter.Valid(); iter.Next() {
+		var na NodeAccount
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &na); err != nil {
+			return dbError(ctx, "Unmarshal: node account", err)
+		}
+		nodeAccounts = append(nodeAccounts, na)
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, na := range nodeAccounts {
+		store.Set(k.getNodeAccountBondKey(ctx, na.Bond, na.NodeAddress), k.cdc.MustMarshalBinaryBare(na.NodeAddress))
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type KeeperMigrationSuite struct{}
+
+var _ = Suite(&KeeperMigrationSuite{})
+
+func (s *KeeperMigrationSuite) TestBackfillYggdrasilVaultIndex(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	k, ok := keeper.(KVStore)
+	c.Assert(ok, Equals, true)
+	// vaults saved before version 0.3.0 are not indexed
+	c.Assert(k.SetThorchainVersion(ctx, semver.MustParse("0.2.0")), IsNil)
+	ygg := GetRandomVault()
+	ygg.Type = YggdrasilVault
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+	asgard := GetRandomVault()
+	asgard.Type = AsgardVault
+	c.Assert(k.SetVault(ctx, asgard), IsNil)
+	yggs, err := k.GetYggdrasilVaults(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(yggs, HasLen, 0)
+
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	c.Assert(k.RunMigrations(ctx), IsNil)
+	yggs, err = k.GetYggdrasilVaults(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(yggs, HasLen, 1)
+	c.Check(yggs[0].PubKey.Equals(ygg.PubKey), Equals, true)
+
+	// migrations only run once
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(k.GetKey(ctx, prefixYggdrasilVault, ygg.PubKey.String())))
+	c.Assert(k.RunMigrations(ctx), IsNil)
+	yggs, err = k.GetYggdrasilVaults(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(yggs, HasLen, 0)
+}
+
+func (s *KeeperMigrationSuite) TestBackfillVaultRuneRank(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	k, ok := keeper.(KVStore)
+	c.Assert(ok, Equals, true)
+	// vaults saved before version 0.3.0 are not ranked
+	c.Assert(k.SetThorchainVersion(ctx, semver.MustParse("0.2.0")), IsNil)
+	asgard := GetRandomVault()
+	asgard.Type = AsgardVault
+	asgard.Status = ActiveVault
+	asgard.AddFunds(common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))})
+	c.Assert(k.SetVault(ctx, asgard), IsNil)
+	_, err := k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, NotNil)
+
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	c.Assert(k.RunMigrations(ctx), IsNil)
+	vault, err := k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.Equals(asgard.PubKey), Equals, true)
+}
+
+func (s *KeeperMigrationSuite) TestBackfillEventStatusIndex(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	k, ok := keeper.(KVStore)
+	c.Assert(ok, Equals, true)
+	// events saved before version 0.3.0 are not indexed
+	c.Assert(k.SetThorchainVersion(ctx, semver.MustParse("0.2.0")), IsNil)
+	evt := NewEvent(EventRefund{}.Type(), 12, GetRandomTx(), nil, EventPending)
+	c.Assert(k.UpsertEvent(ctx, evt), IsNil)
+	pending, err := k.GetEventsByStatus(ctx, EventPending)
+	c.Assert(err, IsNil)
+	c.Assert(pending, HasLen, 0)
+
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	c.Assert(k.RunMigrations(ctx), IsNil)
+	pending, err = k.GetEventsByStatus(ctx, EventPending)
+	c.Assert(err, IsNil)
+	c.Assert(pending, HasLen, 1)
+	c.Check(pending[0].InTx.ID.Equals(evt.InTx.ID), Equals, true)
+}
+
+func (s *KeeperMigrationSuite) TestBackfillNodeAccountBondIndex(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	k, ok := keeper.(KVStore)
+	c.Assert(ok, Equals, true)
+	// node accounts saved before version 0.3.0 are not indexed
+	c.Assert(k.SetThorchainVersion(ctx, semver.MustParse("0.2.0")), IsNil)
+	na := GetRandomNodeAccount(NodeStandby)
+	na.Bond = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	nas, err := k.GetNodeAccountsByBond(ctx, sdk.ZeroUint(), na.Bond)
+	c.Assert(err, IsNil)
+	c.Assert(nas, HasLen, 0)
+
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	c.Assert(k.RunMigrations(ctx), IsNil)
+	nas, err = k.GetNodeAccountsByBond(ctx, sdk.ZeroUint(), na.Bond)
+	c.Assert(err, IsNil)
+	c.Assert(nas, HasLen, 1)
+	c.Check(nas[0].NodeAddress.Equals(na.NodeAddress), Equals, true)
+}
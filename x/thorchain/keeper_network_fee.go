@@ -0,0 +1,120 @@
+package thorchain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperNetworkFee interface {
+	SetNetworkFeeVoter(ctx sdk.Context, voter NetworkFeeVoter) error
+	GetNetworkFeeVoter(ctx sdk.Context, chain common.Chain, height int64, fee sdk.Uint) (NetworkFeeVoter, error)
+	GetNetworkFeeVoters(ctx sdk.Context, chain common.Chain, height int64) ([]NetworkFeeVoter, error)
+	PruneNetworkFeeVoters(ctx sdk.Context, chain common.Chain, height int64) error
+	SaveNetworkFee(ctx sdk.Context, chain common.Chain, fee sdk.Uint) error
+	GetNetworkFee(ctx sdk.Context, chain common.Chain) (sdk.Uint, error)
+	GetNetworkFeeIterator(ctx sdk.Context) sdk.Iterator
+}
+
+// SetNetworkFeeVoter - save a network fee voter object
+func (k KVStore) SetNetworkFeeVoter(ctx sdk.Context, voter NetworkFeeVoter) error {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixNetworkFeeVoter, voter.String())
+	buf, err := k.cdc.MarshalBinaryBare(voter)
+	if err != nil {
+		return dbError(ctx, "fail to marshal network fee voter to binary", err)
+	}
+	store.Set([]byte(key), buf)
+	return nil
+}
+
+// GetNetworkFeeVoter - gets the voter of the given network fee of the chain at the given block height
+func (k KVStore) GetNetworkFeeVoter(ctx sdk.Context, chain common.Chain, height int64, fee sdk.Uint) (NetworkFeeVoter, error) {
+	voter := NewNetworkFeeVoter(chain, height, fee)
+	key := k.GetKey(ctx, prefixNetworkFeeVoter, voter.String())
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return voter, nil
+	}
+
+	buf := store.Get([]byte(key))
+	var record NetworkFeeVoter
+	if err := k.cdc.UnmarshalBinaryBare(buf, &record); err != nil {
+		return voter, dbError(ctx, "Unmarshal: network fee voter", err)
+	}
+	return record, nil
+}
+
+// GetNetworkFeeVoters - gets all the voters of the network fee of the chain at the given block height, one per voted fee
+func (k KVStore) GetNetworkFeeVoters(ctx sdk.Context, chain common.Chain, height int64) ([]NetworkFeeVoter, error) {
+	key := k.GetKey(ctx, prefixNetworkFeeVoter, fmt.Sprintf("%s-%d-", chain, height))
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(key))
+	defer iter.Close()
+	var voters []NetworkFeeVoter
+	for ; iter.Valid(); iter.Next() {
+		var voter NetworkFeeVoter
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &voter); err != nil {
+			return nil, dbError(ctx, "Unmarshal: network fee voter", err)
+		}
+		voters = append(voters, voter)
+	}
+	return voters, nil
+}
+
+// PruneNetworkFeeVoters - remove all the voters of the network fee of the chain observed before the given block height
+func (k KVStore) PruneNetworkFeeVoters(ctx sdk.Context, chain common.Chain, height int64) error {
+	prefix := k.GetKey(ctx, prefixNetworkFeeVoter, fmt.Sprintf("%s-", chain))
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(prefix))
+	defer iter.Close()
+	var toDelete [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var voter NetworkFeeVoter
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &voter); err != nil {
+			return dbError(ctx, "Unmarshal: network fee voter", err)
+		}
+		if voter.BlockHeight < height {
+			toDelete = append(toDelete, append([]byte{}, iter.Key()...))
+		}
+	}
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// SaveNetworkFee save the network fee of the given chain agreed by the active validators
+func (k KVStore) SaveNetworkFee(ctx sdk.Context, chain common.Chain, fee sdk.Uint) error {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixNetworkFee, chain.String())
+	buf, err := k.cdc.MarshalBinaryBare(fee)
+	if err != nil {
+		return dbError(ctx, "fail to marshal network fee to binary", err)
+	}
+	store.Set([]byte(key), buf)
+	return nil
+}
+
+// GetNetworkFee get the network fee of the given chain, zero will be returned when the network fee has not been set
+func (k KVStore) GetNetworkFee(ctx sdk.Context, chain common.Chain) (sdk.Uint, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixNetworkFee, chain.String())
+	if !store.Has([]byte(key)) {
+		return sdk.ZeroUint(), nil
+	}
+	var fee sdk.Uint
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &fee); err != nil {
+		return sdk.ZeroUint(), dbError(ctx, "Unmarshal: network fee", err)
+	}
+	return fee, nil
+}
+
+// GetNetworkFeeIterator iterate the network fees of all the chains
+func (k KVStore) GetNetworkFeeIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, []byte(prefixNetworkFee))
+}
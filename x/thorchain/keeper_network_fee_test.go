@@ -0,0 +1,62 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperNetworkFeeSuite struct{}
+
+var _ = Suite(&KeeperNetworkFeeSuite{})
+
+func (s *KeeperNetworkFeeSuite) TestNetworkFeeVoter(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	voter, err := k.GetNetworkFeeVoter(ctx, common.BNBChain, 1024, sdk.NewUint(37500))
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+	c.Check(voter.Fee.Equal(sdk.NewUint(37500)), Equals, true)
+
+	signer := GetRandomBech32Addr()
+	voter.Sign(signer)
+	c.Assert(k.SetNetworkFeeVoter(ctx, voter), IsNil)
+	voter, err = k.GetNetworkFeeVoter(ctx, common.BNBChain, 1024, sdk.NewUint(37500))
+	c.Assert(err, IsNil)
+	c.Check(voter.HasSigned(signer), Equals, true)
+
+	other := NewNetworkFeeVoter(common.BNBChain, 1024, sdk.NewUint(40000))
+	other.Sign(GetRandomBech32Addr())
+	c.Assert(k.SetNetworkFeeVoter(ctx, other), IsNil)
+	c.Assert(k.SetNetworkFeeVoter(ctx, NewNetworkFeeVoter(common.BNBChain, 10240, sdk.NewUint(40000))), IsNil)
+	c.Assert(k.SetNetworkFeeVoter(ctx, NewNetworkFeeVoter(common.BTCChain, 1024, sdk.NewUint(40000))), IsNil)
+	voters, err := k.GetNetworkFeeVoters(ctx, common.BNBChain, 1024)
+	c.Assert(err, IsNil)
+	c.Assert(voters, HasLen, 2)
+
+	// only the voters of the chain before the height are pruned
+	c.Assert(k.PruneNetworkFeeVoters(ctx, common.BNBChain, 10240), IsNil)
+	voters, err = k.GetNetworkFeeVoters(ctx, common.BNBChain, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voters, HasLen, 0)
+	voters, err = k.GetNetworkFeeVoters(ctx, common.BNBChain, 10240)
+	c.Assert(err, IsNil)
+	c.Check(voters, HasLen, 1)
+	voters, err = k.GetNetworkFeeVoters(ctx, common.BTCChain, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voters, HasLen, 1)
+}
+
+func (s *KeeperNetworkFeeSuite) TestNetworkFee(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	fee, err := k.GetNetworkFee(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(fee.IsZero(), Equals, true)
+	c.Assert(k.SaveNetworkFee(ctx, common.BNBChain, sdk.NewUint(37500)), IsNil)
+	fee, err = k.GetNetworkFee(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(fee.Equal(sdk.NewUint(37500)), Equals, true)
+	fee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(fee.IsZero(), Equals, true)
+}
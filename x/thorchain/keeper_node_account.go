@@ -1,8 +1,10 @@
 package thorchain
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 
@@ -22,6 +24,7 @@ type KeeperNodeAccount interface {
 	GetNodeAccount(ctx sdk.Context, addr sdk.AccAddress) (NodeAccount, error)
 	GetNodeAccountByPubKey(ctx sdk.Context, pk common.PubKey) (NodeAccount, error)
 	GetNodeAccountByBondAddress(ctx sdk.Context, addr common.Address) (NodeAccount, error)
+	GetNodeAccountsByBond(ctx sdk.Context, minBond, maxBond sdk.Uint) (NodeAccounts, error)
 	SetNodeAccount(ctx sdk.Context, na NodeAccount) error
 	EnsureNodeKeysUnique(ctx sdk.Context, consensusPubKey string, pubKeys common.PubKeySet) error
 	GetNodeAccountIterator(ctx sdk.Context) sdk.Iterator
@@ -30,6 +33,9 @@ type KeeperNodeAccount interface {
 	IncNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error
 	DecNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error
 	ResetNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress)
+	GetNodeBondRewards(ctx sdk.Context, pubKey common.PubKey) (sdk.Uint, error)
+	SetNodeBondRewards(ctx sdk.Context, pubKey common.PubKey, amount sdk.Uint) error
+	SlashNodeAccount(ctx sdk.Context, pubKey common.PubKey, asset common.Asset, amount sdk.Uint, eventMgr EventManager) error
 }
 
 // TotalActiveNodeAccount count the number of active node account
@@ -203,6 +209,21 @@ func (k KVStore) SetNodeAccount(ctx sdk.Context, na NodeAccount) error {
 	}
 	store := ctx.KVStore(k.storeKey)
 	key := k.GetKey(ctx, prefixNodeAccount, na.NodeAddress.String())
+	fromStatus := NodeUnknown
+	var existing NodeAccount
+	if store.Has([]byte(key)) {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &existing); err != nil {
+			return dbError(ctx, "Unmarshal: node account", err)
+		}
+		fromStatus = existing.Status
+	}
+	version := k.GetThorchainVersion(ctx)
+	// node accounts could move to any status before version 0.3.0
+	if version.GTE(semver.MustParse("0.3.0")) {
+		if err := NewNodeStatusTransitionManager().Transition(fromStatus, na.Status); err != nil {
+			return fmt.Errorf("fail to save node account(%s): %w", na.NodeAddress, err)
+		}
+	}
 	if na.Status == NodeActive {
 		if na.ActiveBlockHeight == 0 {
 			// the na is active, and does not have a block height when they
@@ -214,6 +235,13 @@ func (k KVStore) SetNodeAccount(ctx sdk.Context, na NodeAccount) error {
 	}
 
 	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(na))
+	// the bond index is only maintained from version 0.3.0, the node accounts saved before are indexed by a store migration
+	if version.GTE(semver.MustParse("0.3.0")) {
+		if !existing.IsEmpty() {
+			store.Delete(k.getNodeAccountBondKey(ctx, existing.Bond, existing.NodeAddress))
+		}
+		store.Set(k.getNodeAccountBondKey(ctx, na.Bond, na.NodeAddress), k.cdc.MustMarshalBinaryBare(na.NodeAddress))
+	}
 
 	// When a node is in active status, THORNode need to add the observer address to active
 	// if it is not , then THORNode could remove them
@@ -225,6 +253,55 @@ func (k KVStore) SetNodeAccount(ctx sdk.Context, na NodeAccount) error {
 	return nil
 }
 
+// bondIndexValue the bond is indexed as an uint64 in big endian, bigger bonds are capped to math.MaxUint64
+func bondIndexValue(bond sdk.Uint) []byte {
+	value := uint64(math.MaxUint64)
+	if bond.LT(sdk.NewUint(math.MaxUint64)) {
+		value = bond.Uint64()
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, value)
+	return buf
+}
+
+// getNodeAccountBondKey the bond in big endian followed by the node address is appended after the versioned prefix, so
+// the index is iterated in the order of bond
+func (k KVStore) getNodeAccountBondKey(ctx sdk.Context, bond sdk.Uint, addr sdk.AccAddress) []byte {
+	key := append([]byte(k.GetKey(ctx, prefixNodeAccountBond, "")), bondIndexValue(bond)...)
+	return append(key, addr.Bytes()...)
+}
+
+// GetNodeAccountsByBond return the node accounts with a bond between minBond and maxBond (both included), in the order
+// of bond. The bond index it reads is only maintained from version 0.3.0
+func (k KVStore) GetNodeAccountsByBond(ctx sdk.Context, minBond, maxBond sdk.Uint) (NodeAccounts, error) {
+	if minBond.GT(maxBond) {
+		return nil, fmt.Errorf("min bond(%s) is greater than max bond(%s)", minBond, maxBond)
+	}
+	prefix := k.GetKey(ctx, prefixNodeAccountBond, "")
+	start := append([]byte(prefix), bondIndexValue(minBond)...)
+	// the end of the range is exclusive
+	end := sdk.PrefixEndBytes([]byte(prefix))
+	if maxBond.LT(sdk.NewUint(math.MaxUint64)) {
+		end = append([]byte(prefix), bondIndexValue(maxBond.AddUint64(1))...)
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	nodeAccounts := make(NodeAccounts, 0)
+	for ; iter.Valid(); iter.Next() {
+		var addr sdk.AccAddress
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &addr); err != nil {
+			return nil, dbError(ctx, "Unmarshal: node account bond index", err)
+		}
+		na, err := k.GetNodeAccount(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get node account(%s): %w", addr, err)
+		}
+		nodeAccounts = append(nodeAccounts, na)
+	}
+	return nodeAccounts, nil
+}
+
 // EnsureNodeKeysUnique check the given consensus pubkey and pubkey set against all the the node account
 // return an error when it is overlap with any existing account
 func (k KVStore) EnsureNodeKeysUnique(ctx sdk.Context, consensusPubKey string, pubKeys common.PubKeySet) error {
@@ -311,3 +388,137 @@ func (k KVStore) DecNodeAccountSlashPoints(ctx sdk.Context, addr sdk.AccAddress,
 	k.SetNodeAccountSlashPoints(ctx, addr, current-pts)
 	return nil
 }
+
+// GetNodeBondRewards - get the bond rewards the node has accumulated but not
+// claimed yet
+func (k KVStore) GetNodeBondRewards(ctx sdk.Context, pubKey common.PubKey) (sdk.Uint, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixNodeBondRewards, pubKey.String())
+	if !store.Has([]byte(key)) {
+		return sdk.ZeroUint(), nil
+	}
+	payload := store.Get([]byte(key))
+	var amount sdk.Uint
+	if err := k.cdc.UnmarshalBinaryBare(payload, &amount); err != nil {
+		return sdk.ZeroUint(), dbError(ctx, "Unmarshal: node bond rewards", err)
+	}
+	return amount, nil
+}
+
+// SetNodeBondRewards - set the unclaimed bond rewards of the node
+func (k KVStore) SetNodeBondRewards(ctx sdk.Context, pubKey common.PubKey, amount sdk.Uint) error {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixNodeBondRewards, pubKey.String())
+	if amount.IsZero() {
+		store.Delete([]byte(key))
+		return nil
+	}
+	buf, err := k.cdc.MarshalBinaryBare(amount)
+	if err != nil {
+		return dbError(ctx, "Marshal: node bond rewards", err)
+	}
+	store.Set([]byte(key), buf)
+	return nil
+}
+
+// SlashNodeAccount thorchain keep monitoring the outbound tx from asgard pool
+// and yggdrasil pool, usually the txout is triggered by thorchain itself by
+// adding an item into the txout array, refer to TxOutItem for the detail, the
+// TxOutItem contains a specific coin and amount.  if somehow thorchain
+// discover signer send out fund more than the amount specified in TxOutItem,
+// it will slash the node account who does that by taking 1.5 * extra fund from
+// node account's bond and subsidise the pool that actually lost it.
+func (k KVStore) SlashNodeAccount(ctx sdk.Context, pubKey common.PubKey, asset common.Asset, amount sdk.Uint, eventMgr EventManager) error {
+	return slashNodeAccount(ctx, k, pubKey, asset, amount, eventMgr)
+}
+
+// slashNodeAccount does the bond, reserve and pool accounting of SlashNodeAccount through the given keeper
+func slashNodeAccount(ctx sdk.Context, k Keeper, pubKey common.PubKey, asset common.Asset, amount sdk.Uint, eventMgr EventManager) error {
+	if amount.IsZero() {
+		return nil
+	}
+	nodeAccount, err := k.GetNodeAccountByPubKey(ctx, pubKey)
+	if err != nil {
+		return fmt.Errorf("fail to get node account with pubkey(%s), %w", pubKey, err)
+	}
+
+	if nodeAccount.Status == NodeUnknown {
+		return nil
+	}
+
+	if asset.IsRune() {
+		// If rune, we take 1.5x the amount, and take it from their bond. We
+		// put 1/3rd of it into the reserve, and 2/3rds into the pools (but
+		// keeping the rune pool balances unchanged)
+		amountToReserve := amount.QuoUint64(2)
+		// if the diff asset is RUNE , just took 1.5 * diff from their bond
+		slashAmount := amount.MulUint64(3).QuoUint64(2)
+		slashed, err := subtractNodeBond(ctx, k, &nodeAccount, slashAmount)
+		if err != nil {
+			return err
+		}
+		vaultData, err := k.GetVaultData(ctx)
+		if err != nil {
+			return fmt.Errorf("fail to get vault data: %w", err)
+		}
+		vaultData.TotalReserve = vaultData.TotalReserve.Add(amountToReserve)
+		if err := k.SetVaultData(ctx, vaultData); err != nil {
+			return fmt.Errorf("fail to save vault data: %w", err)
+		}
+		if err := k.SetNodeAccount(ctx, nodeAccount); err != nil {
+			return err
+		}
+		recordFundLossSlash(ctx, k, nodeAccount.NodeAddress, slashed)
+		return nil
+	}
+	pool, err := k.GetPool(ctx, asset)
+	if err != nil {
+		return fmt.Errorf("fail to get %s pool : %w", asset, err)
+	}
+	// thorchain doesn't even have a pool for the asset, or the pool had been
+	// suspended, then who cares
+	if pool.Empty() || pool.Status == PoolSuspended {
+		return nil
+	}
+	runeValue := pool.AssetValueInRune(amount).MulUint64(3).QuoUint64(2)
+	pool.BalanceAsset = common.SafeSub(pool.BalanceAsset, amount)
+	pool.BalanceRune = pool.BalanceRune.Add(runeValue)
+	slashed, err := subtractNodeBond(ctx, k, &nodeAccount, runeValue)
+	if err != nil {
+		return err
+	}
+	if err := k.SetPool(ctx, pool); err != nil {
+		return fmt.Errorf("fail to save %s pool: %w", asset, err)
+	}
+
+	poolSlashAmt := []PoolAmt{
+		{
+			Asset:  pool.Asset,
+			Amount: 0 - int64(amount.Uint64()),
+		},
+		{
+			Asset:  common.RuneAsset(),
+			Amount: int64(runeValue.Uint64()),
+		},
+	}
+	eventSlash := NewEventSlash(pool.Asset, poolSlashAmt)
+	if err := eventMgr.EmitSlashEvent(ctx, k, eventSlash); err != nil {
+		return fmt.Errorf("fail to emit slash event: %w", err)
+	}
+
+	if err := k.SetNodeAccount(ctx, nodeAccount); err != nil {
+		return err
+	}
+	recordFundLossSlash(ctx, k, nodeAccount.NodeAddress, slashed)
+	return nil
+}
+
+// recordFundLossSlash add the RUNE taken from the bond of the given node account to its slash history
+func recordFundLossSlash(ctx sdk.Context, k Keeper, addr sdk.AccAddress, slashed sdk.Uint) {
+	if slashed.IsZero() {
+		return
+	}
+	if err := k.RecordSlash(ctx, addr, "fund loss", int64(slashed.Uint64())); err != nil {
+		ctx.Logger().Error("fail to record slash", "error", err)
+	}
+}
@@ -1,10 +1,14 @@
 package thorchain
 
 import (
+	"math"
+
 	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperNodeAccountSuite struct{}
@@ -178,3 +182,185 @@ func (s *KeeperNodeAccountSuite) TestNodeAccountSlashPoints(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(pts, Equals, int64(10))
 }
+
+func (s *KeeperNodeAccountSuite) TestNodeBondRewards(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pk := GetRandomPubKey()
+
+	rewards, err := k.GetNodeBondRewards(ctx, pk)
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+
+	c.Assert(k.SetNodeBondRewards(ctx, pk, sdk.NewUint(common.One)), IsNil)
+	rewards, err = k.GetNodeBondRewards(ctx, pk)
+	c.Assert(err, IsNil)
+	c.Check(rewards.Equal(sdk.NewUint(common.One)), Equals, true)
+
+	// other nodes are not affected
+	rewards, err = k.GetNodeBondRewards(ctx, GetRandomPubKey())
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+
+	c.Assert(k.SetNodeBondRewards(ctx, pk, sdk.ZeroUint()), IsNil)
+	rewards, err = k.GetNodeBondRewards(ctx, pk)
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+}
+
+func (s *KeeperNodeAccountSuite) TestSlashNodeAccount(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	eventMgr := NewEventMgr()
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	pk := na.PubKeySet.Secp256k1
+
+	// nothing to slash
+	c.Assert(k.SlashNodeAccount(ctx, pk, common.BNBAsset, sdk.ZeroUint(), eventMgr), IsNil)
+	// node account doesn't exist
+	c.Assert(k.SlashNodeAccount(ctx, GetRandomPubKey(), common.BNBAsset, sdk.NewUint(common.One), eventMgr), IsNil)
+	na, err := k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(100*common.One)), Equals, true)
+
+	// slash RUNE, 1.5x is taken from bond, and 0.5x goes to reserve
+	vaultData, err := k.GetVaultData(ctx)
+	c.Assert(err, IsNil)
+	expectedReserve := vaultData.TotalReserve.Add(sdk.NewUint(common.One))
+	c.Assert(k.SlashNodeAccount(ctx, pk, common.RuneAsset(), sdk.NewUint(2*common.One), eventMgr), IsNil)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(97*common.One)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+	vaultData, err = k.GetVaultData(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vaultData.TotalReserve.Equal(expectedReserve), Equals, true)
+
+	// slash asset, 1.5x of its RUNE value is taken from bond to subsidise the pool
+	c.Assert(k.SlashNodeAccount(ctx, pk, common.BNBAsset, sdk.NewUint(1024), eventMgr), IsNil)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(97*common.One-1536)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceAsset.Equal(sdk.NewUint(100*common.One-1024)), Equals, true)
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(100*common.One+1536)), Equals, true)
+	found := false
+	slashEventType := NewEventSlash(common.BNBAsset, nil).Type()
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == slashEventType {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+
+	// pool doesn't exist, no slash to the bond
+	c.Assert(k.SlashNodeAccount(ctx, pk, common.BTCAsset, sdk.NewUint(common.One), eventMgr), IsNil)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(97*common.One-1536)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+
+	// unclaimed bond rewards are slashed before the bond
+	c.Assert(k.SetNodeBondRewards(ctx, pk, sdk.NewUint(common.One)), IsNil)
+	c.Assert(k.SlashNodeAccount(ctx, pk, common.RuneAsset(), sdk.NewUint(2*common.One), eventMgr), IsNil)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(95*common.One-1536)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+	rewards, err := k.GetNodeBondRewards(ctx, pk)
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+
+	// every slash of the bond is in the slash history of the node account
+	history, err := k.GetNodeAccountSlashHistory(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 1)
+	c.Check(history[0].Reason, Equals, "fund loss")
+	c.Check(history[0].Amount, Equals, int64(6*common.One+1536))
+	c.Check(history[0].Count, Equals, int64(3))
+}
+
+func (s *KeeperNodeAccountSuite) TestSetNodeAccountStatusTransition(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	// any status transition is accepted before version 0.3.0
+	c.Assert(k.SetThorchainVersion(ctx, semver.MustParse("0.2.0")), IsNil)
+	na := GetRandomNodeAccount(NodeDisabled)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	na.UpdateStatus(NodeActive, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	na = GetRandomNodeAccount(NodeStandby)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	// standby node can't become active without going through ready
+	na.UpdateStatus(NodeActive, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), NotNil)
+	na.UpdateStatus(NodeReady, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	na.UpdateStatus(NodeActive, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	na.UpdateStatus(NodeDisabled, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	// disabled node account can't become active without going through ready
+	na.UpdateStatus(NodeActive, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), NotNil)
+	stored, err := k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(stored.Status, Equals, NodeDisabled)
+	na.UpdateStatus(NodeReady, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	na.UpdateStatus(NodeActive, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+}
+
+func (s *KeeperNodeAccountSuite) TestGetNodeAccountsByBond(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	nas, err := k.GetNodeAccountsByBond(ctx, sdk.ZeroUint(), sdk.NewUint(1000*common.One))
+	c.Assert(err, IsNil)
+	c.Check(nas, HasLen, 0)
+	_, err = k.GetNodeAccountsByBond(ctx, sdk.NewUint(2), sdk.NewUint(1))
+	c.Check(err, NotNil)
+
+	na1 := GetRandomNodeAccount(NodeStandby)
+	na1.Bond = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na1), IsNil)
+	na2 := GetRandomNodeAccount(NodeStandby)
+	na2.Bond = sdk.NewUint(200 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na2), IsNil)
+	na3 := GetRandomNodeAccount(NodeStandby)
+	na3.Bond = sdk.NewUint(300 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na3), IsNil)
+
+	// no node in range
+	nas, err = k.GetNodeAccountsByBond(ctx, sdk.NewUint(101*common.One), sdk.NewUint(199*common.One))
+	c.Assert(err, IsNil)
+	c.Check(nas, HasLen, 0)
+
+	// boundaries are included
+	nas, err = k.GetNodeAccountsByBond(ctx, sdk.NewUint(100*common.One), sdk.NewUint(200*common.One))
+	c.Assert(err, IsNil)
+	c.Assert(nas, HasLen, 2)
+	c.Check(nas[0].NodeAddress.Equals(na1.NodeAddress), Equals, true)
+	c.Check(nas[1].NodeAddress.Equals(na2.NodeAddress), Equals, true)
+	nas, err = k.GetNodeAccountsByBond(ctx, sdk.NewUint(300*common.One), sdk.NewUint(300*common.One))
+	c.Assert(err, IsNil)
+	c.Assert(nas, HasLen, 1)
+	c.Check(nas[0].NodeAddress.Equals(na3.NodeAddress), Equals, true)
+
+	// the index follows the bond of the node account
+	na3.Bond = sdk.NewUint(50 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na3), IsNil)
+	nas, err = k.GetNodeAccountsByBond(ctx, sdk.ZeroUint(), sdk.NewUint(math.MaxUint64))
+	c.Assert(err, IsNil)
+	c.Assert(nas, HasLen, 3)
+	c.Check(nas[0].NodeAddress.Equals(na3.NodeAddress), Equals, true)
+	c.Check(nas[0].Bond.Equal(na3.Bond), Equals, true)
+	c.Check(nas[1].NodeAddress.Equals(na1.NodeAddress), Equals, true)
+	c.Check(nas[2].NodeAddress.Equals(na2.NodeAddress), Equals, true)
+}
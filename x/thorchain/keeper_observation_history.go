@@ -0,0 +1,70 @@
+package thorchain
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type KeeperObservationHistory interface {
+	AddObservation(ctx sdk.Context, tx ObservedTx, signer sdk.AccAddress) error
+	GetObservationHistory(ctx sdk.Context, from, to int64) (ObservedTxs, error)
+	PruneObservationHistory(ctx sdk.Context, height int64) error
+}
+
+// getObservationHistoryKey the height is zero padded so the keys are sorted by block height
+func (k KVStore) getObservationHistoryKey(ctx sdk.Context, height int64) string {
+	return k.GetKey(ctx, prefixObservationHistory, fmt.Sprintf("%020d", height))
+}
+
+// AddObservation record the observation of the given tx by the given signer into the observation history of the
+// current block height, each observation is saved under its own key
+func (k KVStore) AddObservation(ctx sdk.Context, tx ObservedTx, signer sdk.AccAddress) error {
+	tx.Signers = []sdk.AccAddress{signer}
+	key := k.GetKey(ctx, prefixObservationHistory, fmt.Sprintf("%020d/%s/%s", ctx.BlockHeight(), signer, tx.Tx.ID))
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(tx))
+	return nil
+}
+
+// GetObservationHistory return all the observations recorded between the given block heights (inclusive)
+func (k KVStore) GetObservationHistory(ctx sdk.Context, from, to int64) (ObservedTxs, error) {
+	if from < 0 || to < from {
+		return nil, errors.New("invalid block height range")
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(k.getObservationHistoryKey(ctx, from))
+	end := []byte(k.getObservationHistoryKey(ctx, to+1))
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	result := make(ObservedTxs, 0)
+	for ; iter.Valid(); iter.Next() {
+		var tx ObservedTx
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &tx); err != nil {
+			return nil, dbError(ctx, "Unmarshal: observation history", err)
+		}
+		result = append(result, tx)
+	}
+	return result, nil
+}
+
+// PruneObservationHistory remove all the observations recorded before the given block height
+func (k KVStore) PruneObservationHistory(ctx sdk.Context, height int64) error {
+	if height <= 0 {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(k.getObservationHistoryKey(ctx, 0))
+	end := []byte(k.getObservationHistoryKey(ctx, height))
+	iter := store.Iterator(start, end)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type KeeperObservationHistorySuite struct{}
+
+var _ = Suite(&KeeperObservationHistorySuite{})
+
+func (s *KeeperObservationHistorySuite) TestObservationHistory(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	signer := GetRandomBech32Addr()
+	for height := int64(1); height <= 10; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		c.Assert(k.AddObservation(ctx, GetRandomObservedTx(), signer), IsNil)
+		c.Assert(k.AddObservation(ctx, GetRandomObservedTx(), signer), IsNil)
+	}
+
+	// the same tx observed by another signer is kept as well
+	tx := GetRandomObservedTx()
+	c.Assert(k.AddObservation(ctx, tx, signer), IsNil)
+	c.Assert(k.AddObservation(ctx, tx, GetRandomBech32Addr()), IsNil)
+	txs, err := k.GetObservationHistory(ctx, 10, 10)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 4)
+	for _, item := range txs {
+		c.Assert(item.Signers, HasLen, 1)
+	}
+
+	txs, err = k.GetObservationHistory(ctx, 1, 10)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 22)
+
+	txs, err = k.GetObservationHistory(ctx, 3, 5)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 6)
+
+	txs, err = k.GetObservationHistory(ctx, 11, 20)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 0)
+
+	_, err = k.GetObservationHistory(ctx, 5, 3)
+	c.Assert(err, NotNil)
+
+	c.Assert(k.PruneObservationHistory(ctx, 0), IsNil)
+	c.Assert(k.PruneObservationHistory(ctx, 8), IsNil)
+	txs, err = k.GetObservationHistory(ctx, 1, 10)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 8)
+}
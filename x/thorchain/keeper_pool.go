@@ -2,18 +2,28 @@ package thorchain
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperPool interface {
 	GetPoolIterator(ctx sdk.Context) sdk.Iterator
 	GetPool(ctx sdk.Context, asset common.Asset) (Pool, error)
 	GetPools(ctx sdk.Context) (Pools, error)
+	GetAllPoolsSorted(ctx sdk.Context) (Pools, error)
 	SetPool(ctx sdk.Context, pool Pool) error
 	PoolExist(ctx sdk.Context, asset common.Asset) bool
+	RecordSwapVolume(ctx sdk.Context, asset common.Asset, runeVolume sdk.Uint, height int64) error
+	GetPoolHistoricalVolume(ctx sdk.Context, asset common.Asset, from, to int64) (sdk.Uint, error)
+	GetPool24hVolume(ctx sdk.Context, asset common.Asset) (sdk.Uint, error)
+	GetPoolCyclePoint(ctx sdk.Context) (int64, error)
+	IsPoolCycleEnd(ctx sdk.Context) (bool, error)
+	ComputePoolDepthInRune(ctx sdk.Context, asset common.Asset) (sdk.Uint, error)
 }
 
 // GetPoolIterator iterate pools
@@ -22,6 +32,8 @@ func (k KVStore) GetPoolIterator(ctx sdk.Context) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, []byte(prefixPool))
 }
 
+// GetPools return all the pools in the order of KV store keys
+// Deprecated: use GetAllPoolsSorted when a deterministic order is required
 func (k KVStore) GetPools(ctx sdk.Context) (Pools, error) {
 	var pools Pools
 	iterator := k.GetPoolIterator(ctx)
@@ -37,6 +49,18 @@ func (k KVStore) GetPools(ctx sdk.Context) (Pools, error) {
 	return pools, nil
 }
 
+// GetAllPoolsSorted return all the pools sorted by asset
+func (k KVStore) GetAllPoolsSorted(ctx sdk.Context) (Pools, error) {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(pools, func(i, j int) bool {
+		return pools[i].Asset.String() < pools[j].Asset.String()
+	})
+	return pools, nil
+}
+
 // GetPool get the entire Pool metadata struct for a pool ID
 func (k KVStore) GetPool(ctx sdk.Context, asset common.Asset) (Pool, error) {
 	key := k.GetKey(ctx, prefixPool, asset.String())
@@ -65,9 +89,144 @@ func (k KVStore) SetPool(ctx sdk.Context, pool Pool) error {
 	return nil
 }
 
+// ComputePoolDepthInRune return the depth of the given pool in RUNE, which is the RUNE balance plus the RUNE value of the
+// asset balance
+func (k KVStore) ComputePoolDepthInRune(ctx sdk.Context, asset common.Asset) (sdk.Uint, error) {
+	pool, err := k.GetPool(ctx, asset)
+	if err != nil {
+		return sdk.ZeroUint(), fmt.Errorf("fail to get pool(%s): %w", asset, err)
+	}
+	return pool.BalanceRune.Add(pool.AssetValueInRune(pool.BalanceAsset)), nil
+}
+
 // PoolExist check whether the given pool exist in the datastore
 func (k KVStore) PoolExist(ctx sdk.Context, asset common.Asset) bool {
 	store := ctx.KVStore(k.storeKey)
 	key := k.GetKey(ctx, prefixPool, asset.String())
 	return store.Has([]byte(key))
 }
+
+func (k KVStore) getPoolVolumeKey(ctx sdk.Context, asset common.Asset, height int64) string {
+	// pad the height , so the volumes will be iterated in the order of block height
+	return k.GetKey(ctx, prefixPoolVolume, fmt.Sprintf("%s/%020d", asset, height))
+}
+
+// RecordSwapVolume add the given swap volume (in RUNE) to the volume of the pool at the given block height, the
+// volumes of the pool that are older than a day are pruned
+func (k KVStore) RecordSwapVolume(ctx sdk.Context, asset common.Asset, runeVolume sdk.Uint, height int64) error {
+	if asset.IsEmpty() {
+		return errors.New("cannot record volume of a pool with an empty asset")
+	}
+	if height < 0 {
+		return fmt.Errorf("invalid block height: %d", height)
+	}
+	blocksPerDay, err := k.getBlocksPerDay(ctx)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.getPoolVolumeKey(ctx, asset, height)
+	volume := sdk.ZeroUint()
+	exist := store.Has([]byte(key))
+	if exist {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &volume); err != nil {
+			return dbError(ctx, "Unmarshal: pool volume", err)
+		}
+	}
+	buf, err := k.cdc.MarshalBinaryBare(volume.Add(runeVolume))
+	if err != nil {
+		return dbError(ctx, "fail to marshal pool volume to binary", err)
+	}
+	store.Set([]byte(key), buf)
+	if !exist {
+		// only the first swap of the pool in a block need to prune
+		k.prunePoolVolume(ctx, asset, height-blocksPerDay+1)
+	}
+	return nil
+}
+
+// prunePoolVolume remove the swap volumes of the pool recorded before the given block height
+func (k KVStore) prunePoolVolume(ctx sdk.Context, asset common.Asset, height int64) {
+	if height <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := k.getPoolVolumeKey(ctx, asset, 0)
+	end := k.getPoolVolumeKey(ctx, asset, height)
+	iter := store.Iterator([]byte(start), []byte(end))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetPoolHistoricalVolume return the total swap volume (in RUNE) of the pool between the given block heights, both inclusive
+func (k KVStore) GetPoolHistoricalVolume(ctx sdk.Context, asset common.Asset, from, to int64) (sdk.Uint, error) {
+	if from < 0 || to < from {
+		return sdk.ZeroUint(), fmt.Errorf("invalid block height range, from: %d, to: %d", from, to)
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := k.getPoolVolumeKey(ctx, asset, from)
+	end := k.getPoolVolumeKey(ctx, asset, to+1)
+	iter := store.Iterator([]byte(start), []byte(end))
+	defer iter.Close()
+	total := sdk.ZeroUint()
+	for ; iter.Valid(); iter.Next() {
+		var volume sdk.Uint
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &volume); err != nil {
+			return sdk.ZeroUint(), dbError(ctx, "Unmarshal: pool volume", err)
+		}
+		total = total.Add(volume)
+	}
+	return total, nil
+}
+
+// getBlocksPerDay return the number of blocks in 24 hours
+func (k KVStore) getBlocksPerDay(ctx sdk.Context) (int64, error) {
+	version := k.GetThorchainVersion(ctx)
+	constAccessor := constants.GetConstantValues(version)
+	if constAccessor == nil {
+		return 0, fmt.Errorf("constants for version(%s) is not available", version)
+	}
+	return constAccessor.GetInt64Value(constants.BlocksPerYear) / 365, nil
+}
+
+// GetPool24hVolume return the swap volume (in RUNE) of the pool in the last 24 hours worth of blocks
+func (k KVStore) GetPool24hVolume(ctx sdk.Context, asset common.Asset) (sdk.Uint, error) {
+	blocksPerDay, err := k.getBlocksPerDay(ctx)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	from := ctx.BlockHeight() - blocksPerDay + 1
+	if from < 0 {
+		from = 0
+	}
+	return k.GetPoolHistoricalVolume(ctx, asset, from, ctx.BlockHeight())
+}
+
+// GetPoolCyclePoint return where the current block is in the NewPoolCycle, 0 means a new pool cycle starts
+func (k KVStore) GetPoolCyclePoint(ctx sdk.Context) (int64, error) {
+	version := k.GetThorchainVersion(ctx)
+	constAccessor := constants.GetConstantValues(version)
+	if constAccessor == nil {
+		return 0, fmt.Errorf("constants for version(%s) is not available", version)
+	}
+	newPoolCycle := constAccessor.GetInt64Value(constants.NewPoolCycle)
+	if newPoolCycle <= 0 {
+		return 0, fmt.Errorf("invalid new pool cycle: %d", newPoolCycle)
+	}
+	return ctx.BlockHeight() % newPoolCycle, nil
+}
+
+// IsPoolCycleEnd return true when the current block ends a NewPoolCycle, and the next pool should be enabled
+func (k KVStore) IsPoolCycleEnd(ctx sdk.Context) (bool, error) {
+	cyclePoint, err := k.GetPoolCyclePoint(ctx)
+	if err != nil {
+		return false, err
+	}
+	return cyclePoint == 0, nil
+}
@@ -1,9 +1,11 @@
 package thorchain
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperPoolSuite struct{}
@@ -29,3 +31,137 @@ func (s *KeeperPoolSuite) TestPool(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(pools, HasLen, 1)
 }
+
+func (s *KeeperPoolSuite) TestGetAllPoolsSorted(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	for _, asset := range []common.Asset{common.BTCAsset, common.ETHAsset, common.BNBAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		c.Assert(k.SetPool(ctx, pool), IsNil)
+	}
+	pools, err := k.GetAllPoolsSorted(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(pools, HasLen, 3)
+	c.Check(pools[0].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(pools[1].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(pools[2].Asset.Equals(common.ETHAsset), Equals, true)
+}
+
+func (s *KeeperPoolSuite) TestPoolVolume(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Assert(k.RecordSwapVolume(ctx, common.EmptyAsset, sdk.NewUint(common.One), 10), NotNil)
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(common.One), -1), NotNil)
+	// no active node , thus no valid version
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(common.One), 10), NotNil)
+	_, err := k.GetPool24hVolume(ctx, common.BNBAsset)
+	c.Assert(err, NotNil)
+	c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(common.One), 10), IsNil)
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(2*common.One), 10), IsNil)
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(3*common.One), 11), IsNil)
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(4*common.One), 20), IsNil)
+	c.Assert(k.RecordSwapVolume(ctx, common.BTCAsset, sdk.NewUint(5*common.One), 11), IsNil)
+
+	volume, err := k.GetPoolHistoricalVolume(ctx, common.BNBAsset, 10, 10)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(3*common.One)), Equals, true, Commentf("%s", volume))
+	volume, err = k.GetPoolHistoricalVolume(ctx, common.BNBAsset, 10, 19)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(6*common.One)), Equals, true, Commentf("%s", volume))
+	volume, err = k.GetPoolHistoricalVolume(ctx, common.BNBAsset, 0, 20)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(10*common.One)), Equals, true, Commentf("%s", volume))
+	volume, err = k.GetPoolHistoricalVolume(ctx, common.BTCAsset, 0, 20)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(5*common.One)), Equals, true, Commentf("%s", volume))
+	volume, err = k.GetPoolHistoricalVolume(ctx, common.ETHAsset, 0, 20)
+	c.Assert(err, IsNil)
+	c.Check(volume.IsZero(), Equals, true)
+	_, err = k.GetPoolHistoricalVolume(ctx, common.BNBAsset, 20, 10)
+	c.Assert(err, NotNil)
+
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	blocksPerDay := constAccessor.GetInt64Value(constants.BlocksPerYear) / 365
+	volume, err = k.GetPool24hVolume(ctx.WithBlockHeight(20), common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(10*common.One)), Equals, true, Commentf("%s", volume))
+	// volume at block height 10 and 11 are older than a day
+	volume, err = k.GetPool24hVolume(ctx.WithBlockHeight(11+blocksPerDay), common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(4*common.One)), Equals, true, Commentf("%s", volume))
+
+	// volumes older than a day get pruned once the pool get swapped again
+	c.Assert(k.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(common.One), 20+blocksPerDay), IsNil)
+	volume, err = k.GetPoolHistoricalVolume(ctx, common.BNBAsset, 0, 20+blocksPerDay)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(common.One)), Equals, true, Commentf("%s", volume))
+	// other pools are not touched
+	volume, err = k.GetPoolHistoricalVolume(ctx, common.BTCAsset, 0, 20)
+	c.Assert(err, IsNil)
+	c.Check(volume.Equal(sdk.NewUint(5*common.One)), Equals, true, Commentf("%s", volume))
+}
+
+func (s *KeeperPoolSuite) TestPoolCyclePoint(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	newPoolCycle := constants.GetConstantValues(constants.SWVersion).GetInt64Value(constants.NewPoolCycle)
+
+	// no active node , thus no valid version
+	_, err := k.GetPoolCyclePoint(ctx)
+	c.Assert(err, NotNil)
+	_, err = k.IsPoolCycleEnd(ctx)
+	c.Assert(err, NotNil)
+
+	c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+	point, err := k.GetPoolCyclePoint(ctx.WithBlockHeight(newPoolCycle + 5))
+	c.Assert(err, IsNil)
+	c.Check(point, Equals, int64(5))
+	end, err := k.IsPoolCycleEnd(ctx.WithBlockHeight(newPoolCycle + 5))
+	c.Assert(err, IsNil)
+	c.Check(end, Equals, false)
+
+	point, err = k.GetPoolCyclePoint(ctx.WithBlockHeight(newPoolCycle * 2))
+	c.Assert(err, IsNil)
+	c.Check(point, Equals, int64(0))
+	end, err = k.IsPoolCycleEnd(ctx.WithBlockHeight(newPoolCycle * 2))
+	c.Assert(err, IsNil)
+	c.Check(end, Equals, true)
+}
+
+func (s *KeeperPoolSuite) TestComputePoolDepthInRune(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// pool doesn't exist
+	depth, err := k.ComputePoolDepthInRune(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(depth.IsZero(), Equals, true)
+
+	// zero balances
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	depth, err = k.ComputePoolDepthInRune(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(depth.IsZero(), Equals, true)
+
+	// zero asset balance
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	depth, err = k.ComputePoolDepthInRune(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(depth.Equal(sdk.NewUint(100*common.One)), Equals, true, Commentf("%s", depth))
+
+	// zero rune balance
+	pool.BalanceRune = sdk.ZeroUint()
+	pool.BalanceAsset = sdk.NewUint(50 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	depth, err = k.ComputePoolDepthInRune(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(depth.IsZero(), Equals, true, Commentf("%s", depth))
+
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	depth, err = k.ComputePoolDepthInRune(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(depth.Equal(sdk.NewUint(200*common.One)), Equals, true, Commentf("%s", depth))
+}
@@ -1,13 +1,20 @@
 package thorchain
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type KeeperRagnarok interface {
 	RagnarokInProgress(_ sdk.Context) bool
 	GetRagnarokBlockHeight(_ sdk.Context) (int64, error)
 	SetRagnarokBlockHeight(_ sdk.Context, _ int64)
+	SetPoolRagnarokInProgress(ctx sdk.Context, asset common.Asset) error
+	IsPoolRagnarokInProgress(ctx sdk.Context, asset common.Asset) (bool, error)
+	ClearPoolRagnarokInProgress(ctx sdk.Context, asset common.Asset)
 }
 
 func (k KVStore) RagnarokInProgress(ctx sdk.Context) bool {
@@ -39,3 +46,41 @@ func (k KVStore) SetRagnarokBlockHeight(ctx sdk.Context, height int64) {
 	key := k.GetKey(ctx, prefixRagnarok, "")
 	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(height))
 }
+
+// SetPoolRagnarokInProgress mark the pool of the given asset as being ragnarok'd, new stakes to the pool are rejected
+// while the existing positions are unwound
+func (k KVStore) SetPoolRagnarokInProgress(ctx sdk.Context, asset common.Asset) error {
+	if asset.IsEmpty() {
+		return dbError(ctx, "fail to set pool ragnarok", errors.New("empty asset"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixPoolRagnarok, asset.String())
+	buf, err := k.cdc.MarshalBinaryBare(true)
+	if err != nil {
+		return dbError(ctx, "fail to marshal pool ragnarok to binary", err)
+	}
+	store.Set([]byte(key), buf)
+	return nil
+}
+
+// IsPoolRagnarokInProgress check whether the pool of the given asset is being ragnarok'd
+func (k KVStore) IsPoolRagnarokInProgress(ctx sdk.Context, asset common.Asset) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixPoolRagnarok, asset.String())
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+	var inProgress bool
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &inProgress); err != nil {
+		return false, dbError(ctx, "Unmarshal: pool ragnarok", err)
+	}
+	return inProgress, nil
+}
+
+// ClearPoolRagnarokInProgress remove the ragnarok mark of the pool of the given asset, once all its positions are
+// unwound or the pool is enabled again
+func (k KVStore) ClearPoolRagnarokInProgress(ctx sdk.Context, asset common.Asset) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixPoolRagnarok, asset.String())
+	store.Delete([]byte(key))
+}
@@ -2,6 +2,8 @@ package thorchain
 
 import (
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type KeeperRagnarokSuite struct{}
@@ -23,3 +25,28 @@ func (s *KeeperRagnarokSuite) TestRagnarok(c *C) {
 	c.Assert(height, Equals, int64(45))
 	c.Check(k.RagnarokInProgress(ctx), Equals, true)
 }
+
+func (s *KeeperRagnarokSuite) TestPoolRagnarok(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	ragnarok, err := k.IsPoolRagnarokInProgress(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, false)
+
+	c.Assert(k.SetPoolRagnarokInProgress(ctx, common.BNBAsset), IsNil)
+	ragnarok, err = k.IsPoolRagnarokInProgress(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, true)
+
+	// other pools are not affected
+	ragnarok, err = k.IsPoolRagnarokInProgress(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, false)
+
+	c.Check(k.SetPoolRagnarokInProgress(ctx, common.Asset{}), NotNil)
+
+	k.ClearPoolRagnarokInProgress(ctx, common.BNBAsset)
+	ragnarok, err = k.IsPoolRagnarokInProgress(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, false)
+}
@@ -4,12 +4,17 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type KeeperReserveContributors interface {
 	GetReservesContributors(ctx sdk.Context) (ReserveContributors, error)
 	SetReserveContributors(ctx sdk.Context, contributors ReserveContributors) error
 	AddFeeToReserve(ctx sdk.Context, fee sdk.Uint) error
+	GetReserveBalance(ctx sdk.Context) sdk.Uint
+	AddToReserve(ctx sdk.Context, amount sdk.Uint) error
+	DeductFromReserve(ctx sdk.Context, amount sdk.Uint) error
 }
 
 // AddFeeToReserve add fee to reserve
@@ -22,6 +27,64 @@ func (k KVStore) AddFeeToReserve(ctx sdk.Context, fee sdk.Uint) error {
 	return k.SetVaultData(ctx, vault)
 }
 
+// GetReserveBalance return the RUNE balance of the reserve, which is the balance of the reserve module account when
+// RUNE is native, otherwise the total reserve recorded in vault data
+func (k KVStore) GetReserveBalance(ctx sdk.Context) sdk.Uint {
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		return k.GetRuneBalaceOfModule(ctx, ReserveName)
+	}
+	vaultData, err := k.GetVaultData(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get vault data", "error", err)
+		return sdk.ZeroUint()
+	}
+	return vaultData.TotalReserve
+}
+
+// AddToReserve move the given amount of RUNE from asgard to the reserve
+func (k KVStore) AddToReserve(ctx sdk.Context, amount sdk.Uint) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		coin := common.NewCoin(common.RuneNative, amount)
+		if err := k.SendFromModuleToModule(ctx, AsgardName, ReserveName, coin); err != nil {
+			return fmt.Errorf("fail to transfer funds from asgard to reserve: %w", err)
+		}
+		return nil
+	}
+	vaultData, err := k.GetVaultData(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get vault data: %w", err)
+	}
+	vaultData.TotalReserve = vaultData.TotalReserve.Add(amount)
+	return k.SetVaultData(ctx, vaultData)
+}
+
+// DeductFromReserve move the given amount of RUNE from the reserve back to asgard, it fails when the reserve doesn't
+// have enough RUNE
+func (k KVStore) DeductFromReserve(ctx sdk.Context, amount sdk.Uint) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if balance := k.GetReserveBalance(ctx); balance.LT(amount) {
+		return fmt.Errorf("reserve balance(%s) is less than %s", balance, amount)
+	}
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		coin := common.NewCoin(common.RuneNative, amount)
+		if err := k.SendFromModuleToModule(ctx, ReserveName, AsgardName, coin); err != nil {
+			return fmt.Errorf("fail to transfer funds from reserve to asgard: %w", err)
+		}
+		return nil
+	}
+	vaultData, err := k.GetVaultData(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get vault data: %w", err)
+	}
+	vaultData.TotalReserve = common.SafeSub(vaultData.TotalReserve, amount)
+	return k.SetVaultData(ctx, vaultData)
+}
+
 func (k KVStore) GetReservesContributors(ctx sdk.Context) (ReserveContributors, error) {
 	contributors := make(ReserveContributors, 0)
 	key := k.GetKey(ctx, prefixReserves, "")
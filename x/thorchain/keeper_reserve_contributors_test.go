@@ -23,3 +23,22 @@ func (KeeperReserveContributorsSuite) TestReserveContributors(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(r, NotNil)
 }
+
+func (KeeperReserveContributorsSuite) TestReserveBalance(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Check(k.GetReserveBalance(ctx).IsZero(), Equals, true)
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		FundModule(c, ctx, k, AsgardName, 100)
+	}
+
+	c.Assert(k.AddToReserve(ctx, sdk.NewUint(60*common.One)), IsNil)
+	c.Check(k.GetReserveBalance(ctx).Equal(sdk.NewUint(60*common.One)), Equals, true)
+	c.Assert(k.AddToReserve(ctx, sdk.ZeroUint()), IsNil)
+
+	c.Assert(k.DeductFromReserve(ctx, sdk.NewUint(20*common.One)), IsNil)
+	c.Check(k.GetReserveBalance(ctx).Equal(sdk.NewUint(40*common.One)), Equals, true)
+
+	// reserve doesn't have enough RUNE
+	c.Assert(k.DeductFromReserve(ctx, sdk.NewUint(41*common.One)), NotNil)
+	c.Check(k.GetReserveBalance(ctx).Equal(sdk.NewUint(40*common.One)), Equals, true)
+}
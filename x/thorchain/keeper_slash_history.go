@@ -0,0 +1,91 @@
+package thorchain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// slashHistoryRetention is the number of blocks a slash record will be kept for
+const slashHistoryRetention = 100000
+
+// slashHistoryInterval is the number of blocks the slashes of a node account are aggregated over, a node slashed
+// every block for the same reason still only gets one record per interval
+const slashHistoryInterval = 720
+
+type KeeperSlashHistory interface {
+	RecordSlash(ctx sdk.Context, addr sdk.AccAddress, reason string, amount int64) error
+	GetNodeAccountSlashHistory(ctx sdk.Context, addr sdk.AccAddress) (SlashRecords, error)
+}
+
+func (k KVStore) getSlashHistoryKey(ctx sdk.Context, addr sdk.AccAddress, height int64) string {
+	// pad the height , so the records will be iterated in the order of block height
+	return k.GetKey(ctx, prefixSlashHistory, fmt.Sprintf("%s/%020d", addr, height))
+}
+
+// RecordSlash add a slash to the slash history of the given node account. Slashes within the same
+// slashHistoryInterval blocks are aggregated by reason, records that are older than slashHistoryRetention blocks
+// will be pruned
+func (k KVStore) RecordSlash(ctx sdk.Context, addr sdk.AccAddress, reason string, amount int64) error {
+	record := NewSlashRecord(ctx.BlockHeight(), reason, amount)
+	if err := record.Valid(); err != nil {
+		return fmt.Errorf("invalid slash record: %w", err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(k.getSlashHistoryKey(ctx, addr, record.Height-record.Height%slashHistoryInterval))
+	var records SlashRecords
+	exist := store.Has(key)
+	if exist {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &records); err != nil {
+			return dbError(ctx, "Unmarshal: slash records", err)
+		}
+	}
+	records = records.Add(record)
+	buf, err := k.cdc.MarshalBinaryBare(records)
+	if err != nil {
+		return dbError(ctx, "fail to marshal slash records to binary", err)
+	}
+	store.Set(key, buf)
+	if !exist {
+		// a new interval just started , the records of the oldest one might be out of the retention now
+		k.pruneSlashHistory(ctx, addr)
+	}
+	return nil
+}
+
+// pruneSlashHistory remove the slash records of the given node account that are older than slashHistoryRetention blocks
+func (k KVStore) pruneSlashHistory(ctx sdk.Context, addr sdk.AccAddress) {
+	height := ctx.BlockHeight() - slashHistoryRetention
+	if height <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := k.getSlashHistoryKey(ctx, addr, 0)
+	end := k.getSlashHistoryKey(ctx, addr, height)
+	iter := store.Iterator([]byte(start), []byte(end))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetNodeAccountSlashHistory return all the slash records of the given node account, in the order of block height
+func (k KVStore) GetNodeAccountSlashHistory(ctx sdk.Context, addr sdk.AccAddress) (SlashRecords, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := k.GetKey(ctx, prefixSlashHistory, addr.String()+"/")
+	iter := sdk.KVStorePrefixIterator(store, []byte(prefix))
+	defer iter.Close()
+	history := make(SlashRecords, 0)
+	for ; iter.Valid(); iter.Next() {
+		var records SlashRecords
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &records); err != nil {
+			return nil, dbError(ctx, "Unmarshal: slash records", err)
+		}
+		history = append(history, records...)
+	}
+	return history, nil
+}
@@ -0,0 +1,49 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type KeeperSlashHistorySuite struct{}
+
+var _ = Suite(&KeeperSlashHistorySuite{})
+
+func (s *KeeperSlashHistorySuite) TestSlashHistory(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+
+	history, err := k.GetNodeAccountSlashHistory(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 0)
+
+	// invalid records
+	c.Assert(k.RecordSlash(ctx, addr, "", 2), NotNil)
+	c.Assert(k.RecordSlash(ctx, addr, "fail to keysign", 0), NotNil)
+
+	c.Assert(k.RecordSlash(ctx.WithBlockHeight(10), addr, "fail to keygen", 3), IsNil)
+	c.Assert(k.RecordSlash(ctx.WithBlockHeight(20), addr, "fail to keysign", 2), IsNil)
+	// slashes of the same reason within an interval are aggregated
+	for height := int64(21); height <= 30; height++ {
+		c.Assert(k.RecordSlash(ctx.WithBlockHeight(height), addr, "lack of observation", 1), IsNil)
+	}
+	c.Assert(k.RecordSlash(ctx.WithBlockHeight(slashHistoryInterval+10), addr, "fail to keysign", 2), IsNil)
+	// slash of other node account
+	c.Assert(k.RecordSlash(ctx.WithBlockHeight(10), GetRandomBech32Addr(), "fail to keygen", 3), IsNil)
+
+	history, err = k.GetNodeAccountSlashHistory(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 4)
+	c.Check(history[0], Equals, NewSlashRecord(10, "fail to keygen", 3))
+	c.Check(history[1], Equals, NewSlashRecord(20, "fail to keysign", 2))
+	c.Check(history[2], Equals, SlashRecord{Height: 30, Reason: "lack of observation", Amount: 10, Count: 10})
+	c.Check(history[3], Equals, NewSlashRecord(slashHistoryInterval+10, "fail to keysign", 2))
+
+	// records older than the retention get pruned
+	height := int64(slashHistoryInterval + slashHistoryRetention)
+	c.Assert(k.RecordSlash(ctx.WithBlockHeight(height), addr, "fail to keysign", 2), IsNil)
+	history, err = k.GetNodeAccountSlashHistory(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 2)
+	c.Check(history[0].Height, Equals, int64(slashHistoryInterval+10))
+	c.Check(history[1].Height, Equals, height)
+}
@@ -0,0 +1,42 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type KeeperObservedSlashVoter interface {
+	SetObservedSlashVoter(_ sdk.Context, _ ObservedSlashVoter)
+	GetObservedSlashVoterIterator(_ sdk.Context) sdk.Iterator
+	GetObservedSlashVoter(_ sdk.Context, _ sdk.AccAddress, _ int64, _ string, _ sdk.Uint) (ObservedSlashVoter, error)
+}
+
+// SetObservedSlashVoter - save a slash voter object
+func (k KVStore) SetObservedSlashVoter(ctx sdk.Context, slash ObservedSlashVoter) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixSlashVoter, slash.String())
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(slash))
+}
+
+// GetObservedSlashVoterIterator iterate slash voters
+func (k KVStore) GetObservedSlashVoterIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, []byte(prefixSlashVoter))
+}
+
+// GetObservedSlashVoter - gets the slash voter of the given node account, block height, reason and amount
+func (k KVStore) GetObservedSlashVoter(ctx sdk.Context, nodeAddress sdk.AccAddress, height int64, reason string, amount sdk.Uint) (ObservedSlashVoter, error) {
+	slash := NewObservedSlashVoter(nodeAddress, height, reason, amount)
+	key := k.GetKey(ctx, prefixSlashVoter, slash.String())
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return slash, nil
+	}
+
+	bz := store.Get([]byte(key))
+	var record ObservedSlashVoter
+	if err := k.cdc.UnmarshalBinaryBare(bz, &record); err != nil {
+		return slash, dbError(ctx, "Unmarshal: slash voter", err)
+	}
+	return record, nil
+}
@@ -0,0 +1,41 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperObservedSlashVoterSuite struct{}
+
+var _ = Suite(&KeeperObservedSlashVoterSuite{})
+
+func (s *KeeperObservedSlashVoterSuite) TestObservedSlashVoter(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	nodeAddr := GetRandomBech32Addr()
+	amt := sdk.NewUint(common.One)
+	voter := NewObservedSlashVoter(nodeAddr, 1024, "misbehave", amt)
+	voter.Sign(GetRandomBech32Addr())
+
+	k.SetObservedSlashVoter(ctx, voter)
+	voter, err := k.GetObservedSlashVoter(ctx, nodeAddr, 1024, "misbehave", amt)
+	c.Assert(err, IsNil)
+	c.Check(voter.NodeAddress.Equals(nodeAddr), Equals, true)
+	c.Check(voter.Signers, HasLen, 1)
+
+	// a different reason is a different vote
+	voter, err = k.GetObservedSlashVoter(ctx, nodeAddr, 1024, "other", amt)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+
+	// so is a different block height
+	voter, err = k.GetObservedSlashVoter(ctx, nodeAddr, 1025, "misbehave", amt)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+
+	iter := k.GetObservedSlashVoterIterator(ctx)
+	c.Check(iter, NotNil)
+	iter.Close()
+}
@@ -1,6 +1,10 @@
 package thorchain
 
 import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -10,6 +14,10 @@ type KeeperObservedTx interface {
 	SetObservedTxVoter(ctx sdk.Context, tx ObservedTxVoter)
 	GetObservedTxVoterIterator(ctx sdk.Context) sdk.Iterator
 	GetObservedTxVoter(ctx sdk.Context, hash common.TxID) (ObservedTxVoter, error)
+	AddObservedOutboundTx(ctx sdk.Context, height int64, txID common.TxID) error
+	GetObservedOutboundTxByHeight(ctx sdk.Context, height int64) (common.TxIDs, error)
+	PruneObservedOutboundTx(ctx sdk.Context, height int64) error
+	GetMissingOutbounds(ctx sdk.Context, height int64, scheduledTxOuts []TxOutItem) ([]TxOutItem, error)
 }
 
 // SetObservedTxVoter - save a txin voter object
@@ -41,3 +49,118 @@ func (k KVStore) GetObservedTxVoter(ctx sdk.Context, hash common.TxID) (Observed
 	}
 	return record, nil
 }
+
+// getOutboundByHeightKey the height is appended in big endian after the versioned prefix, so the index is iterated in
+// the order of block height
+func (k KVStore) getOutboundByHeightKey(ctx sdk.Context, height int64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(height))
+	return append([]byte(k.GetKey(ctx, prefixOutboundByHeight, "")), buf...)
+}
+
+// AddObservedOutboundTx index the observed outbound tx id by the block height its tx out item was scheduled at
+func (k KVStore) AddObservedOutboundTx(ctx sdk.Context, height int64, txID common.TxID) error {
+	if height <= 0 {
+		return fmt.Errorf("invalid block height: %d", height)
+	}
+	txIDs, err := k.GetObservedOutboundTxByHeight(ctx, height)
+	if err != nil {
+		return err
+	}
+	for _, item := range txIDs {
+		if item.Equals(txID) {
+			return nil
+		}
+	}
+	txIDs = append(txIDs, txID)
+	buf, err := k.cdc.MarshalBinaryBare(txIDs)
+	if err != nil {
+		return dbError(ctx, "fail to marshal observed outbound tx ids to binary", err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(k.getOutboundByHeightKey(ctx, height), buf)
+	return nil
+}
+
+// GetObservedOutboundTxByHeight return the observed outbound tx ids of the tx out items scheduled at the given block height
+func (k KVStore) GetObservedOutboundTxByHeight(ctx sdk.Context, height int64) (common.TxIDs, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.getOutboundByHeightKey(ctx, height)
+	if !store.Has(key) {
+		return nil, nil
+	}
+	var txIDs common.TxIDs
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &txIDs); err != nil {
+		return nil, dbError(ctx, "Unmarshal: observed outbound tx ids", err)
+	}
+	return txIDs, nil
+}
+
+// PruneObservedOutboundTx remove the observed outbound index of all the block heights before the given block height
+func (k KVStore) PruneObservedOutboundTx(ctx sdk.Context, height int64) error {
+	if height <= 0 {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(k.getOutboundByHeightKey(ctx, 0), k.getOutboundByHeightKey(ctx, height))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetMissingOutbounds compare the tx out items scheduled at the given block height with the outbound txs observed for
+// them, and return the tx out items that haven't been observed. One observed tx can only account for one tx out item
+func (k KVStore) GetMissingOutbounds(ctx sdk.Context, height int64, scheduledTxOuts []TxOutItem) ([]TxOutItem, error) {
+	txIDs, err := k.GetObservedOutboundTxByHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get observed outbound txs: %w", err)
+	}
+	observed := make([]ObservedTx, 0, len(txIDs))
+	for _, txID := range txIDs {
+		voter, err := k.GetObservedTxVoter(ctx, txID)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get observed tx voter(%s): %w", txID, err)
+		}
+		observed = append(observed, voter.Tx)
+	}
+	matched := make([]bool, len(observed))
+	missing := make([]TxOutItem, 0)
+	for _, item := range scheduledTxOuts {
+		found := false
+		for i, tx := range observed {
+			if !matched[i] && isObservedOutbound(item, tx) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, item)
+		}
+	}
+	return missing, nil
+}
+
+// isObservedOutbound check whether the observed tx is the outbound of the given tx out item, tx out items without an
+// amount (yggdrasil return) match any amount of the coin. The memo is only compared when the observed tx has one, as
+// the memo of an outbound could also be recovered from its tx marker
+func isObservedOutbound(item TxOutItem, tx ObservedTx) bool {
+	if !item.Chain.Equals(tx.Tx.Chain) ||
+		!item.ToAddress.Equals(tx.Tx.ToAddress) ||
+		!item.VaultPubKey.Equals(tx.ObservedPubKey) {
+		return false
+	}
+	if len(item.Memo) > 0 && len(tx.Tx.Memo) > 0 && !strings.EqualFold(item.Memo, tx.Tx.Memo) {
+		return false
+	}
+	if item.Coin.Amount.IsZero() {
+		return true
+	}
+	return tx.Tx.Coins.Equals(common.Coins{item.Coin})
+}
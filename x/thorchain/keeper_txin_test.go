@@ -1,7 +1,10 @@
 package thorchain
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type KeeperTxInSuite struct{}
@@ -28,3 +31,67 @@ func (s *KeeperTxInSuite) TestTxInVoter(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(voter.TxID.Equals(tx.ID), Equals, true)
 }
+
+func (s *KeeperTxInSuite) TestObservedOutboundTxByHeight(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	txIDs, err := k.GetObservedOutboundTxByHeight(ctx, 10)
+	c.Assert(err, IsNil)
+	c.Check(txIDs, HasLen, 0)
+	c.Check(k.AddObservedOutboundTx(ctx, 0, GetRandomTxHash()), NotNil)
+
+	inHash := GetRandomTxHash()
+	vaultPubKey := GetRandomPubKey()
+	item1 := TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vaultPubKey,
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(common.One)),
+		Memo:        NewOutboundMemo(inHash).String(),
+		InHash:      inHash,
+	}
+	item2 := item1
+	item2.Coin = common.NewCoin(common.RuneAsset(), sdk.NewUint(2*common.One))
+	item3 := item1
+	item3.ToAddress = GetRandomBNBAddress()
+
+	tx := GetRandomTx()
+	tx.ToAddress = item1.ToAddress
+	tx.Coins = common.Coins{item1.Coin}
+	tx.Memo = item1.Memo
+	voter := NewObservedTxVoter(tx.ID, nil)
+	voter.Tx = NewObservedTx(tx, 12, vaultPubKey)
+	k.SetObservedTxVoter(ctx, voter)
+	c.Assert(k.AddObservedOutboundTx(ctx, 10, tx.ID), IsNil)
+	// the same tx is only indexed once
+	c.Assert(k.AddObservedOutboundTx(ctx, 10, tx.ID), IsNil)
+	c.Assert(k.AddObservedOutboundTx(ctx, 11, GetRandomTxHash()), IsNil)
+	txIDs, err = k.GetObservedOutboundTxByHeight(ctx, 10)
+	c.Assert(err, IsNil)
+	c.Assert(txIDs, HasLen, 1)
+	c.Check(txIDs[0].Equals(tx.ID), Equals, true)
+
+	missing, err := k.GetMissingOutbounds(ctx, 10, []TxOutItem{item1, item2, item3})
+	c.Assert(err, IsNil)
+	c.Assert(missing, HasLen, 2)
+	c.Check(missing[0].Coin.Equals(item2.Coin), Equals, true)
+	c.Check(missing[1].ToAddress.Equals(item3.ToAddress), Equals, true)
+
+	// one observed tx only accounts for one tx out item
+	missing, err = k.GetMissingOutbounds(ctx, 10, []TxOutItem{item1, item1})
+	c.Assert(err, IsNil)
+	c.Check(missing, HasLen, 1)
+
+	missing, err = k.GetMissingOutbounds(ctx, 12, []TxOutItem{item1})
+	c.Assert(err, IsNil)
+	c.Check(missing, HasLen, 1)
+
+	// prune the heights before 11
+	c.Assert(k.PruneObservedOutboundTx(ctx, 11), IsNil)
+	txIDs, err = k.GetObservedOutboundTxByHeight(ctx, 10)
+	c.Assert(err, IsNil)
+	c.Check(txIDs, HasLen, 0)
+	txIDs, err = k.GetObservedOutboundTxByHeight(ctx, 11)
+	c.Assert(err, IsNil)
+	c.Check(txIDs, HasLen, 1)
+}
@@ -1,9 +1,14 @@
 package thorchain
 
 import (
+	"fmt"
 	"strconv"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperTxOut interface {
@@ -11,6 +16,7 @@ type KeeperTxOut interface {
 	AppendTxOut(ctx sdk.Context, height int64, item *TxOutItem) error
 	GetTxOutIterator(ctx sdk.Context) sdk.Iterator
 	GetTxOut(ctx sdk.Context, height int64) (*TxOut, error)
+	GetTxOutsForSigner(ctx sdk.Context, pubKey common.PubKey, version semver.Version) ([]TxOutItem, error)
 }
 
 // AppendTxOut - append a given item to txOut
@@ -32,9 +38,39 @@ func (k KVStore) SetTxOut(ctx sdk.Context, blockOut *TxOut) error {
 		return dbError(ctx, "fail to marshal tx out to binary", err)
 	}
 	store.Set([]byte(key), buf)
+	k.setTxOutSignerIndex(ctx, blockOut)
 	return nil
 }
 
+// setTxOutSignerIndex keep an index between vault pubkey and the block height it has tx out items to sign
+// once all the items of a vault in the block had been completed, the index will be removed
+func (k KVStore) setTxOutSignerIndex(ctx sdk.Context, blockOut *TxOut) {
+	store := ctx.KVStore(k.storeKey)
+	var pubKeys common.PubKeys
+	pending := make(map[common.PubKey]bool)
+	for _, item := range blockOut.TxArray {
+		if item.VaultPubKey.IsEmpty() {
+			continue
+		}
+		if _, ok := pending[item.VaultPubKey]; !ok {
+			pubKeys = append(pubKeys, item.VaultPubKey)
+		}
+		pending[item.VaultPubKey] = pending[item.VaultPubKey] || item.OutHash.IsEmpty()
+	}
+	for _, pk := range pubKeys {
+		key := k.getTxOutSignerKey(ctx, pk, blockOut.Height)
+		if pending[pk] {
+			store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(blockOut.Height))
+		} else {
+			store.Delete([]byte(key))
+		}
+	}
+}
+
+func (k KVStore) getTxOutSignerKey(ctx sdk.Context, pubKey common.PubKey, height int64) string {
+	return k.GetKey(ctx, prefixTxOutBySigner, fmt.Sprintf("%s/%d", pubKey, height))
+}
+
 // GetTxOutIterator iterate tx out
 func (k KVStore) GetTxOutIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -55,3 +91,37 @@ func (k KVStore) GetTxOut(ctx sdk.Context, height int64) (*TxOut, error) {
 	}
 	return txOut, nil
 }
+
+// GetTxOutsForSigner return all the tx out items that still need to be signed by the given vault pubkey
+// tx out items that are older than SigningTransactionPeriod will not be returned, since signer will not sign them anyway
+func (k KVStore) GetTxOutsForSigner(ctx sdk.Context, pubKey common.PubKey, version semver.Version) ([]TxOutItem, error) {
+	constAccessor := constants.GetConstantValues(version)
+	if constAccessor == nil {
+		return nil, fmt.Errorf("constants for version(%s) is not available", version)
+	}
+	signingPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
+	store := ctx.KVStore(k.storeKey)
+	prefix := k.GetKey(ctx, prefixTxOutBySigner, pubKey.String()+"/")
+	iter := sdk.KVStorePrefixIterator(store, []byte(prefix))
+	defer iter.Close()
+	var items []TxOutItem
+	for ; iter.Valid(); iter.Next() {
+		var height int64
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &height); err != nil {
+			return nil, dbError(ctx, "fail to unmarshal tx out signer index", err)
+		}
+		if ctx.BlockHeight()-height > signingPeriod {
+			continue
+		}
+		txOut, err := k.GetTxOut(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get tx out at height(%d): %w", height, err)
+		}
+		for _, item := range txOut.TxArray {
+			if item.VaultPubKey.Equals(pubKey) && item.OutHash.IsEmpty() {
+				items = append(items, *item)
+			}
+		}
+	}
+	return items, nil
+}
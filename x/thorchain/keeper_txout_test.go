@@ -5,6 +5,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperTxOutSuite struct{}
@@ -35,3 +36,70 @@ func (KeeperTxOutSuite) TestKeeperTxOut(c *C) {
 	iter := k.GetTxOutIterator(ctx)
 	defer iter.Close()
 }
+
+func (KeeperTxOutSuite) TestGetTxOutsForSigner(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	pk1 := GetRandomPubKey()
+	pk2 := GetRandomPubKey()
+
+	items, err := k.GetTxOutsForSigner(ctx, pk1, ver)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+
+	txOut := NewTxOut(5)
+	txOut.TxArray = append(txOut.TxArray, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: pk1,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+	}, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: pk2,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(200*common.One)),
+	})
+	c.Assert(k.SetTxOut(ctx, txOut), IsNil)
+	c.Assert(k.AppendTxOut(ctx, 6, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: pk1,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(300*common.One)),
+	}), IsNil)
+
+	items, err = k.GetTxOutsForSigner(ctx, pk1, ver)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	for _, item := range items {
+		c.Check(item.VaultPubKey.Equals(pk1), Equals, true)
+	}
+	items, err = k.GetTxOutsForSigner(ctx, pk2, ver)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Amount.Equal(sdk.NewUint(200*common.One)), Equals, true)
+
+	// complete the tx out item of pk1 at height 5 , index should be removed
+	txOut, err = k.GetTxOut(ctx, 5)
+	c.Assert(err, IsNil)
+	txOut.TxArray[0].OutHash = GetRandomTxHash()
+	c.Assert(k.SetTxOut(ctx, txOut), IsNil)
+	kvStore, ok := k.(KVStore)
+	c.Assert(ok, Equals, true)
+	store := ctx.KVStore(kvStore.storeKey)
+	c.Check(store.Has([]byte(kvStore.getTxOutSignerKey(ctx, pk1, 5))), Equals, false)
+	c.Check(store.Has([]byte(kvStore.getTxOutSignerKey(ctx, pk2, 5))), Equals, true)
+	items, err = k.GetTxOutsForSigner(ctx, pk1, ver)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Amount.Equal(sdk.NewUint(300*common.One)), Equals, true)
+
+	// tx out items older than signing period will not be returned
+	ctx = ctx.WithBlockHeight(10 + constants.GetConstantValues(ver).GetInt64Value(constants.SigningTransactionPeriod))
+	items, err = k.GetTxOutsForSigner(ctx, pk1, ver)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+}
@@ -1,9 +1,13 @@
 package thorchain
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -18,6 +22,12 @@ type KeeperVault interface {
 	GetAsgardVaults(ctx sdk.Context) (Vaults, error)
 	GetAsgardVaultsByStatus(_ sdk.Context, _ VaultStatus) (Vaults, error)
 	DeleteVault(ctx sdk.Context, pk common.PubKey) error
+	SetVaultStatus(ctx sdk.Context, pk common.PubKey, status VaultStatus, height int64) error
+	TransferFunds(ctx sdk.Context, from, to common.PubKey, coin common.Coin) error
+	GetAsgardVaultWithMostRune(ctx sdk.Context) (Vault, error)
+	GetYggdrasilVaults(ctx sdk.Context) (Vaults, error)
+	GetYggdrasilVaultByPubKey(ctx sdk.Context, pk common.PubKey) (Vault, error)
+	GetTotalYggdrasilBalance(ctx sdk.Context, asset common.Asset) (sdk.Uint, error)
 }
 
 // GetVaultIterator only iterate vault pools
@@ -39,7 +49,122 @@ func (k KVStore) SetVault(ctx sdk.Context, vault Vault) error {
 			return err
 		}
 	}
+	// the yggdrasil index is only maintained from version 0.3.0, the vaults saved before are indexed by a store migration
+	if k.GetThorchainVersion(ctx).GTE(semver.MustParse("0.3.0")) {
+		yggKey := k.GetKey(ctx, prefixYggdrasilVault, vault.PubKey.String())
+		if vault.IsYggdrasil() {
+			store.Set([]byte(yggKey), k.cdc.MustMarshalBinaryBare(vault.PubKey))
+		} else {
+			store.Delete([]byte(yggKey))
+		}
+	}
+	// the RUNE rank is only maintained from version 0.3.0, the vaults saved before are ranked by a store migration
+	rank := k.GetThorchainVersion(ctx).GTE(semver.MustParse("0.3.0"))
+	if rank && store.Has([]byte(key)) {
+		old, err := k.GetVault(ctx, vault.PubKey)
+		if err != nil {
+			return err
+		}
+		k.removeVaultRuneRank(ctx, old)
+	}
 	store.Set([]byte(key), buf)
+	if rank {
+		k.setVaultRuneRank(ctx, vault)
+	}
+	return nil
+}
+
+// getVaultRuneRankKey the key is made of (MaxInt64 - RUNE balance) in big endian, so iterating the
+// rank gives the vault with the most RUNE first
+func (k KVStore) getVaultRuneRankKey(ctx sdk.Context, vault Vault) string {
+	runeAmt := vault.GetCoin(common.RuneAsset()).Amount
+	rank := uint64(0)
+	if runeAmt.LT(sdk.NewUint(math.MaxInt64)) {
+		rank = uint64(math.MaxInt64) - runeAmt.Uint64()
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, rank)
+	return k.GetKey(ctx, prefixVaultRuneRank, fmt.Sprintf("%s/%s", hex.EncodeToString(buf), vault.PubKey))
+}
+
+// setVaultRuneRank rank active asgard vaults by their RUNE balance
+func (k KVStore) setVaultRuneRank(ctx sdk.Context, vault Vault) {
+	if !vault.IsAsgard() || vault.Status != ActiveVault {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getVaultRuneRankKey(ctx, vault)), k.cdc.MustMarshalBinaryBare(vault.PubKey))
+}
+
+func (k KVStore) removeVaultRuneRank(ctx sdk.Context, vault Vault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(k.getVaultRuneRankKey(ctx, vault)))
+}
+
+// GetAsgardVaultWithMostRune return the active asgard vault that has the most RUNE, the RUNE rank it reads is only
+// maintained from version 0.3.0
+func (k KVStore) GetAsgardVaultWithMostRune(ctx sdk.Context) (Vault, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(k.GetKey(ctx, prefixVaultRuneRank, "")))
+	defer iter.Close()
+	if !iter.Valid() {
+		return Vault{}, fmt.Errorf("no active asgard vault: %w", ErrVaultNotFound)
+	}
+	var pk common.PubKey
+	if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &pk); err != nil {
+		return Vault{}, dbError(ctx, "fail to unmarshal vault rune rank", err)
+	}
+	return k.GetVault(ctx, pk)
+}
+
+// SetVaultStatus move the vault with the given pubkey to the given status, StatusSince will be set to the given height
+// it fails when the vault doesn't exist, or the vault is not allowed to move from its current status to the given status
+func (k KVStore) SetVaultStatus(ctx sdk.Context, pk common.PubKey, status VaultStatus, height int64) error {
+	vault, err := k.GetVault(ctx, pk)
+	if err != nil {
+		return err
+	}
+	if err := NewVaultStatusTransitionManager().Transition(vault.Status, status); err != nil {
+		return fmt.Errorf("fail to update status of vault(%s): %w", pk, err)
+	}
+	vault.UpdateStatus(status, height)
+	return k.SetVault(ctx, vault)
+}
+
+// TransferFunds move the given coin from one vault to another, both vaults need to exist and the vault the fund moved
+// from need to have enough balance
+func (k KVStore) TransferFunds(ctx sdk.Context, from, to common.PubKey, coin common.Coin) error {
+	if from.Equals(to) {
+		return fmt.Errorf("can't transfer funds from vault(%s) to itself", from)
+	}
+	if err := coin.IsValid(); err != nil {
+		return fmt.Errorf("invalid coin: %w", err)
+	}
+	fromVault, err := k.GetVault(ctx, from)
+	if err != nil {
+		return fmt.Errorf("fail to get vault(%s): %w", from, err)
+	}
+	toVault, err := k.GetVault(ctx, to)
+	if err != nil {
+		return fmt.Errorf("fail to get vault(%s): %w", to, err)
+	}
+	balance := fromVault.GetCoin(coin.Asset).Amount
+	if balance.LT(coin.Amount) {
+		return fmt.Errorf("vault(%s) has insufficient %s balance, %s < %s", from, coin.Asset, balance, coin.Amount)
+	}
+	fromVault.SubFunds(common.Coins{coin})
+	toVault.AddFunds(common.Coins{coin})
+	if err := k.SetVault(ctx, fromVault); err != nil {
+		return fmt.Errorf("fail to save vault(%s): %w", from, err)
+	}
+	if err := k.SetVault(ctx, toVault); err != nil {
+		return fmt.Errorf("fail to save vault(%s): %w", to, err)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("transfer",
+			sdk.NewAttribute("from", from.String()),
+			sdk.NewAttribute("to", to.String()),
+			sdk.NewAttribute("coin", coin.String())))
 	return nil
 }
 
@@ -189,9 +314,54 @@ func (k KVStore) DeleteVault(ctx sdk.Context, pubkey common.PubKey) error {
 			store.Delete([]byte(key))
 		}
 	}
+	k.removeVaultRuneRank(ctx, vault)
 	// delete the actual vault
 	key := k.GetKey(ctx, prefixVaultPool, vault.PubKey.String())
 	store := ctx.KVStore(k.storeKey)
 	store.Delete([]byte(key))
+	store.Delete([]byte(k.GetKey(ctx, prefixYggdrasilVault, vault.PubKey.String())))
 	return nil
 }
+
+// GetYggdrasilVaults return all the yggdrasil vaults, the yggdrasil index it reads is only maintained from version 0.3.0
+func (k KVStore) GetYggdrasilVaults(ctx sdk.Context) (Vaults, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(k.GetKey(ctx, prefixYggdrasilVault, "")))
+	defer iter.Close()
+	var yggs Vaults
+	for ; iter.Valid(); iter.Next() {
+		var pk common.PubKey
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &pk); err != nil {
+			return nil, dbError(ctx, "fail to unmarshal yggdrasil index", err)
+		}
+		vault, err := k.GetVault(ctx, pk)
+		if err != nil {
+			return nil, err
+		}
+		yggs = append(yggs, vault)
+	}
+	return yggs, nil
+}
+
+// GetYggdrasilVaultByPubKey return the yggdrasil vault with the given pubkey, it fails when the vault
+// doesn't exist or is not a yggdrasil vault
+func (k KVStore) GetYggdrasilVaultByPubKey(ctx sdk.Context, pk common.PubKey) (Vault, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(k.GetKey(ctx, prefixYggdrasilVault, pk.String()))) {
+		return Vault{}, fmt.Errorf("yggdrasil vault with pubkey(%s) doesn't exist: %w", pk, ErrVaultNotFound)
+	}
+	return k.GetVault(ctx, pk)
+}
+
+// GetTotalYggdrasilBalance sum the balance of the given asset across all yggdrasil vaults
+func (k KVStore) GetTotalYggdrasilBalance(ctx sdk.Context, asset common.Asset) (sdk.Uint, error) {
+	yggs, err := k.GetYggdrasilVaults(ctx)
+	if err != nil {
+		return sdk.ZeroUint(), fmt.Errorf("fail to get yggdrasil vaults: %w", err)
+	}
+	total := sdk.ZeroUint()
+	for _, ygg := range yggs {
+		total = total.Add(ygg.GetCoin(asset).Amount)
+	}
+	return total, nil
+}
@@ -48,13 +48,11 @@ func (k KVStore) getEnabledPoolsAndTotalStakedRune(ctx sdk.Context) (Pools, sdk.
 	// First get active pools and total staked Rune
 	totalStaked := sdk.ZeroUint()
 	var pools Pools
-	iterator := k.GetPoolIterator(ctx)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var pool Pool
-		if err := k.Cdc().UnmarshalBinaryBare(iterator.Value(), &pool); err != nil {
-			return nil, sdk.ZeroUint(), fmt.Errorf("fail to unmarhsl pool: %w", err)
-		}
+	allPools, err := k.GetAllPoolsSorted(ctx)
+	if err != nil {
+		return nil, sdk.ZeroUint(), fmt.Errorf("fail to get pools: %w", err)
+	}
+	for _, pool := range allPools {
 		if pool.IsEnabled() && !pool.BalanceRune.IsZero() {
 			totalStaked = totalStaked.Add(pool.BalanceRune)
 			pools = append(pools, pool)
@@ -70,7 +68,11 @@ func (k KVStore) getTotalActiveBond(ctx sdk.Context) (sdk.Uint, error) {
 		return sdk.ZeroUint(), fmt.Errorf("fail to get all active accounts: %w", err)
 	}
 	for _, node := range nodes {
-		totalBonded = totalBonded.Add(node.Bond)
+		bond, err := getNodeBondWithRewards(ctx, k, node)
+		if err != nil {
+			return sdk.ZeroUint(), err
+		}
+		totalBonded = totalBonded.Add(bond)
 	}
 	return totalBonded, nil
 }
@@ -5,6 +5,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type KeeperVaultSuite struct{}
@@ -53,3 +54,179 @@ func (s *KeeperVaultSuite) TestVault(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(asgards, HasLen, 0)
 }
+
+func (s *KeeperVaultSuite) TestSetVaultStatus(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pubKey := GetRandomPubKey()
+	c.Assert(k.SetVaultStatus(ctx, pubKey, RetiringVault, 10), NotNil) // vault doesn't exist
+
+	asgard := NewVault(1, ActiveVault, AsgardVault, pubKey, common.Chains{common.BNBChain})
+	c.Assert(k.SetVault(ctx, asgard), IsNil)
+	c.Assert(k.SetVaultStatus(ctx, pubKey, RetiringVault, 10), IsNil)
+	vault, err := k.GetVault(ctx, pubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, RetiringVault)
+	c.Check(vault.StatusSince, Equals, int64(10))
+
+	// retiring vault can't become active again
+	c.Assert(k.SetVaultStatus(ctx, pubKey, ActiveVault, 11), NotNil)
+	c.Assert(k.SetVaultStatus(ctx, pubKey, InactiveVault, 12), IsNil)
+	vault, err = k.GetVault(ctx, pubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, InactiveVault)
+	c.Check(vault.StatusSince, Equals, int64(12))
+}
+
+func (s *KeeperVaultSuite) TestGetAsgardVaultWithMostRune(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	_, err := k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, NotNil)
+
+	newAsgard := func(runeAmt uint64) Vault {
+		vault := NewVault(ctx.BlockHeight(), ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+		vault.Coins = common.Coins{
+			common.NewCoin(common.RuneAsset(), types.NewUint(runeAmt)),
+		}
+		c.Assert(k.SetVault(ctx, vault), IsNil)
+		return vault
+	}
+	asgard1 := newAsgard(100 * common.One)
+	asgard2 := newAsgard(200 * common.One)
+	// yggdrasil vaults are not ranked
+	ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	ygg.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), types.NewUint(1000*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+
+	vault, err := k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.Equals(asgard2.PubKey), Equals, true)
+
+	// update balance, the rank should follow
+	asgard1.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), types.NewUint(300*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, asgard1), IsNil)
+	vault, err = k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.Equals(asgard1.PubKey), Equals, true)
+
+	asgard1.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), types.NewUint(50*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, asgard1), IsNil)
+	vault, err = k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.Equals(asgard2.PubKey), Equals, true)
+
+	// retiring vault is no longer ranked
+	c.Assert(k.SetVaultStatus(ctx, asgard2.PubKey, RetiringVault, ctx.BlockHeight()), IsNil)
+	vault, err = k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.Equals(asgard1.PubKey), Equals, true)
+
+	// deleted vault is removed from the rank
+	asgard1.Coins = common.Coins{}
+	c.Assert(k.SetVault(ctx, asgard1), IsNil)
+	c.Assert(k.DeleteVault(ctx, asgard1.PubKey), IsNil)
+	_, err = k.GetAsgardVaultWithMostRune(ctx)
+	c.Assert(err, NotNil)
+}
+
+func (s *KeeperVaultSuite) TestGetYggdrasilVaults(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Assert(k.SetThorchainVersion(ctx, constants.SWVersion), IsNil)
+	yggs, err := k.GetYggdrasilVaults(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(yggs, HasLen, 0)
+
+	asgard := NewVault(ctx.BlockHeight(), ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	asgard.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, types.NewUint(1000*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, asgard), IsNil)
+	ygg1 := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	ygg1.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, types.NewUint(100*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, ygg1), IsNil)
+	ygg2 := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	ygg2.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, types.NewUint(50*common.One)),
+		common.NewCoin(common.RuneAsset(), types.NewUint(10*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, ygg2), IsNil)
+
+	yggs, err = k.GetYggdrasilVaults(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(yggs, HasLen, 2)
+	for _, ygg := range yggs {
+		c.Check(ygg.IsYggdrasil(), Equals, true)
+	}
+
+	ygg, err := k.GetYggdrasilVaultByPubKey(ctx, ygg1.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(ygg.PubKey.Equals(ygg1.PubKey), Equals, true)
+	_, err = k.GetYggdrasilVaultByPubKey(ctx, asgard.PubKey)
+	c.Assert(err, NotNil)
+	_, err = k.GetYggdrasilVaultByPubKey(ctx, GetRandomPubKey())
+	c.Assert(err, NotNil)
+
+	total, err := k.GetTotalYggdrasilBalance(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(total.Equal(types.NewUint(150*common.One)), Equals, true)
+	total, err = k.GetTotalYggdrasilBalance(ctx, common.RuneAsset())
+	c.Assert(err, IsNil)
+	c.Check(total.Equal(types.NewUint(10*common.One)), Equals, true)
+	total, err = k.GetTotalYggdrasilBalance(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(total.IsZero(), Equals, true)
+
+	// deleted vault is removed from the index
+	ygg1.Coins = common.Coins{}
+	c.Assert(k.SetVault(ctx, ygg1), IsNil)
+	c.Assert(k.DeleteVault(ctx, ygg1.PubKey), IsNil)
+	yggs, err = k.GetYggdrasilVaults(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(yggs, HasLen, 1)
+	c.Check(yggs[0].PubKey.Equals(ygg2.PubKey), Equals, true)
+}
+
+func (s *KeeperVaultSuite) TestTransferFunds(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	asgard := NewVault(ctx.BlockHeight(), ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	asgard.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, types.NewUint(100*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, asgard), IsNil)
+	ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+
+	coin := common.NewCoin(common.BNBAsset, types.NewUint(30*common.One))
+	// vault doesn't exist
+	c.Assert(k.TransferFunds(ctx, GetRandomPubKey(), ygg.PubKey, coin), NotNil)
+	c.Assert(k.TransferFunds(ctx, asgard.PubKey, GetRandomPubKey(), coin), NotNil)
+	// same vault
+	c.Assert(k.TransferFunds(ctx, asgard.PubKey, asgard.PubKey, coin), NotNil)
+	// insufficient balance
+	c.Assert(k.TransferFunds(ctx, ygg.PubKey, asgard.PubKey, coin), NotNil)
+	c.Assert(k.TransferFunds(ctx, asgard.PubKey, ygg.PubKey, common.NewCoin(common.BNBAsset, types.NewUint(101*common.One))), NotNil)
+
+	c.Assert(k.TransferFunds(ctx, asgard.PubKey, ygg.PubKey, coin), IsNil)
+	asgard, err := k.GetVault(ctx, asgard.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(asgard.GetCoin(common.BNBAsset).Amount.Equal(types.NewUint(70*common.One)), Equals, true)
+	ygg, err = k.GetVault(ctx, ygg.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(ygg.GetCoin(common.BNBAsset).Amount.Equal(types.NewUint(30*common.One)), Equals, true)
+
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == "transfer" {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+}
@@ -0,0 +1,62 @@
+package thorchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type KeeperVersion interface {
+	SetThorchainVersion(ctx sdk.Context, version semver.Version) error
+	GetThorchainVersion(ctx sdk.Context) semver.Version
+}
+
+// SetThorchainVersion save the version all the nodes agree to run the handlers with, nothing is written when the
+// version is already the saved one
+func (k KVStore) SetThorchainVersion(ctx sdk.Context, version semver.Version) error {
+	if version.Equals(semver.Version{}) {
+		return dbError(ctx, "fail to set thorchain version", errors.New("empty version"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.getThorchainVersionKey()
+	if store.Has(key) && string(store.Get(key)) == version.String() {
+		return nil
+	}
+	store.Set(key, []byte(version.String()))
+	return nil
+}
+
+// GetThorchainVersion return the saved thorchain version, when there is none it falls back to the lowest version of
+// the active node accounts
+func (k KVStore) GetThorchainVersion(ctx sdk.Context) semver.Version {
+	store := ctx.KVStore(k.storeKey)
+	key := k.getThorchainVersionKey()
+	if !store.Has(key) {
+		return k.GetLowestActiveVersion(ctx)
+	}
+	version, err := semver.Parse(string(store.Get(key)))
+	if err != nil {
+		_ = dbError(ctx, "fail to parse thorchain version", err)
+		return k.GetLowestActiveVersion(ctx)
+	}
+	return version
+}
+
+// updateThorchainVersion a new version becomes the thorchain version once all the active node accounts run it, the
+// thorchain version never goes backward
+func updateThorchainVersion(ctx sdk.Context, keeper Keeper) error {
+	lowestVersion := keeper.GetLowestActiveVersion(ctx)
+	if lowestVersion.Equals(semver.Version{}) || lowestVersion.LT(keeper.GetThorchainVersion(ctx)) {
+		return nil
+	}
+	return keeper.SetThorchainVersion(ctx, lowestVersion)
+}
+
+// getThorchainVersionKey GetKey picks the key version with the thorchain version, so the key of the thorchain version
+// itself is built without it
+func (k KVStore) getThorchainVersionKey() []byte {
+	version := getVersion(semver.Version{}, prefixThorchainVersion)
+	return []byte(fmt.Sprintf("%s%d/", prefixThorchainVersion, version.Minor))
+}
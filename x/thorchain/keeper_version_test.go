@@ -0,0 +1,64 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	. "gopkg.in/check.v1"
+)
+
+type KeeperVersionSuite struct{}
+
+var _ = Suite(&KeeperVersionSuite{})
+
+func (s *KeeperVersionSuite) TestThorchainVersion(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// falls back to the lowest active version when there is none saved
+	na := GetRandomNodeAccount(NodeActive)
+	na.Version = semver.MustParse("0.2.0")
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	c.Check(k.GetThorchainVersion(ctx).Equals(semver.MustParse("0.2.0")), Equals, true)
+
+	c.Check(k.SetThorchainVersion(ctx, semver.Version{}), NotNil)
+	c.Assert(k.SetThorchainVersion(ctx, semver.MustParse("0.3.0")), IsNil)
+	c.Check(k.GetThorchainVersion(ctx).Equals(semver.MustParse("0.3.0")), Equals, true)
+}
+
+func (s *KeeperVersionSuite) TestUpdateThorchainVersion(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1)
+
+	// no active node account yet
+	c.Assert(updateThorchainVersion(ctx, k), IsNil)
+	c.Check(k.GetThorchainVersion(ctx).Equals(semver.Version{}), Equals, true)
+
+	na1 := GetRandomNodeAccount(NodeActive)
+	na1.Version = semver.MustParse("0.1.0")
+	c.Assert(k.SetNodeAccount(ctx, na1), IsNil)
+	na2 := GetRandomNodeAccount(NodeActive)
+	na2.Version = semver.MustParse("0.1.0")
+	c.Assert(k.SetNodeAccount(ctx, na2), IsNil)
+	c.Assert(updateThorchainVersion(ctx, k), IsNil)
+	c.Check(k.GetThorchainVersion(ctx).Equals(semver.MustParse("0.1.0")), Equals, true)
+
+	// one of the nodes upgraded, the version stays until all of them do
+	ctx = ctx.WithBlockHeight(2)
+	na1.Version = semver.MustParse("0.2.0")
+	c.Assert(k.SetNodeAccount(ctx, na1), IsNil)
+	c.Assert(updateThorchainVersion(ctx, k), IsNil)
+	c.Check(k.GetThorchainVersion(ctx).Equals(semver.MustParse("0.1.0")), Equals, true)
+
+	ctx = ctx.WithBlockHeight(3)
+	na2.Version = semver.MustParse("0.2.0")
+	c.Assert(k.SetNodeAccount(ctx, na2), IsNil)
+	c.Assert(updateThorchainVersion(ctx, k), IsNil)
+	c.Check(k.GetThorchainVersion(ctx).Equals(semver.MustParse("0.2.0")), Equals, true)
+
+	// the version persists in later blocks, and doesn't go backward when a node with an older version becomes active
+	ctx = ctx.WithBlockHeight(4)
+	na3 := GetRandomNodeAccount(NodeActive)
+	na3.Version = semver.MustParse("0.1.0")
+	c.Assert(k.SetNodeAccount(ctx, na3), IsNil)
+	c.Assert(updateThorchainVersion(ctx, k), IsNil)
+	c.Check(k.GetLowestActiveVersion(ctx).Equals(semver.MustParse("0.1.0")), Equals, true)
+	c.Check(k.GetThorchainVersion(ctx.WithBlockHeight(5)).Equals(semver.MustParse("0.2.0")), Equals, true)
+}
@@ -1,14 +1,15 @@
 package thorchain
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 // TXTYPE:STATE1:STATE2:STATE3:FINALMEMO
@@ -34,8 +35,12 @@ const (
 	TxMigrate
 	TxRagnarok
 	TxSwitch
+	TxNoOp
 )
 
+// MaxNoOpNoteLength is the maximum length in bytes of the note of a noop memo
+const MaxNoOpNoteLength = 256
+
 var stringToTxTypeMap = map[string]TxType{
 	"stake":      TxStake,
 	"st":         TxStake,
@@ -60,6 +65,8 @@ var stringToTxTypeMap = map[string]TxType{
 	"migrate":    TxMigrate,
 	"ragnarok":   TxRagnarok,
 	"switch":     TxSwitch,
+	"noop":       TxNoOp,
+	"#":          TxNoOp,
 }
 
 var txToStringMap = map[TxType]string{
@@ -77,6 +84,7 @@ var txToStringMap = map[TxType]string{
 	TxMigrate:         "migrate",
 	TxRagnarok:        "ragnarok",
 	TxSwitch:          "switch",
+	TxNoOp:            "noop",
 }
 
 // converts a string into a txType
@@ -91,7 +99,7 @@ func StringToTxType(s string) (TxType, error) {
 
 func (tx TxType) IsInbound() bool {
 	switch tx {
-	case TxStake, TxUnstake, TxSwap, TxAdd, TxBond, TxLeave, TxSwitch, TxReserve:
+	case TxStake, TxUnstake, TxSwap, TxAdd, TxBond, TxLeave, TxSwitch, TxReserve, TxNoOp:
 		return true
 	default:
 		return false
@@ -148,6 +156,29 @@ type Memo interface {
 	GetTxID() common.TxID
 	GetAccAddress() sdk.AccAddress
 	GetBlockHeight() int64
+	GetExpiry() int64
+	Validate() error
+}
+
+// MemoParseError is the error returned when a memo fails to parse or validate, Field is the name of the invalid field
+// and Part is the index of the colon separated part of the memo the field is parsed from
+type MemoParseError struct {
+	Field  string
+	Part   int
+	Reason string
+}
+
+func newMemoParseError(field string, part int, format string, args ...interface{}) MemoParseError {
+	return MemoParseError{
+		Field:  field,
+		Part:   part,
+		Reason: fmt.Sprintf(format, args...),
+	}
+}
+
+// Error implement error interface
+func (e MemoParseError) Error() string {
+	return e.Reason
 }
 
 type MemoBase struct {
@@ -181,8 +212,10 @@ type UnstakeMemo struct {
 
 type SwapMemo struct {
 	MemoBase
-	Destination common.Address
-	SlipLimit   sdk.Uint
+	Destination      common.Address
+	SlipLimit        sdk.Uint
+	AffiliateAddress common.Address
+	AffiliateBps     sdk.Uint
 }
 
 type AdminMemo struct {
@@ -209,6 +242,7 @@ type BondMemo struct {
 
 type LeaveMemo struct {
 	MemoBase
+	NodeAddress sdk.AccAddress
 }
 
 type YggdrasilFundMemo struct {
@@ -240,6 +274,56 @@ type SwitchMemo struct {
 	Destination common.Address
 }
 
+// NoOpMemo is a memo to annotate a tx with a free form note, the coins of the tx are refunded
+type NoOpMemo struct {
+	MemoBase
+	Note string
+}
+
+// ExpiryMemo wraps a memo with the block height after which the tx should be refunded rather than processed
+type ExpiryMemo struct {
+	Memo
+	Expiry int64
+}
+
+// expirableMemoFields is the number of colon-delimited fields a memo type consumes itself, the field after them is
+// taken as the expiry block height
+var expirableMemoFields = map[TxType]int{
+	TxStake: 3,
+	TxSwap:  6,
+}
+
+// legacySwapExpiryField is where the expiry of a swap memo used to be, right after the trade target, before the
+// affiliate fields were added. SWAP:ASSET:DEST:LIMIT:EXPIRY is still accepted, an affiliate address is never a number
+const legacySwapExpiryField = 4
+
+// isLegacySwapExpiry check whether the swap memo carries its expiry at the legacy position
+func isLegacySwapExpiry(parts []string) bool {
+	if len(parts) != legacySwapExpiryField+1 {
+		return false
+	}
+	_, err := strconv.ParseInt(parts[legacySwapExpiryField], 10, 64)
+	return err == nil
+}
+
+func NewExpiryMemo(memo Memo, expiry int64) ExpiryMemo {
+	return ExpiryMemo{
+		Memo:   memo,
+		Expiry: expiry,
+	}
+}
+
+// GetExpiry return the block height the memo expires at
+func (m ExpiryMemo) GetExpiry() int64 { return m.Expiry }
+
+// unwrapMemo return the memo wrapped by an ExpiryMemo, or the memo itself when it is not wrapped
+func unwrapMemo(memo Memo) Memo {
+	if m, ok := memo.(ExpiryMemo); ok {
+		return m.Memo
+	}
+	return memo
+}
+
 func NewSwitchMemo(addr common.Address) SwitchMemo {
 	return SwitchMemo{
 		MemoBase:    MemoBase{TxType: TxSwitch},
@@ -247,9 +331,18 @@ func NewSwitchMemo(addr common.Address) SwitchMemo {
 	}
 }
 
-func NewLeaveMemo() LeaveMemo {
+// NewNoOpMemo create a new instance of NoOpMemo
+func NewNoOpMemo(note string) NoOpMemo {
+	return NoOpMemo{
+		MemoBase: MemoBase{TxType: TxNoOp},
+		Note:     note,
+	}
+}
+
+func NewLeaveMemo(addr sdk.AccAddress) LeaveMemo {
 	return LeaveMemo{
-		MemoBase: MemoBase{TxType: TxLeave},
+		MemoBase:    MemoBase{TxType: TxLeave},
+		NodeAddress: addr,
 	}
 }
 
@@ -273,6 +366,18 @@ func NewStakeMemo(asset common.Asset, addr common.Address) StakeMemo {
 	}
 }
 
+// TargetAddress return the address of the staker on the chain of the pool. It is the address in the memo when there is
+// one, otherwise it is the address the tx was sent from, which is only valid when the tx is on the same chain as the pool
+func (m StakeMemo) TargetAddress(txFromAddr common.Address, chain common.Chain) (common.Address, error) {
+	if !m.Address.IsEmpty() {
+		return m.Address, nil
+	}
+	if m.Asset.Chain.Equals(chain) {
+		return txFromAddr, nil
+	}
+	return common.NoAddress, fmt.Errorf("no %s address provided to stake into pool(%s) from %s chain", m.Asset.Chain, m.Asset, chain)
+}
+
 func NewUnstakeMemo(asset common.Asset, amt string) UnstakeMemo {
 	return UnstakeMemo{
 		MemoBase: MemoBase{TxType: TxUnstake, Asset: asset},
@@ -280,6 +385,22 @@ func NewUnstakeMemo(asset common.Asset, amt string) UnstakeMemo {
 	}
 }
 
+// BasisPoints return the parsed withdraw amount in basis points, which must be in (0, MaxUnstakeBasisPoints].
+// An empty amount means a full withdrawal
+func (m UnstakeMemo) BasisPoints() (sdk.Uint, error) {
+	if len(m.Amount) == 0 {
+		return sdk.NewUint(MaxUnstakeBasisPoints), nil
+	}
+	bps, err := sdk.ParseUint(m.Amount)
+	if err != nil {
+		return sdk.ZeroUint(), fmt.Errorf("invalid withdraw amount(%s): %w", m.Amount, err)
+	}
+	if bps.IsZero() || bps.GT(sdk.NewUint(MaxUnstakeBasisPoints)) {
+		return sdk.ZeroUint(), fmt.Errorf("withdraw amount :%s is invalid", m.Amount)
+	}
+	return bps, nil
+}
+
 func NewReserveMemo() ReserveMemo {
 	return ReserveMemo{
 		MemoBase: MemoBase{TxType: TxReserve},
@@ -331,29 +452,91 @@ func NewBondMemo(addr sdk.AccAddress) BondMemo {
 
 func NewSwapMemo(asset common.Asset, dest common.Address, slip sdk.Uint) SwapMemo {
 	return SwapMemo{
-		MemoBase:    MemoBase{TxType: TxSwap, Asset: asset},
-		Destination: dest,
-		SlipLimit:   slip,
+		MemoBase:     MemoBase{TxType: TxSwap, Asset: asset},
+		Destination:  dest,
+		SlipLimit:    slip,
+		AffiliateBps: sdk.ZeroUint(),
+	}
+}
+
+// String implement fmt.Stringer, the affiliate fields are only added when there is an affiliate
+func (m SwapMemo) String() string {
+	memo := fmt.Sprintf("SWAP:%s:%s:%s", m.Asset, m.Destination, m.SlipLimit)
+	if !m.AffiliateAddress.IsEmpty() {
+		memo = fmt.Sprintf("%s:%s:%s", memo, m.AffiliateAddress, m.AffiliateBps)
 	}
+	return memo
 }
 
+// IsValidForDoubleSwap check the swap from the given source asset to the asset of the memo can be done as a double
+// swap through RUNE, the asset of the memo has to be on one of the supported chains
+func (m SwapMemo) IsValidForDoubleSwap(source common.Asset, supportedChains common.Chains) error {
+	if source.IsRune() {
+		return fmt.Errorf("source asset %s is RUNE, it is a single swap", source)
+	}
+	if m.Asset.IsRune() {
+		return fmt.Errorf("target asset %s is RUNE, it is a single swap", m.Asset)
+	}
+	if !supportedChains.Has(m.Asset.Chain) {
+		return fmt.Errorf("target asset %s is on chain %s which is not supported", m.Asset, m.Asset.Chain)
+	}
+	if m.Asset.Equals(source) {
+		return fmt.Errorf("target asset %s is the same as the source asset", m.Asset)
+	}
+	return nil
+}
+
+// ParseMemo parse the given memo with the rules of the version of this software
 func ParseMemo(memo string) (Memo, error) {
+	return ParseMemoWithVersion(constants.SWVersion, memo)
+}
+
+// ParseMemoWithVersion parse the given memo with the rules in use at the given version. From version 0.3.0, when the
+// memo type supports it and there is a non empty field after the ones the memo type consumes, that field is parsed as
+// the expiry block height and the memo is wrapped in an ExpiryMemo
+func ParseMemoWithVersion(version semver.Version, memo string) (Memo, error) {
+	m, err := parseMemo(version, memo)
+	if err != nil {
+		return m, err
+	}
+	if version.LT(semver.MustParse("0.3.0")) {
+		return m, nil
+	}
+	fields, ok := expirableMemoFields[m.GetType()]
+	if !ok {
+		return m, nil
+	}
+	parts := strings.Split(memo, ":")
+	if m.IsType(TxSwap) && isLegacySwapExpiry(parts) {
+		fields = legacySwapExpiryField
+	}
+	if len(parts) <= fields || len(parts[fields]) == 0 {
+		return m, nil
+	}
+	expiry, err := strconv.ParseInt(parts[fields], 10, 64)
+	if err != nil || expiry <= 0 {
+		return MemoBase{}, newMemoParseError("expiry", fields, "invalid expiry block height: %s", parts[fields])
+	}
+	return NewExpiryMemo(m, expiry), nil
+}
+
+func parseMemo(version semver.Version, memo string) (Memo, error) {
 	var err error
 	noMemo := MemoBase{}
 	if len(memo) == 0 {
-		return noMemo, fmt.Errorf("memo can't be empty")
+		return noMemo, newMemoParseError("memo", 0, "memo can't be empty")
 	}
 	parts := strings.Split(memo, ":")
 	tx, err := StringToTxType(parts[0])
 	if err != nil {
-		return noMemo, err
+		return noMemo, newMemoParseError("type", 0, "%s", err)
 	}
 
 	// list of memo types that do not contain an asset in their memo
 	noAssetMemos := []TxType{
 		TxOutbound, TxBond, TxLeave, TxRefund,
 		TxYggdrasilFund, TxYggdrasilReturn, TxReserve,
-		TxMigrate, TxRagnarok, TxSwitch,
+		TxMigrate, TxRagnarok, TxSwitch, TxNoOp,
 	}
 	hasAsset := true
 	for _, memoType := range noAssetMemos {
@@ -365,18 +548,27 @@ func ParseMemo(memo string) (Memo, error) {
 	var asset common.Asset
 	if hasAsset {
 		if len(parts) < 2 {
-			return noMemo, fmt.Errorf("cannot parse given memo: length %d", len(parts))
+			return noMemo, newMemoParseError("asset", 1, "cannot parse given memo: length %d", len(parts))
 		}
 		var err error
 		asset, err = common.NewAsset(parts[1])
 		if err != nil {
-			return noMemo, err
+			return noMemo, newMemoParseError("asset", 1, "%s", err)
 		}
 	}
 
 	switch tx {
 	case TxLeave:
-		return NewLeaveMemo(), nil
+		// LEAVE[:node_address], without a node address the node is looked up by the bond address of the sender
+		var addr sdk.AccAddress
+		if len(parts) > 1 && len(parts[1]) > 0 {
+			var err error
+			addr, err = sdk.AccAddressFromBech32(parts[1])
+			if err != nil {
+				return noMemo, newMemoParseError("node_address", 1, "%s is an invalid thorchain address: %s", parts[1], err)
+			}
+		}
+		return NewLeaveMemo(addr), nil
 	case TxAdd:
 		return NewAddMemo(asset), nil
 	case TxStake:
@@ -385,35 +577,33 @@ func ParseMemo(memo string) (Memo, error) {
 			if len(parts) < 3 {
 				// cannot stake into a non BNB-based pool when THORNode don't have an
 				// associated address
-				return noMemo, fmt.Errorf("invalid stake. Cannot stake to a non BNB-based pool without providing an associated address")
+				return noMemo, newMemoParseError("address", 2, "invalid stake. Cannot stake to a non BNB-based pool without providing an associated address")
 			}
 			addr, err = common.NewAddress(parts[2])
 			if err != nil {
-				return noMemo, err
+				return noMemo, newMemoParseError("address", 2, "%s", err)
 			}
 		}
 		return NewStakeMemo(asset, addr), nil
 
 	case TxUnstake:
 		if len(parts) < 2 {
-			return noMemo, fmt.Errorf("invalid unstake memo")
+			return noMemo, newMemoParseError("asset", 1, "invalid unstake memo")
 		}
 		var withdrawAmount string
 		if len(parts) > 2 {
 			withdrawAmount = parts[2]
-			wa, err := sdk.ParseUint(withdrawAmount)
-			if err != nil {
-				return noMemo, err
-			}
-			if !wa.GT(sdk.ZeroUint()) || wa.GT(sdk.NewUint(MaxUnstakeBasisPoints)) {
-				return noMemo, fmt.Errorf("withdraw amount :%s is invalid", withdrawAmount)
-			}
 		}
-		return NewUnstakeMemo(asset, withdrawAmount), nil
+		m := NewUnstakeMemo(asset, withdrawAmount)
+		if _, err := m.BasisPoints(); err != nil {
+			return noMemo, newMemoParseError("amount", 2, "%s", err)
+		}
+		return m, nil
 
 	case TxSwap:
+		// SWAP:ASSET:DESTADDR:TRADE-TARGET:AFFILIATE:AFFILIATE-FEE-BPS:EXPIRY, all the fields after the asset are optional
 		if len(parts) < 2 {
-			return noMemo, fmt.Errorf("missing swap parameters: memo should in SWAP:SYMBOLXX-XXX:DESTADDR:TRADE-TARGET format")
+			return noMemo, newMemoParseError("asset", 1, "missing swap parameters: memo should in SWAP:SYMBOLXX-XXX:DESTADDR:TRADE-TARGET format")
 		}
 		// DESTADDR can be empty , if it is empty , it will swap to the sender address
 		destination := common.NoAddress
@@ -421,7 +611,7 @@ func ParseMemo(memo string) (Memo, error) {
 			if len(parts[2]) > 0 {
 				destination, err = common.NewAddress(parts[2])
 				if err != nil {
-					return noMemo, err
+					return noMemo, newMemoParseError("destination", 2, "%s", err)
 				}
 			}
 		}
@@ -430,85 +620,119 @@ func ParseMemo(memo string) (Memo, error) {
 		if len(parts) > 3 && len(parts[3]) > 0 {
 			amount, err := sdk.ParseUint(parts[3])
 			if err != nil {
-				return noMemo, fmt.Errorf("swap price limit:%s is invalid", parts[3])
+				return noMemo, newMemoParseError("slip_limit", 3, "swap price limit:%s is invalid", parts[3])
 			}
 
 			slip = amount
 		}
-		return NewSwapMemo(asset, destination, slip), nil
+		m := NewSwapMemo(asset, destination, slip)
+		// the fields after the trade target used to be ignored, affiliate fields are only parsed from version 0.3.0
+		if version.LT(semver.MustParse("0.3.0")) {
+			return m, nil
+		}
+		// affiliate receive the given basis points of the swap output
+		if len(parts) > 4 && len(parts[4]) > 0 && !isLegacySwapExpiry(parts) {
+			m.AffiliateAddress, err = common.NewAddress(parts[4])
+			if err != nil {
+				return noMemo, newMemoParseError("affiliate_address", 4, "%s", err)
+			}
+		}
+		if len(parts) > 5 && len(parts[5]) > 0 {
+			m.AffiliateBps, err = sdk.ParseUint(parts[5])
+			if err != nil {
+				return noMemo, newMemoParseError("affiliate_bps", 5, "affiliate fee basis points:%s is invalid", parts[5])
+			}
+		}
+		if err := m.Validate(); err != nil {
+			return noMemo, err
+		}
+		return m, nil
 	case TxOutbound:
 		if len(parts) < 2 {
-			return noMemo, fmt.Errorf("not enough parameters")
+			return noMemo, newMemoParseError("tx_id", 1, "not enough parameters")
 		}
 		txID, err := common.NewTxID(parts[1])
-		return NewOutboundMemo(txID), err
+		if err != nil {
+			return NewOutboundMemo(txID), newMemoParseError("tx_id", 1, "%s", err)
+		}
+		return NewOutboundMemo(txID), nil
 	case TxRefund:
 		if len(parts) < 2 {
-			return noMemo, fmt.Errorf("not enough parameters")
+			return noMemo, newMemoParseError("tx_id", 1, "not enough parameters")
 		}
 		txID, err := common.NewTxID(parts[1])
-		return NewRefundMemo(txID), err
+		if err != nil {
+			return NewRefundMemo(txID), newMemoParseError("tx_id", 1, "%s", err)
+		}
+		return NewRefundMemo(txID), nil
 	case TxBond:
 		if len(parts) < 2 {
-			return noMemo, fmt.Errorf("not enough parameters")
+			return noMemo, newMemoParseError("node_address", 1, "not enough parameters")
 		}
 		addr, err := sdk.AccAddressFromBech32(parts[1])
 		if err != nil {
-			return noMemo, fmt.Errorf("%s is an invalid thorchain address: %w", parts[1], err)
+			return noMemo, newMemoParseError("node_address", 1, "%s is an invalid thorchain address: %s", parts[1], err)
 		}
 		return NewBondMemo(addr), nil
 	case TxYggdrasilFund:
 		if len(parts) < 2 {
-			return noMemo, errors.New("not enough parameters")
+			return noMemo, newMemoParseError("block_height", 1, "not enough parameters")
 		}
 		blockHeight, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
-			return noMemo, fmt.Errorf("fail to convert (%s) to a valid block height: %w", parts[1], err)
+			return noMemo, newMemoParseError("block_height", 1, "fail to convert (%s) to a valid block height: %s", parts[1], err)
 		}
 		return NewYggdrasilFund(blockHeight), nil
 	case TxYggdrasilReturn:
 		if len(parts) < 2 {
-			return noMemo, errors.New("not enough parameters")
+			return noMemo, newMemoParseError("block_height", 1, "not enough parameters")
 		}
 		blockHeight, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
-			return noMemo, fmt.Errorf("fail to convert (%s) to a valid block height: %w", parts[1], err)
+			return noMemo, newMemoParseError("block_height", 1, "fail to convert (%s) to a valid block height: %s", parts[1], err)
 		}
 		return NewYggdrasilReturn(blockHeight), nil
 	case TxReserve:
 		return NewReserveMemo(), nil
 	case TxMigrate:
 		if len(parts) < 2 {
-			return noMemo, errors.New("not enough parameters")
+			return noMemo, newMemoParseError("block_height", 1, "not enough parameters")
 		}
 		blockHeight, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
-			return noMemo, fmt.Errorf("fail to convert (%s) to a valid block height: %w", parts[1], err)
+			return noMemo, newMemoParseError("block_height", 1, "fail to convert (%s) to a valid block height: %s", parts[1], err)
 		}
 		return NewMigrateMemo(blockHeight), nil
 	case TxRagnarok:
 		if len(parts) < 2 {
-			return noMemo, errors.New("not enough parameters")
+			return noMemo, newMemoParseError("block_height", 1, "not enough parameters")
 		}
 		blockHeight, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
-			return noMemo, fmt.Errorf("fail to convert (%s) to a valid block height: %w", parts[1], err)
+			return noMemo, newMemoParseError("block_height", 1, "fail to convert (%s) to a valid block height: %s", parts[1], err)
 		}
 		return NewRagnarokMemo(blockHeight), nil
 	case TxSwitch:
 		if len(parts) < 2 {
-			return noMemo, errors.New("not enough parameters")
+			return noMemo, newMemoParseError("destination", 1, "not enough parameters")
 		}
 		destination, err := common.NewAddress(parts[1])
 		if err != nil {
-			return noMemo, err
+			return noMemo, newMemoParseError("destination", 1, "%s", err)
 		}
 		if destination.IsEmpty() {
-			return noMemo, errors.New("address cannot be empty")
+			return noMemo, newMemoParseError("destination", 1, "address cannot be empty")
 		}
 		return NewSwitchMemo(destination), nil
+	case TxNoOp:
+		// the note is free form, it can contain colons as well
+		m := NewNoOpMemo(strings.Join(parts[1:], ":"))
+		if err := m.Validate(); err != nil {
+			return noMemo, err
+		}
+		return m, nil
 	default:
-		return noMemo, fmt.Errorf("TxType not supported: %s", tx.String())
+		return noMemo, newMemoParseError("type", 0, "TxType not supported: %s", tx.String())
 	}
 }
 
@@ -525,6 +749,7 @@ func (m MemoBase) GetValue() string               { return "" }
 func (m MemoBase) GetTxID() common.TxID           { return "" }
 func (m MemoBase) GetAccAddress() sdk.AccAddress  { return sdk.AccAddress{} }
 func (m MemoBase) GetBlockHeight() int64          { return 0 }
+func (m MemoBase) GetExpiry() int64               { return 0 }
 func (m MemoBase) IsOutbound() bool               { return m.TxType.IsOutbound() }
 func (m MemoBase) IsInbound() bool                { return m.TxType.IsInbound() }
 func (m MemoBase) IsInternal() bool               { return m.TxType.IsInternal() }
@@ -583,6 +808,132 @@ func (m RagnarokMemo) GetBlockHeight() int64 {
 	return m.BlockHeight
 }
 
+// GetNodeAddress return the node address given in the leave memo, empty when the memo doesn't name one
+func (m LeaveMemo) GetNodeAddress() sdk.AccAddress { return m.NodeAddress }
+
+// String implement fmt.Stringer
+func (m LeaveMemo) String() string {
+	if m.NodeAddress.Empty() {
+		return "LEAVE"
+	}
+	return fmt.Sprintf("LEAVE:%s", m.NodeAddress.String())
+}
+
 func (m SwitchMemo) GetDestination() common.Address {
 	return m.Destination
 }
+
+func (m NoOpMemo) String() string {
+	return fmt.Sprintf("NOOP:%s", m.Note)
+}
+
+// Validate Functions, they run the same checks as ParseMemo so memos built programmatically can be checked as well
+func (m MemoBase) Validate() error {
+	if m.TxType.IsEmpty() {
+		return newMemoParseError("type", 0, "invalid tx type: %s", m.TxType)
+	}
+	return nil
+}
+
+// validateAsset validate a memo of a tx type that requires an asset
+func (m MemoBase) validateAsset() error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	if m.Asset.IsEmpty() {
+		return newMemoParseError("asset", 1, "asset can't be empty")
+	}
+	return nil
+}
+
+func (m AddMemo) Validate() error { return m.validateAsset() }
+
+func (m SwapMemo) Validate() error {
+	if err := m.validateAsset(); err != nil {
+		return err
+	}
+	if m.AffiliateBps.GT(sdk.NewUint(MaxAffiliateFeeBasisPoints)) {
+		return newMemoParseError("affiliate_bps", 5, "affiliate fee basis points:%s can't be more than %d", m.AffiliateBps, MaxAffiliateFeeBasisPoints)
+	}
+	if !m.AffiliateBps.IsZero() && m.AffiliateAddress.IsEmpty() {
+		return newMemoParseError("affiliate_address", 4, "affiliate address can't be empty when there is an affiliate fee")
+	}
+	return nil
+}
+
+func (m StakeMemo) Validate() error {
+	if err := m.validateAsset(); err != nil {
+		return err
+	}
+	if !m.Asset.Chain.IsBNB() && m.Address.IsEmpty() {
+		return newMemoParseError("address", 2, "invalid stake. Cannot stake to a non BNB-based pool without providing an associated address")
+	}
+	return nil
+}
+
+func (m UnstakeMemo) Validate() error {
+	if err := m.validateAsset(); err != nil {
+		return err
+	}
+	if _, err := m.BasisPoints(); err != nil {
+		return newMemoParseError("amount", 2, "%s", err)
+	}
+	return nil
+}
+
+func (m OutboundMemo) Validate() error {
+	if err := m.MemoBase.Validate(); err != nil {
+		return err
+	}
+	if m.TxID.IsEmpty() {
+		return newMemoParseError("tx_id", 1, "tx id can't be empty")
+	}
+	return nil
+}
+
+func (m RefundMemo) Validate() error {
+	if err := m.MemoBase.Validate(); err != nil {
+		return err
+	}
+	if m.TxID.IsEmpty() {
+		return newMemoParseError("tx_id", 1, "tx id can't be empty")
+	}
+	return nil
+}
+
+func (m BondMemo) Validate() error {
+	if err := m.MemoBase.Validate(); err != nil {
+		return err
+	}
+	if m.NodeAddress.Empty() {
+		return newMemoParseError("node_address", 1, "node address can't be empty")
+	}
+	return nil
+}
+
+func (m SwitchMemo) Validate() error {
+	if err := m.MemoBase.Validate(); err != nil {
+		return err
+	}
+	if m.Destination.IsEmpty() {
+		return newMemoParseError("destination", 1, "address cannot be empty")
+	}
+	return nil
+}
+
+func (m ExpiryMemo) Validate() error {
+	if m.Expiry <= 0 {
+		return newMemoParseError("expiry", expirableMemoFields[m.GetType()], "invalid expiry block height: %d", m.Expiry)
+	}
+	return m.Memo.Validate()
+}
+
+func (m NoOpMemo) Validate() error {
+	if err := m.MemoBase.Validate(); err != nil {
+		return err
+	}
+	if len(m.Note) > MaxNoOpNoteLength {
+		return newMemoParseError("note", 1, "note is %d bytes, it can't be longer than %d bytes", len(m.Note), MaxNoOpNoteLength)
+	}
+	return nil
+}
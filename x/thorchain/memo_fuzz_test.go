@@ -0,0 +1,49 @@
+//go:build go1.18
+// +build go1.18
+
+package thorchain
+
+import (
+	"testing"
+)
+
+// FuzzParseMemo make sure ParseMemo never panic no matter what memo it is given
+func FuzzParseMemo(f *testing.F) {
+	SetupConfigForTest()
+	seeds := []string{
+		"STAKE:BNB.BNB",
+		"STAKE:BTC.BTC:bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej",
+		"%:BNB.RUNE-1BA",
+		"ADD:BNB.BNB",
+		"WITHDRAW:BNB.RUNE-1BA:25",
+		"SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000",
+		"SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:",
+		"SWAP:BNB.RUNE-1BA",
+		"SWAP:BNB.RUNE-1BA:::::1024",
+		"OUTBOUND:" + GetRandomTxHash().String(),
+		"REFUND:" + GetRandomTxHash().String(),
+		"BOND:" + GetRandomBech32Addr().String(),
+		"LEAVE",
+		"LEAVE:" + GetRandomBech32Addr().String(),
+		"YGGDRASIL+:1024",
+		"YGGDRASIL-:1024",
+		"RESERVE",
+		"MIGRATE:100",
+		"RAGNAROK:100",
+		"SWITCH:" + GetRandomBNBAddress().String(),
+		"",
+		":",
+		"::::",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, memo string) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("ParseMemo panic on memo(%q): %v", memo, r)
+			}
+		}()
+		_, _ = ParseMemo(memo)
+	})
+}
@@ -2,9 +2,15 @@ package thorchain
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
+	"strings"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type MemoSuite struct{}
@@ -29,6 +35,15 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
 	c.Check(memo.IsType(TxAdd), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.IsEmpty(), Equals, false)
+
+	// missing or unknown memo is empty
+	memo, err = ParseMemo("")
+	c.Assert(err, NotNil)
+	c.Check(memo.IsEmpty(), Equals, true)
+	memo, err = ParseMemo("whatever:BNB.BNB")
+	c.Assert(err, NotNil)
+	c.Check(memo.IsEmpty(), Equals, true)
 	c.Check(memo.IsInbound(), Equals, true)
 
 	memo, err = ParseMemo("+:BNB.RUNE-1BA")
@@ -78,7 +93,7 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	c.Check(memo.IsType(TxRefund), Equals, true)
 	c.Check(memo.IsOutbound(), Equals, true)
 
-	memo, err = ParseMemo("leave:whatever")
+	memo, err = ParseMemo("leave:" + GetRandomBech32Addr().String())
 	c.Assert(err, IsNil)
 	c.Check(memo.IsType(TxLeave), Equals, true)
 
@@ -193,6 +208,17 @@ func (s *MemoSuite) TestParse(c *C) {
 	memo, err = ParseMemo("leave")
 	c.Assert(err, IsNil)
 	c.Assert(memo.IsType(TxLeave), Equals, true)
+	c.Check(memo.(LeaveMemo).GetNodeAddress().Empty(), Equals, true)
+	c.Check(memo.String(), Equals, "LEAVE")
+
+	memo, err = ParseMemo("leave:" + whiteListAddr.String())
+	c.Assert(err, IsNil)
+	c.Assert(memo.IsType(TxLeave), Equals, true)
+	c.Check(memo.(LeaveMemo).GetNodeAddress().Equals(whiteListAddr), Equals, true)
+	c.Check(memo.String(), Equals, "LEAVE:"+whiteListAddr.String())
+
+	_, err = ParseMemo("leave:whatever")
+	c.Assert(err, NotNil)
 
 	memo, err = ParseMemo("migrate:100")
 	c.Assert(err, IsNil)
@@ -224,3 +250,311 @@ func (s *MemoSuite) TestParse(c *C) {
 	_, err = ParseMemo("migrate:abc")
 	c.Assert(err, NotNil)
 }
+
+func (s *MemoSuite) TestMigrateMemoRoundTrip(c *C) {
+	heights := []int64{0, 1, -1, 1024, math.MaxInt64, math.MinInt64}
+	for i := 0; i < 100; i++ {
+		height := rand.Int63()
+		if i%2 == 0 {
+			height = -height
+		}
+		heights = append(heights, height)
+	}
+	for _, height := range heights {
+		m := NewMigrateMemo(height)
+		memo, err := ParseMemo(m.String())
+		c.Assert(err, IsNil, Commentf("memo: %s", m.String()))
+		c.Check(memo.IsType(TxMigrate), Equals, true)
+		c.Check(memo.GetBlockHeight(), Equals, m.BlockHeight, Commentf("memo: %s", m.String()))
+	}
+}
+
+func (s *MemoSuite) TestStakeMemoTargetAddress(c *C) {
+	fromAddr := GetRandomBNBAddress()
+
+	// stake into a pool on the same chain as the tx
+	memo := NewStakeMemo(common.BNBAsset, common.NoAddress)
+	addr, err := memo.TargetAddress(fromAddr, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(addr.Equals(fromAddr), Equals, true)
+
+	// address in the memo take precedence
+	bnbAddr := GetRandomBNBAddress()
+	memo = NewStakeMemo(common.BNBAsset, bnbAddr)
+	addr, err = memo.TargetAddress(fromAddr, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(addr.Equals(bnbAddr), Equals, true)
+
+	// stake into a pool on another chain
+	btcAddr := GetRandomBTCAddress()
+	memo = NewStakeMemo(common.BTCAsset, btcAddr)
+	addr, err = memo.TargetAddress(fromAddr, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(addr.Equals(btcAddr), Equals, true)
+	memo = NewStakeMemo(common.BTCAsset, common.NoAddress)
+	addr, err = memo.TargetAddress(fromAddr, common.BNBChain)
+	c.Assert(err, NotNil)
+	c.Check(addr.IsEmpty(), Equals, true)
+}
+
+func (s *MemoSuite) TestUnstakeMemoBasisPoints(c *C) {
+	// full withdrawal
+	bps, err := NewUnstakeMemo(common.BNBAsset, "").BasisPoints()
+	c.Assert(err, IsNil)
+	c.Check(bps.Equal(sdk.NewUint(MaxUnstakeBasisPoints)), Equals, true)
+
+	for _, amt := range []uint64{1, 5000, 10000} {
+		bps, err = NewUnstakeMemo(common.BNBAsset, fmt.Sprintf("%d", amt)).BasisPoints()
+		c.Assert(err, IsNil)
+		c.Check(bps.Equal(sdk.NewUint(amt)), Equals, true, Commentf("%d", amt))
+	}
+
+	for _, amt := range []string{"0", "10001", "-1", "1.5", "twenty-two", " "} {
+		bps, err = NewUnstakeMemo(common.BNBAsset, amt).BasisPoints()
+		c.Check(err, NotNil, Commentf("%s", amt))
+		c.Check(bps.IsZero(), Equals, true)
+	}
+}
+
+func (s *MemoSuite) TestSwapMemoIsValidForDoubleSwap(c *C) {
+	supportedChains := common.Chains{common.BNBChain, common.BTCChain}
+	memo := NewSwapMemo(common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint())
+	c.Check(memo.IsValidForDoubleSwap(common.BNBAsset, supportedChains), IsNil)
+
+	// RUNE source is a single swap
+	c.Check(memo.IsValidForDoubleSwap(common.RuneAsset(), supportedChains), NotNil)
+	// destination chain is not supported
+	c.Check(memo.IsValidForDoubleSwap(common.BNBAsset, common.Chains{common.BNBChain}), NotNil)
+	// same asset
+	c.Check(memo.IsValidForDoubleSwap(common.BTCAsset, supportedChains), NotNil)
+	// RUNE target is a single swap
+	memo = NewSwapMemo(common.RuneAsset(), GetRandomBNBAddress(), sdk.ZeroUint())
+	c.Check(memo.IsValidForDoubleSwap(common.BNBAsset, supportedChains), NotNil)
+}
+
+func (s *MemoSuite) TestParseExpiry(c *C) {
+	memo, err := ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:::1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxSwap), Equals, true)
+	c.Check(memo.GetExpiry(), Equals, int64(1024))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Check(memo.GetSlipLimit().Equal(sdk.NewUint(870000000)), Equals, true)
+	swapMemo, ok := unwrapMemo(memo).(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetExpiry(), Equals, int64(0))
+
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:::::1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(1024))
+	c.Check(memo.GetDestination().IsEmpty(), Equals, true)
+
+	// expiry right after the trade target, before affiliate fields were added
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(1024))
+	swapMemo, ok = unwrapMemo(memo).(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.AffiliateAddress.IsEmpty(), Equals, true)
+	c.Check(swapMemo.GetSlipLimit().Equal(sdk.NewUint(870000000)), Equals, true)
+
+	memo, err = ParseMemo("STAKE:BNB.BNB::2048")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxStake), Equals, true)
+	c.Check(memo.GetExpiry(), Equals, int64(2048))
+	_, ok = unwrapMemo(memo).(StakeMemo)
+	c.Check(ok, Equals, true)
+
+	// no expiry
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(0))
+	_, ok = memo.(SwapMemo)
+	c.Check(ok, Equals, true)
+	memo, err = ParseMemo("STAKE:BNB.BNB")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(0))
+	memo, err = ParseMemo("WITHDRAW:BNB.RUNE-1BA:25:1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(0))
+
+	// invalid expiry
+	_, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:::soon")
+	c.Check(err, NotNil)
+	_, err = ParseMemo("SWAP:BNB.RUNE-1BA:::::-1")
+	c.Check(err, NotNil)
+	_, err = ParseMemo("SWAP:BNB.RUNE-1BA:::-1")
+	c.Check(err, NotNil)
+	_, err = ParseMemo("STAKE:BNB.BNB::0")
+	c.Check(err, NotNil)
+
+	// expiry is not parsed before version 0.3.0, trailing fields used to be ignored
+	memo, err = ParseMemoWithVersion(semver.MustParse("0.2.0"), "STAKE:BNB.BNB::soon")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(0))
+	_, ok = memo.(StakeMemo)
+	c.Check(ok, Equals, true)
+	memo, err = ParseMemoWithVersion(semver.MustParse("0.2.0"), "SWAP:BNB.RUNE-1BA:::::1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(0))
+	_, ok = memo.(SwapMemo)
+	c.Check(ok, Equals, true)
+}
+
+func (s *MemoSuite) TestParseMemoError(c *C) {
+	testCases := []struct {
+		memo  string
+		field string
+		part  int
+	}{
+		{memo: "", field: "memo", part: 0},
+		{memo: "bogus", field: "type", part: 0},
+		{memo: "add", field: "asset", part: 1},
+		{memo: "add:", field: "asset", part: 1},
+		{memo: "STAKE:BTC.BTC", field: "address", part: 2},
+		{memo: "STAKE:BTC.BTC:bad_DES", field: "address", part: 2},
+		{memo: "STAKE:BNB.BNB::soon", field: "expiry", part: 3},
+		{memo: "withdraw:bnb:twenty-two", field: "amount", part: 2},
+		{memo: "withdraw:bnb:0", field: "amount", part: 2},
+		{memo: "swap:bnb:bad_DES:5.6", field: "destination", part: 2},
+		{memo: "swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:five", field: "slip_limit", part: 3},
+		{memo: "swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:5:::-1", field: "expiry", part: 6},
+		{memo: "swap:bnb::5:bad_DES:10", field: "affiliate_address", part: 4},
+		{memo: "swap:bnb::5:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:ten", field: "affiliate_bps", part: 5},
+		{memo: "swap:bnb::5:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1001", field: "affiliate_bps", part: 5},
+		{memo: "swap:bnb::5::10", field: "affiliate_address", part: 4},
+		{memo: "outbound", field: "tx_id", part: 1},
+		{memo: "outbound:bogus", field: "tx_id", part: 1},
+		{memo: "refund:bogus", field: "tx_id", part: 1},
+		{memo: "bond", field: "node_address", part: 1},
+		{memo: "bond:bogus", field: "node_address", part: 1},
+		{memo: "yggdrasil+", field: "block_height", part: 1},
+		{memo: "yggdrasil-:abc", field: "block_height", part: 1},
+		{memo: "migrate", field: "block_height", part: 1},
+		{memo: "ragnarok:abc", field: "block_height", part: 1},
+		{memo: "switch", field: "destination", part: 1},
+		{memo: "switch:", field: "destination", part: 1},
+		{memo: "switch:bad_DES", field: "destination", part: 1},
+	}
+	for _, tc := range testCases {
+		_, err := ParseMemo(tc.memo)
+		c.Assert(err, NotNil, Commentf("%s", tc.memo))
+		parseErr, ok := err.(MemoParseError)
+		c.Assert(ok, Equals, true, Commentf("%s: %s", tc.memo, err))
+		c.Check(parseErr.Field, Equals, tc.field, Commentf("%s: %s", tc.memo, err))
+		c.Check(parseErr.Part, Equals, tc.part, Commentf("%s: %s", tc.memo, err))
+		c.Check(parseErr.Reason, Equals, err.Error())
+	}
+}
+
+func (s *MemoSuite) TestSwapMemoAffiliate(c *C) {
+	memo, err := ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:bnb1g0xakzh03tpa54khxyvheeu92hwzypkdce77rm:50:1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetExpiry(), Equals, int64(1024))
+	swapMemo, ok := unwrapMemo(memo).(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.AffiliateAddress.String(), Equals, "bnb1g0xakzh03tpa54khxyvheeu92hwzypkdce77rm")
+	c.Check(swapMemo.AffiliateBps.Equal(sdk.NewUint(50)), Equals, true)
+
+	// the affiliate fee is optional
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:bnb1g0xakzh03tpa54khxyvheeu92hwzypkdce77rm")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.AffiliateBps.IsZero(), Equals, true)
+
+	for _, input := range []string{
+		"SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000",
+		"SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:bnb1g0xakzh03tpa54khxyvheeu92hwzypkdce77rm:0",
+		"SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:0:bnb1g0xakzh03tpa54khxyvheeu92hwzypkdce77rm:1000",
+	} {
+		memo, err := ParseMemo(input)
+		c.Assert(err, IsNil, Commentf("%s", input))
+		c.Check(memo.String(), Equals, input)
+		parsed, err := ParseMemo(memo.String())
+		c.Assert(err, IsNil)
+		c.Check(parsed, DeepEquals, memo)
+	}
+
+	// the fields after the trade target are ignored before version 0.3.0
+	memo, err = ParseMemoWithVersion(semver.MustParse("0.2.0"), "SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:whatever:abc")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.AffiliateAddress.IsEmpty(), Equals, true)
+	c.Check(swapMemo.AffiliateBps.IsZero(), Equals, true)
+	c.Check(swapMemo.GetSlipLimit().Equal(sdk.NewUint(870000000)), Equals, true)
+	_, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:whatever:abc")
+	c.Check(err, NotNil)
+}
+
+func (s *MemoSuite) TestMemoValidate(c *C) {
+	// memos parsed successfully pass validation
+	for _, memo := range []string{
+		"add:BNB.RUNE-1BA",
+		"STAKE:BNB.RUNE-1BA",
+		"STAKE:BTC.BTC:bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej",
+		"WITHDRAW:BNB.RUNE-1BA:25",
+		"SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:::2048",
+		"OUTBOUND:MUKVQILIHIAUSEOVAXBFEZAJKYHFJYHRUUYGQJZGFYBYVXCXYNEMUOAIQKFQLLCX",
+		"REFUND:MUKVQILIHIAUSEOVAXBFEZAJKYHFJYHRUUYGQJZGFYBYVXCXYNEMUOAIQKFQLLCX",
+		"bond:" + GetRandomBech32Addr().String(),
+		"leave",
+		"leave:" + GetRandomBech32Addr().String(),
+		"yggdrasil+:30",
+		"migrate:100",
+		"switch:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6",
+	} {
+		m, err := ParseMemo(memo)
+		c.Assert(err, IsNil, Commentf("%s", memo))
+		c.Check(m.Validate(), IsNil, Commentf("%s", memo))
+	}
+
+	testCases := []struct {
+		memo  Memo
+		field string
+	}{
+		{memo: MemoBase{}, field: "type"},
+		{memo: NewAddMemo(common.EmptyAsset), field: "asset"},
+		{memo: NewStakeMemo(common.BTCAsset, common.NoAddress), field: "address"},
+		{memo: NewUnstakeMemo(common.BNBAsset, "10001"), field: "amount"},
+		{memo: NewSwapMemo(common.EmptyAsset, common.NoAddress, sdk.ZeroUint()), field: "asset"},
+		{memo: NewOutboundMemo(common.TxID("")), field: "tx_id"},
+		{memo: NewRefundMemo(common.TxID("")), field: "tx_id"},
+		{memo: NewBondMemo(sdk.AccAddress{}), field: "node_address"},
+		{memo: NewSwitchMemo(common.NoAddress), field: "destination"},
+		{memo: NewExpiryMemo(NewSwapMemo(common.BNBAsset, common.NoAddress, sdk.ZeroUint()), 0), field: "expiry"},
+		{memo: NewExpiryMemo(NewStakeMemo(common.BTCAsset, common.NoAddress), 10), field: "address"},
+	}
+	for _, tc := range testCases {
+		err := tc.memo.Validate()
+		c.Assert(err, NotNil, Commentf("%+v", tc.memo))
+		parseErr, ok := err.(MemoParseError)
+		c.Assert(ok, Equals, true, Commentf("%+v", tc.memo))
+		c.Check(parseErr.Field, Equals, tc.field, Commentf("%+v: %s", tc.memo, err))
+	}
+}
+
+func (s *MemoSuite) TestParseNoOpMemo(c *C) {
+	memo, err := ParseMemo("noop:thanks for all the fish: so long")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxNoOp), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+	noOp, ok := memo.(NoOpMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(noOp.Note, Equals, "thanks for all the fish: so long")
+	c.Check(memo.String(), Equals, "NOOP:thanks for all the fish: so long")
+
+	memo, err = ParseMemo("#")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxNoOp), Equals, true)
+	c.Check(memo.(NoOpMemo).Note, Equals, "")
+
+	memo, err = ParseMemo("#:" + strings.Repeat("a", MaxNoOpNoteLength))
+	c.Assert(err, IsNil)
+	c.Check(memo.Validate(), IsNil)
+
+	_, err = ParseMemo("#:" + strings.Repeat("a", MaxNoOpNoteLength+1))
+	c.Assert(err, NotNil)
+	c.Check(err.(MemoParseError).Field, Equals, "note")
+	c.Check(NewNoOpMemo(strings.Repeat("a", MaxNoOpNoteLength+1)).Validate(), NotNil)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -126,7 +127,16 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	ctx.Logger().Debug("Begin Block", "height", req.Header.Height)
-	version := am.keeper.GetLowestActiveVersion(ctx)
+	if err := updateThorchainVersion(ctx, am.keeper); err != nil {
+		ctx.Logger().Error("fail to update thorchain version", "error", err)
+	}
+	version := am.keeper.GetThorchainVersion(ctx)
+	// the store indexes are maintained from version 0.3.0, backfill them once the network upgrade to it
+	if version.GTE(semver.MustParse("0.3.0")) {
+		if err := am.keeper.RunMigrations(ctx); err != nil {
+			ctx.Logger().Error("fail to run store migrations", "error", err)
+		}
+	}
 	am.keeper.ClearObservingAddresses(ctx)
 	obMgr, err := am.versionedObserverManager.GetObserverManager(ctx, version)
 	if err != nil {
@@ -146,6 +156,14 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 		ctx.Logger().Error(fmt.Sprintf("constants for version(%s) is not available", version))
 		return
 	}
+	// prune observation history that is older than the retention period
+	observationHistoryBlocks := constantValues.GetInt64Value(constants.ObservationHistoryBlocks)
+	if err := am.keeper.PruneObservationHistory(ctx, ctx.BlockHeight()-observationHistoryBlocks); err != nil {
+		ctx.Logger().Error("fail to prune observation history", "error", err)
+	}
+	if err := am.keeper.PruneObservedOutboundTx(ctx, ctx.BlockHeight()-observationHistoryBlocks); err != nil {
+		ctx.Logger().Error("fail to prune observed outbound tx index", "error", err)
+	}
 
 	slasher, err := NewSlasher(am.keeper, version, am.versionedEventManager)
 	if err != nil {
@@ -167,7 +185,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 	ctx.Logger().Debug("End Block", "height", req.Height)
 
-	version := am.keeper.GetLowestActiveVersion(ctx)
+	version := am.keeper.GetThorchainVersion(ctx)
 	constantValues := constants.GetConstantValues(version)
 	if constantValues == nil {
 		ctx.Logger().Error(fmt.Sprintf("constants for version(%s) is not available", version))
@@ -205,9 +223,10 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	if err := slasher.LackSigning(ctx, constantValues, txStore); err != nil {
 		ctx.Logger().Error("Unable to slash for lack of signing:", "error", err)
 	}
-	newPoolCycle := constantValues.GetInt64Value(constants.NewPoolCycle)
-	// Enable a pool every newPoolCycle
-	if ctx.BlockHeight()%newPoolCycle == 0 {
+	// Enable a pool every NewPoolCycle
+	if poolCycleEnd, err := am.keeper.IsPoolCycleEnd(ctx); err != nil {
+		ctx.Logger().Error("fail to get pool cycle point", "error", err)
+	} else if poolCycleEnd {
 		if err := enableNextPool(ctx, am.keeper, eventMgr); err != nil {
 			ctx.Logger().Error("Unable to enable a pool", "error", err)
 		}
@@ -260,6 +279,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	if err := Fund(ctx, am.keeper, txStore, constantValues); err != nil {
 		ctx.Logger().Error("unable to fund yggdrasil", "error", err)
 	}
+	if err := vaultMgr.RebalanceYggdrasilFunds(ctx, version, constantValues); err != nil {
+		ctx.Logger().Error("unable to rebalance yggdrasil funds", "error", err)
+	}
 	gasMgr.EndBlock(ctx, am.keeper, eventMgr)
 
 	return validators
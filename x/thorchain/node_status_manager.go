@@ -0,0 +1,49 @@
+package thorchain
+
+import (
+	"fmt"
+)
+
+// validNodeStatusTransitions define all the status a node account is allowed to move to from a given status
+// a node account that doesn't exist yet (NodeUnknown) can be created in any status, for example from genesis
+// a disabled node account has to go through NodeReady before it can become active again
+var validNodeStatusTransitions = map[NodeStatus][]NodeStatus{
+	NodeUnknown:     {NodeWhiteListed, NodeStandby, NodeReady, NodeActive, NodeDisabled},
+	NodeWhiteListed: {NodeStandby, NodeDisabled},
+	NodeStandby:     {NodeReady, NodeDisabled},
+	NodeReady:       {NodeStandby, NodeActive, NodeDisabled},
+	NodeActive:      {NodeStandby, NodeDisabled},
+	NodeDisabled:    {NodeReady},
+}
+
+// NodeStatusTransitionManager make sure node account only move between status following the valid path
+type NodeStatusTransitionManager struct {
+	transitions map[NodeStatus][]NodeStatus
+}
+
+// NewNodeStatusTransitionManager create a new instance of NodeStatusTransitionManager
+func NewNodeStatusTransitionManager() NodeStatusTransitionManager {
+	return NodeStatusTransitionManager{
+		transitions: validNodeStatusTransitions,
+	}
+}
+
+// Transition check whether a node account is allowed to move from status `from` to status `to`
+// staying in the same status is always allowed
+func (m NodeStatusTransitionManager) Transition(from, to NodeStatus) error {
+	if err := from.Valid(); err != nil {
+		return fmt.Errorf("invalid from status(%d): %w", from, err)
+	}
+	if err := to.Valid(); err != nil {
+		return fmt.Errorf("invalid to status(%d): %w", to, err)
+	}
+	if from == to {
+		return nil
+	}
+	for _, item := range m.transitions[from] {
+		if item == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("node status can't transit from %s to %s", from, to)
+}
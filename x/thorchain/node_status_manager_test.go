@@ -0,0 +1,70 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type NodeStatusTransitionManagerSuite struct{}
+
+var _ = Suite(&NodeStatusTransitionManagerSuite{})
+
+func (s *NodeStatusTransitionManagerSuite) TestTransition(c *C) {
+	allStatus := []NodeStatus{
+		NodeUnknown,
+		NodeWhiteListed,
+		NodeStandby,
+		NodeReady,
+		NodeActive,
+		NodeDisabled,
+	}
+	valid := map[NodeStatus]map[NodeStatus]bool{
+		NodeUnknown: {
+			NodeUnknown:     true,
+			NodeWhiteListed: true,
+			NodeStandby:     true,
+			NodeReady:       true,
+			NodeActive:      true,
+			NodeDisabled:    true,
+		},
+		NodeWhiteListed: {
+			NodeWhiteListed: true,
+			NodeStandby:     true,
+			NodeDisabled:    true,
+		},
+		NodeStandby: {
+			NodeStandby:  true,
+			NodeReady:    true,
+			NodeDisabled: true,
+		},
+		NodeReady: {
+			NodeStandby:  true,
+			NodeReady:    true,
+			NodeActive:   true,
+			NodeDisabled: true,
+		},
+		NodeActive: {
+			NodeStandby:  true,
+			NodeActive:   true,
+			NodeDisabled: true,
+		},
+		NodeDisabled: {
+			NodeReady:    true,
+			NodeDisabled: true,
+		},
+	}
+	m := NewNodeStatusTransitionManager()
+	for _, from := range allStatus {
+		for _, to := range allStatus {
+			err := m.Transition(from, to)
+			if valid[from][to] {
+				c.Check(err, IsNil, Commentf("%s => %s should be valid", from, to))
+			} else {
+				c.Check(err, NotNil, Commentf("%s => %s should be invalid", from, to))
+			}
+		}
+	}
+
+	// invalid status
+	c.Check(m.Transition(NodeStatus(100), NodeActive), NotNil)
+	c.Check(m.Transition(NodeActive, NodeStatus(100)), NotNil)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -64,12 +65,28 @@ func NewQuerier(keeper Keeper, validatorMgr VersionedValidatorManager) sdk.Queri
 			return queryVaultsPubkeys(ctx, keeper)
 		case q.QueryTSSSigners.Key:
 			return queryTSSSigners(ctx, path[1:], req, keeper)
+		case q.QueryVaultsByStatus.Key:
+			return queryVaultsByStatus(ctx, path[1:], req, keeper)
+		case q.QueryVault.Key:
+			return queryVault(ctx, path[1:], req, keeper)
+		case q.QueryObservations.Key:
+			return queryObservationHistory(ctx, path[1:], req, keeper)
 		case q.QueryConstantValues.Key:
 			return queryConstantValues(ctx, path[1:], req, keeper)
 		case q.QueryMimirValues.Key:
 			return queryMimirValues(ctx, path[1:], req, keeper)
 		case q.QueryBan.Key:
 			return queryBan(ctx, path[1:], req, keeper)
+		case q.QuerySlashHistory.Key:
+			return querySlashHistory(ctx, path[1:], req, keeper)
+		case q.QueryPoolVolume.Key:
+			return queryPoolVolume(ctx, path[1:], req, keeper)
+		case q.QueryChainContracts.Key:
+			return queryChainContracts(ctx, keeper)
+		case q.QueryMissingOutbounds.Key:
+			return queryMissingOutbounds(ctx, path[1:], req, keeper)
+		case q.QueryReserve.Key:
+			return queryReserve(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(
 				fmt.Sprintf("unknown thorchain query endpoint: %s", path[0]),
@@ -418,7 +435,18 @@ func queryPool(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	}
 
 	pool.PoolAddress = addr
-	res, err := codec.MarshalJSONIndent(keeper.Cdc(), pool)
+
+	activeNodes, err := keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get active node accounts", "error", err)
+		return nil, sdk.ErrInternal("fail to get active node accounts")
+	}
+	totalBonded := sdk.ZeroUint()
+	for _, na := range activeNodes {
+		totalBonded = totalBonded.Add(na.Bond)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), NewQueryResPool(pool, totalBonded, len(activeNodes)))
 	if err != nil {
 		return nil, sdk.ErrInternal("could not marshal result to JSON")
 	}
@@ -427,7 +455,11 @@ func queryPool(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 
 func queryPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	pools := QueryResPools{}
-	iterator := keeper.GetPoolIterator(ctx)
+	allPools, err := keeper.GetAllPoolsSorted(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return nil, sdk.ErrInternal("fail to get pools")
+	}
 
 	active, err := keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
@@ -435,12 +467,7 @@ func queryPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 		return nil, sdk.ErrInternal("fail to get active vaults")
 	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var pool Pool
-		if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &pool); err != nil {
-			return nil, sdk.ErrInternal("Unmarshl: Pool")
-		}
-
+	for _, pool := range allPools {
 		// ignore pool if no stake units
 		if pool.PoolUnits.IsZero() {
 			continue
@@ -776,6 +803,111 @@ func queryHeights(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 }
 
 // queryTSSSigner
+func queryVaultsByStatus(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || len(path[0]) == 0 {
+		return nil, sdk.ErrUnknownRequest("empty vault status")
+	}
+	status := VaultStatus(strings.ToLower(path[0]))
+	switch status {
+	case ActiveVault, RetiringVault, InactiveVault:
+	default:
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid vault status: %s", path[0]))
+	}
+
+	vaults := make(Vaults, 0)
+	iter := keeper.GetVaultIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vault Vault
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &vault); err != nil {
+			ctx.Logger().Error("fail to unmarshal vault", "error", err)
+			return nil, sdk.ErrInternal("fail to unmarshal vault")
+		}
+		if vault.Status == status {
+			vaults = append(vaults, vault)
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), vaults)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal vaults response to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal response to json")
+	}
+	return res, nil
+}
+
+func queryVault(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || len(path[0]) == 0 {
+		return nil, sdk.ErrUnknownRequest("empty vault pub key")
+	}
+	pk, err := common.NewPubKey(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse vault pub key", "error", err)
+		return nil, sdk.ErrUnknownRequest("invalid vault pub key")
+	}
+	if !keeper.VaultExists(ctx, pk) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("vault %s not found", pk))
+	}
+	vault, err := keeper.GetVault(ctx, pk)
+	if err != nil {
+		ctx.Logger().Error("fail to get vault", "error", err)
+		return nil, sdk.ErrInternal("fail to get vault")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), vault)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal vault to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal response to json")
+	}
+	return res, nil
+}
+
+func getHeightFromQuery(u *url.URL, name string) (int64, error) {
+	if u == nil {
+		return 0, errors.New("empty url")
+	}
+	value := u.Query().Get(name)
+	if len(value) == 0 {
+		return 0, fmt.Errorf("%s is required", name)
+	}
+	height, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s(%s): %w", name, value, err)
+	}
+	return height, nil
+}
+
+func queryObservationHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	u, err := getURLFromData(req.Data)
+	if err != nil {
+		ctx.Logger().Error(err.Error())
+	}
+	from, err := getHeightFromQuery(u, "from")
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	to, err := getHeightFromQuery(u, "to")
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	if from < 0 || to < from {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid block height range, from: %d, to: %d", from, to))
+	}
+
+	txs, err := keeper.GetObservationHistory(ctx, from, to)
+	if err != nil {
+		ctx.Logger().Error("fail to get observation history", "error", err)
+		return nil, sdk.ErrInternal("fail to get observation history")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), txs)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal observation history to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal response to json")
+	}
+	return res, nil
+}
+
 func queryTSSSigners(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	vaultPubKey := path[0]
 	if len(vaultPubKey) == 0 {
@@ -841,7 +973,7 @@ func queryTSSSigners(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 }
 
 func queryConstantValues(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	ver := keeper.GetLowestActiveVersion(ctx)
+	ver := keeper.GetThorchainVersion(ctx)
 	constAccessor := constants.GetConstantValues(ver)
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), constAccessor)
 	if err != nil {
@@ -891,3 +1023,131 @@ func queryBan(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keep
 	}
 	return res, nil
 }
+
+func querySlashHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("node address not provided")
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		ctx.Logger().Error("invalid node address", "error", err)
+		return nil, sdk.ErrInternal("invalid node address")
+	}
+
+	history, err := keeper.GetNodeAccountSlashHistory(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get slash history", "error", err)
+		return nil, sdk.ErrInternal("fail to get slash history")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), history)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal slash history to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal slash history to json")
+	}
+	return res, nil
+}
+
+// queryPoolVolume return the swap volume (in RUNE) of a pool over a period, only 24h is supported for now
+func queryPoolVolume(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("asset not provided")
+	}
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return nil, sdk.ErrUnknownRequest("invalid asset")
+	}
+	period := "24h"
+	u, err := getURLFromData(req.Data)
+	if err == nil && len(u.Query().Get("period")) > 0 {
+		period = u.Query().Get("period")
+	}
+	if period != "24h" {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unsupported period: %s", period))
+	}
+
+	volume, err := keeper.GetPool24hVolume(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool volume", "error", err)
+		return nil, sdk.ErrInternal("fail to get pool volume")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), struct {
+		Asset  common.Asset `json:"asset"`
+		Period string       `json:"period"`
+		Volume sdk.Uint     `json:"volume"`
+	}{
+		Asset:  asset,
+		Period: period,
+		Volume: volume,
+	})
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pool volume to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal pool volume to json")
+	}
+	return res, nil
+}
+
+func queryChainContracts(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	contracts, err := keeper.GetAllChainContracts(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get chain contracts", "error", err)
+		return nil, sdk.ErrInternal("fail to get chain contracts")
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), contracts)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal chain contracts to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal chain contracts to json")
+	}
+	return res, nil
+}
+
+// queryReserve return the current RUNE balance of the reserve
+func queryReserve(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), QueryResReserve{
+		Reserve: keeper.GetReserveBalance(ctx),
+	})
+	if err != nil {
+		ctx.Logger().Error("fail to marshal reserve to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal reserve to json")
+	}
+	return res, nil
+}
+
+// queryMissingOutbounds return the tx out items scheduled at the given block height that haven't been observed yet
+func queryMissingOutbounds(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("block height not provided")
+	}
+	height, err := strconv.ParseInt(path[0], 0, 64)
+	if err != nil || height <= 0 {
+		ctx.Logger().Error("fail to parse block height", "error", err)
+		return nil, sdk.ErrUnknownRequest("fail to parse block height")
+	}
+	if height > ctx.BlockHeight() {
+		return nil, sdk.ErrUnknownRequest("block height not available yet")
+	}
+
+	txOut, err := keeper.GetTxOut(ctx, height)
+	if err != nil {
+		ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
+		return nil, sdk.ErrInternal("fail to get tx out array from key value store")
+	}
+	scheduled := make([]TxOutItem, 0, len(txOut.TxArray))
+	for _, item := range txOut.TxArray {
+		scheduled = append(scheduled, *item)
+	}
+	missing, err := keeper.GetMissingOutbounds(ctx, height, scheduled)
+	if err != nil {
+		ctx.Logger().Error("fail to get missing outbounds", "error", err)
+		return nil, sdk.ErrInternal("fail to get missing outbounds")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), missing)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal missing outbounds to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal missing outbounds to json")
+	}
+	return res, nil
+}
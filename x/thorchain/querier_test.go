@@ -2,6 +2,7 @@ package thorchain
 
 import (
 	"encoding/json"
+	"net/url"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -111,6 +112,44 @@ func (s *QuerierSuite) TestQueryPool(c *C) {
 	c.Assert(len(out), Equals, 2)
 }
 
+func (s *QuerierSuite) TestQuerySinglePool(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+
+	pubKey := GetRandomPubKey()
+	asgard := NewVault(ctx.BlockHeight(), ActiveVault, AsgardVault, pubKey, common.Chains{common.BNBChain})
+	c.Assert(keeper.SetVault(ctx, asgard), IsNil)
+
+	for i := 0; i < 4; i++ {
+		na := GetRandomNodeAccount(NodeActive)
+		na.Bond = sdk.NewUint(750 * common.One)
+		c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+	}
+
+	poolBNB := NewPool()
+	poolBNB.Asset = common.BNBAsset
+	poolBNB.PoolUnits = sdk.NewUint(100)
+	poolBNB.BalanceRune = sdk.NewUint(100 * common.One)
+	poolBNB.BalanceAsset = sdk.NewUint(100 * common.One)
+	c.Assert(keeper.SetPool(ctx, poolBNB), IsNil)
+
+	res, err := querier(ctx, []string{"pool", common.BNBAsset.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	var out types.QueryResPool
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(out.SecurityBond.Equal(sdk.NewUint(500*common.One)), Equals, true, Commentf("%s", out.SecurityBond))
+	c.Check(out.IsSecure, Equals, true)
+}
+
 func (s *QuerierSuite) TestQueryNodeAccounts(c *C) {
 	ctx, keeper := setupKeeperForTest(c)
 
@@ -190,6 +229,7 @@ func (s *QuerierSuite) TestQueryCompEvents(c *C) {
 	stake := NewEventStake(
 		common.BNBAsset,
 		sdk.NewUint(5),
+		txIn.FromAddress,
 		txIn,
 	)
 	stakeBytes, _ := json.Marshal(stake)
@@ -299,3 +339,260 @@ func (s *QuerierSuite) TestQueryCompEvents(c *C) {
 	c.Assert(out[2].OutTxs[0].Chain.Equals(common.BTCChain), Equals, true)
 	c.Assert(out[3].InTx.Chain.IsEmpty(), Equals, true)
 }
+
+func (s *QuerierSuite) TestQueryVaults(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+
+	active := GetRandomVault()
+	c.Assert(keeper.SetVault(ctx, active), IsNil)
+	retiring := GetRandomVault()
+	retiring.Status = RetiringVault
+	c.Assert(keeper.SetVault(ctx, retiring), IsNil)
+
+	res, err := querier(ctx, []string{"vaultsbystatus", "active"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out Vaults
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].PubKey.Equals(active.PubKey), Equals, true)
+
+	res, err = querier(ctx, []string{"vaultsbystatus", "inactive"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 0)
+
+	_, err = querier(ctx, []string{"vaultsbystatus", "whatever"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	res, err = querier(ctx, []string{"vault", retiring.PubKey.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var vault Vault
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &vault), IsNil)
+	c.Check(vault.PubKey.Equals(retiring.PubKey), Equals, true)
+	c.Check(vault.Status, Equals, RetiringVault)
+
+	_, err = querier(ctx, []string{"vault", GetRandomPubKey().String()}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"vault", "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryObservationHistory(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+
+	for height := int64(10); height < 20; height++ {
+		c.Assert(keeper.AddObservation(ctx.WithBlockHeight(height), GetRandomObservedTx(), GetRandomBech32Addr()), IsNil)
+	}
+
+	getRequest := func(rawQuery string) abci.RequestQuery {
+		u := &url.URL{Path: "/thorchain/observations", RawQuery: rawQuery}
+		buf, err := u.MarshalBinary()
+		c.Assert(err, IsNil)
+		return abci.RequestQuery{Data: buf}
+	}
+
+	res, err := querier(ctx, []string{"observations"}, getRequest("from=12&to=15"))
+	c.Assert(err, IsNil)
+	var out ObservedTxs
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 4)
+
+	_, err = querier(ctx, []string{"observations"}, getRequest("from=12"))
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"observations"}, getRequest("from=15&to=12"))
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"observations"}, getRequest("from=abc&to=12"))
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQuerySlashHistory(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+
+	addr := GetRandomBech32Addr()
+	c.Assert(keeper.RecordSlash(ctx.WithBlockHeight(10), addr, "fail to keysign", 2), IsNil)
+	c.Assert(keeper.RecordSlash(ctx.WithBlockHeight(12), addr, "lack of observation", 1), IsNil)
+
+	res, err := querier(ctx, []string{"slashes", addr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out SlashRecords
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 2)
+	c.Check(out[1].Reason, Equals, "lack of observation")
+
+	_, err = querier(ctx, []string{"slashes", "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"slashes"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPoolVolume(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+	c.Assert(keeper.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+
+	ctx = ctx.WithBlockHeight(20)
+	c.Assert(keeper.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(common.One), 20), IsNil)
+	c.Assert(keeper.RecordSwapVolume(ctx, common.BNBAsset, sdk.NewUint(2*common.One), 20), IsNil)
+
+	getRequest := func(rawQuery string) abci.RequestQuery {
+		u := &url.URL{Path: "/thorchain/pool/BNB.BNB/volume", RawQuery: rawQuery}
+		buf, err := u.MarshalBinary()
+		c.Assert(err, IsNil)
+		return abci.RequestQuery{Data: buf}
+	}
+
+	res, err := querier(ctx, []string{"poolvolume", "BNB.BNB"}, getRequest("period=24h"))
+	c.Assert(err, IsNil)
+	var out struct {
+		Asset  common.Asset `json:"asset"`
+		Period string       `json:"period"`
+		Volume sdk.Uint     `json:"volume"`
+	}
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(out.Period, Equals, "24h")
+	c.Check(out.Volume.Equal(sdk.NewUint(3*common.One)), Equals, true)
+
+	// period defaults to 24h
+	_, err = querier(ctx, []string{"poolvolume", "BNB.BNB"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	_, err = querier(ctx, []string{"poolvolume", "BNB.BNB"}, getRequest("period=7d"))
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"poolvolume", "X.BNB"}, getRequest(""))
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"poolvolume"}, getRequest(""))
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryChainContracts(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+	c.Assert(keeper.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+
+	addr := common.Address("0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473")
+	c.Assert(keeper.SetChainContract(ctx, NewChainContract(common.ETHChain, addr, "[]")), IsNil)
+
+	res, err := querier(ctx, []string{"chaincontracts"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out ChainContracts
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Chain.Equals(common.ETHChain), Equals, true)
+	c.Check(out[0].ContractAddress.Equals(addr), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryReserve(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+	c.Assert(keeper.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		FundModule(c, ctx, keeper, AsgardName, 100)
+	}
+	c.Assert(keeper.AddToReserve(ctx, sdk.NewUint(100*common.One)), IsNil)
+
+	res, err := querier(ctx, []string{"reserve"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out QueryResReserve
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.Reserve.Equal(sdk.NewUint(100*common.One)), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryMissingOutbounds(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	validatorMgr := NewVersionedValidatorMgr(keeper, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+
+	querier := NewQuerier(keeper, validatorMgr)
+	ctx = ctx.WithBlockHeight(20)
+
+	vaultPubKey := GetRandomPubKey()
+	item1 := &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vaultPubKey,
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(common.One)),
+		InHash:      GetRandomTxHash(),
+	}
+	item2 := &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vaultPubKey,
+		Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(common.One)),
+		InHash:      GetRandomTxHash(),
+	}
+	txOut := NewTxOut(10)
+	txOut.TxArray = append(txOut.TxArray, item1, item2)
+	c.Assert(keeper.SetTxOut(ctx, txOut), IsNil)
+
+	tx := GetRandomTx()
+	tx.ToAddress = item1.ToAddress
+	tx.Coins = common.Coins{item1.Coin}
+	voter := NewObservedTxVoter(tx.ID, nil)
+	voter.Tx = NewObservedTx(tx, 12, vaultPubKey)
+	keeper.SetObservedTxVoter(ctx, voter)
+	c.Assert(keeper.AddObservedOutboundTx(ctx, 10, tx.ID), IsNil)
+
+	res, err := querier(ctx, []string{"missingoutbounds", "10"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out []TxOutItem
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].ToAddress.Equals(item2.ToAddress), Equals, true)
+
+	_, err = querier(ctx, []string{"missingoutbounds", "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"missingoutbounds", "30"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"missingoutbounds"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
@@ -55,9 +55,17 @@ var (
 	QueryVaultsYggdrasil    = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
 	QueryVaultPubkeys       = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
 	QueryTSSSigners         = Query{Key: "tsssigner", EndpointTemplate: "/%s/vaults/{%s}/signers"}
+	QueryVaultsByStatus     = Query{Key: "vaultsbystatus", EndpointTemplate: "/%s/vaults/{%s}"}
+	QueryVault              = Query{Key: "vault", EndpointTemplate: "/%s/vault/{%s}"}
+	QueryObservations       = Query{Key: "observations", EndpointTemplate: "/%s/observations"}
 	QueryConstantValues     = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
 	QueryMimirValues        = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
 	QueryBan                = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
+	QuerySlashHistory       = Query{Key: "slashes", EndpointTemplate: "/%s/node/{%s}/slashes"}
+	QueryPoolVolume         = Query{Key: "poolvolume", EndpointTemplate: "/%s/pool/{%s}/volume"}
+	QueryChainContracts     = Query{Key: "chaincontracts", EndpointTemplate: "/%s/contracts"}
+	QueryMissingOutbounds   = Query{Key: "missingoutbounds", EndpointTemplate: "/%s/tx/missing-outbounds/{%s}"}
+	QueryReserve            = Query{Key: "reserve", EndpointTemplate: "/%s/reserve"}
 )
 
 // Queries all queries
@@ -84,7 +92,15 @@ var Queries = []Query{
 	QueryVaultPubkeys,
 	QueryKeygensPubkey,
 	QueryTSSSigners,
+	QueryVaultsByStatus,
+	QueryVault,
+	QueryObservations,
 	QueryConstantValues,
 	QueryMimirValues,
 	QueryBan,
+	QuerySlashHistory,
+	QueryPoolVolume,
+	QueryChainContracts,
+	QueryMissingOutbounds,
+	QueryReserve,
 }
@@ -16,3 +16,40 @@ func (s QuerySuite) TestQuery(c *C) {
 	c.Check(QueryTxIn.Endpoint("foo", "bar"), Equals, "/foo/tx/{bar}")
 	c.Check(QueryTxIn.Path("foo", "bar"), Equals, "custom/foo/txin/bar")
 }
+
+func (s QuerySuite) TestQueryVaults(c *C) {
+	c.Check(QueryVaultsByStatus.Endpoint("foo", "bar"), Equals, "/foo/vaults/{bar}")
+	c.Check(QueryVaultsByStatus.Path("foo", "active"), Equals, "custom/foo/vaultsbystatus/active")
+	c.Check(QueryVault.Endpoint("foo", "bar"), Equals, "/foo/vault/{bar}")
+	c.Check(QueryVault.Path("foo", "bar"), Equals, "custom/foo/vault/bar")
+}
+
+func (s QuerySuite) TestQueryObservations(c *C) {
+	c.Check(QueryObservations.Endpoint("foo"), Equals, "/foo/observations")
+	c.Check(QueryObservations.Path("foo"), Equals, "custom/foo/observations")
+}
+
+func (s QuerySuite) TestQuerySlashHistory(c *C) {
+	c.Check(QuerySlashHistory.Endpoint("foo", "bar"), Equals, "/foo/node/{bar}/slashes")
+	c.Check(QuerySlashHistory.Path("foo", "bar"), Equals, "custom/foo/slashes/bar")
+}
+
+func (s QuerySuite) TestQueryPoolVolume(c *C) {
+	c.Check(QueryPoolVolume.Endpoint("foo", "bar"), Equals, "/foo/pool/{bar}/volume")
+	c.Check(QueryPoolVolume.Path("foo", "bar"), Equals, "custom/foo/poolvolume/bar")
+}
+
+func (s QuerySuite) TestQueryChainContracts(c *C) {
+	c.Check(QueryChainContracts.Endpoint("foo"), Equals, "/foo/contracts")
+	c.Check(QueryChainContracts.Path("foo"), Equals, "custom/foo/chaincontracts")
+}
+
+func (s QuerySuite) TestQueryMissingOutbounds(c *C) {
+	c.Check(QueryMissingOutbounds.Endpoint("foo", "bar"), Equals, "/foo/tx/missing-outbounds/{bar}")
+	c.Check(QueryMissingOutbounds.Path("foo", "bar"), Equals, "custom/foo/missingoutbounds/bar")
+}
+
+func (s QuerySuite) TestQueryReserve(c *C) {
+	c.Check(QueryReserve.Endpoint("foo"), Equals, "/foo/reserve")
+	c.Check(QueryReserve.Path("foo"), Equals, "custom/foo/reserve")
+}
@@ -141,6 +141,9 @@ func (s *Slasher) LackObserving(ctx sdk.Context, constAccessor constants.Constan
 			if err := s.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, lackOfObservationPenalty); err != nil {
 				ctx.Logger().Error("fail to inc slash points", "error", err)
 			}
+			if err := s.keeper.RecordSlash(ctx, na.NodeAddress, "lack of observation", lackOfObservationPenalty); err != nil {
+				ctx.Logger().Error("fail to record slash", "error", err)
+			}
 		}
 	}
 
@@ -183,6 +186,9 @@ func (s *Slasher) LackSigning(ctx sdk.Context, constAccessor constants.ConstantV
 						if err := s.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, signingTransPeriod*2); err != nil {
 							ctx.Logger().Error("fail to inc slash points", "error", err)
 						}
+						if err := s.keeper.RecordSlash(ctx, na.NodeAddress, "fail to sign outbound tx", signingTransPeriod*2); err != nil {
+							ctx.Logger().Error("fail to record slash", "error", err)
+						}
 					}
 
 					active, err := s.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
@@ -225,81 +231,3 @@ func (s *Slasher) LackSigning(ctx sdk.Context, constAccessor constants.ConstantV
 	}
 	return nil
 }
-
-// slashNodeAccount thorchain keep monitoring the outbound tx from asgard pool
-// and yggdrasil pool, usually the txout is triggered by thorchain itself by
-// adding an item into the txout array, refer to TxOutItem for the detail, the
-// TxOutItem contains a specific coin and amount.  if somehow thorchain
-// discover signer send out fund more than the amount specified in TxOutItem,
-// it will slash the node account who does that by taking 1.5 * extra fund from
-// node account's bond and subsidise the pool that actually lost it.
-func (s *Slasher) SlashNodeAccount(ctx sdk.Context, observedPubKey common.PubKey, asset common.Asset, slashAmount sdk.Uint) error {
-	if slashAmount.IsZero() {
-		return nil
-	}
-	nodeAccount, err := s.keeper.GetNodeAccountByPubKey(ctx, observedPubKey)
-	if err != nil {
-		return fmt.Errorf("fail to get node account with pubkey(%s), %w", observedPubKey, err)
-	}
-
-	if nodeAccount.Status == NodeUnknown {
-		return nil
-	}
-
-	if asset.IsRune() {
-		// If rune, we take 1.5x the amount, and take it from their bond. We
-		// put 1/3rd of it into the reserve, and 2/3rds into the pools (but
-		// keeping the rune pool balances unchanged)
-		amountToReserve := slashAmount.QuoUint64(2)
-		// if the diff asset is RUNE , just took 1.5 * diff from their bond
-		slashAmount = slashAmount.MulUint64(3).QuoUint64(2)
-		nodeAccount.Bond = common.SafeSub(nodeAccount.Bond, slashAmount)
-		vaultData, err := s.keeper.GetVaultData(ctx)
-		if err != nil {
-			return fmt.Errorf("fail to get vault data: %w", err)
-		}
-		vaultData.TotalReserve = vaultData.TotalReserve.Add(amountToReserve)
-		if err := s.keeper.SetVaultData(ctx, vaultData); err != nil {
-			return fmt.Errorf("fail to save vault data: %w", err)
-		}
-		return s.keeper.SetNodeAccount(ctx, nodeAccount)
-	}
-	pool, err := s.keeper.GetPool(ctx, asset)
-	if err != nil {
-		return fmt.Errorf("fail to get %s pool : %w", asset, err)
-	}
-	// thorchain doesn't even have a pool for the asset, or the pool had been
-	// suspended, then who cares
-	if pool.Empty() || pool.Status == PoolSuspended {
-		return nil
-	}
-	runeValue := pool.AssetValueInRune(slashAmount).MulUint64(3).QuoUint64(2)
-	pool.BalanceAsset = common.SafeSub(pool.BalanceAsset, slashAmount)
-	pool.BalanceRune = pool.BalanceRune.Add(runeValue)
-	nodeAccount.Bond = common.SafeSub(nodeAccount.Bond, runeValue)
-	if err := s.keeper.SetPool(ctx, pool); err != nil {
-		return fmt.Errorf("fail to save %s pool: %w", asset, err)
-	}
-
-	poolSlashAmt := []PoolAmt{
-		{
-			Asset:  pool.Asset,
-			Amount: 0 - int64(slashAmount.Uint64()),
-		},
-		{
-			Asset:  common.RuneAsset(),
-			Amount: int64(runeValue.Uint64()),
-		},
-	}
-	eventSlash := NewEventSlash(pool.Asset, poolSlashAmt)
-	eventMgr, err := s.versionedEventManager.GetEventManager(ctx, s.version)
-	if err != nil {
-		return fmt.Errorf("fail to get event manager: %w", err)
-	}
-
-	if err := eventMgr.EmitSlashEvent(ctx, s.keeper, eventSlash); err != nil {
-		return fmt.Errorf("fail to emit slash event: %w", err)
-	}
-
-	return s.keeper.SetNodeAccount(ctx, nodeAccount)
-}
@@ -298,6 +298,7 @@ func (s *SlashingSuite) TestNodeSignSlashErrors(c *C) {
 			sdk.NewUint(5),
 			sdk.NewUint(5),
 			sdk.NewUint(5),
+			false,
 			inTx,
 		)
 
@@ -371,6 +372,7 @@ func (s *SlashingSuite) TestNotSigningSlash(c *C) {
 		sdk.NewUint(5),
 		sdk.NewUint(5),
 		sdk.NewUint(5),
+		false,
 		inTx,
 	)
 
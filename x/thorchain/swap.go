@@ -49,6 +49,7 @@ func swap(ctx sdk.Context,
 	target common.Asset,
 	destination common.Address,
 	tradeTarget sdk.Uint,
+	affiliateBps sdk.Uint,
 	transactionFee sdk.Uint) (sdk.Uint, []EventSwap, sdk.Error) {
 	var swapEvents []EventSwap
 
@@ -60,51 +61,49 @@ func swap(ctx sdk.Context,
 	if err := validatePools(ctx, keeper, source, target); err != nil {
 		return sdk.ZeroUint(), swapEvents, err
 	}
-	pools := make([]Pool, 0)
-	isDoubleSwap := !source.IsRune() && !target.IsRune()
-	if isDoubleSwap {
-		var swapErr sdk.Error
-		var swapEvt EventSwap
-		var amt sdk.Uint
-		// Here we use a tradeTarget of 0 because the target is for the next swap asset in a double swap
-		amt, sourcePool, swapEvt, swapErr := swapOne(ctx, keeper, tx, common.RuneAsset(), destination, sdk.ZeroUint(), transactionFee)
-		if swapErr != nil {
-			return sdk.ZeroUint(), swapEvents, swapErr
-		}
-		pools = append(pools, sourcePool)
-		tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), amt)}
-		tx.Gas = nil
-		swapEvt.OutTxs = common.NewTx(common.BlankTxID, tx.FromAddress, tx.ToAddress, tx.Coins, tx.Gas, tx.Memo)
-		swapEvents = append(swapEvents, swapEvt)
+	if !source.IsRune() && !target.IsRune() {
+		return NewDoubleSwapRouter(keeper).Swap(ctx, tx, target, destination, tradeTarget, affiliateBps, transactionFee)
 	}
 
-	assetAmount, pool, swapEvt, swapErr := swapOne(ctx, keeper, tx, target, destination, tradeTarget, transactionFee)
+	assetAmount, pool, swapEvt, swapErr := swapOne(ctx, keeper, tx, target, destination, tradeTarget, transactionFee, false)
 	if swapErr != nil {
 		return sdk.ZeroUint(), swapEvents, swapErr
 	}
 	swapEvents = append(swapEvents, swapEvt)
-	pools = append(pools, pool)
+	if err := validateSwapOutput(common.SafeSub(assetAmount, calcAffiliateFee(assetAmount, affiliateBps)), target, tradeTarget, transactionFee); err != nil {
+		return sdk.ZeroUint(), swapEvents, err
+	}
+
+	if err := keeper.SetPool(ctx, pool); err != nil {
+		return sdk.ZeroUint(), swapEvents, sdk.NewError(DefaultCodespace, CodeSwapFail, err.Error())
+	}
+
+	return assetAmount, swapEvents, nil
+}
+
+// calcAffiliateFee calculate the share of the swap output paid to the affiliate
+func calcAffiliateFee(amount, affiliateBps sdk.Uint) sdk.Uint {
+	if affiliateBps.IsZero() {
+		return sdk.ZeroUint()
+	}
+	return common.GetShare(affiliateBps, sdk.NewUint(10_000), amount)
+}
+
+// validateSwapOutput check the emitted asset meets the trade target and is enough to pay for the transaction fee
+func validateSwapOutput(assetAmount sdk.Uint, target common.Asset, tradeTarget, transactionFee sdk.Uint) sdk.Error {
 	if !tradeTarget.IsZero() && assetAmount.LT(tradeTarget) {
-		return sdk.ZeroUint(), swapEvents, sdk.NewError(DefaultCodespace, CodeSwapFailTradeTarget, "emit asset %s less than price limit %s", assetAmount, tradeTarget)
+		return sdk.NewError(DefaultCodespace, CodeSwapFailTradeTarget, "emit asset %s less than price limit %s", assetAmount, tradeTarget)
 	}
 	if target.IsRune() {
 		if assetAmount.LTE(transactionFee) {
-			return sdk.ZeroUint(), swapEvents, sdk.NewError(DefaultCodespace, CodeSwapFailNotEnoughFee, "output RUNE (%s) is not enough to pay transaction fee", assetAmount)
+			return sdk.NewError(DefaultCodespace, CodeSwapFailNotEnoughFee, "output RUNE (%s) is not enough to pay transaction fee", assetAmount)
 		}
 	}
 	// emit asset is zero
 	if assetAmount.IsZero() {
-		return sdk.ZeroUint(), swapEvents, sdk.NewError(DefaultCodespace, CodeSwapFailZeroEmitAsset, "zero emit asset")
+		return sdk.NewError(DefaultCodespace, CodeSwapFailZeroEmitAsset, "zero emit asset")
 	}
-
-	// Update pools
-	for _, pool := range pools {
-		if err := keeper.SetPool(ctx, pool); err != nil {
-			return sdk.ZeroUint(), swapEvents, sdk.NewError(DefaultCodespace, CodeSwapFail, err.Error())
-		}
-	}
-
-	return assetAmount, swapEvents, nil
+	return nil
 }
 
 func swapOne(ctx sdk.Context,
@@ -112,14 +111,14 @@ func swapOne(ctx sdk.Context,
 	target common.Asset,
 	destination common.Address,
 	tradeTarget sdk.Uint,
-	transactionFee sdk.Uint) (amt sdk.Uint, poolResult Pool, evt EventSwap, swapErr sdk.Error) {
+	transactionFee sdk.Uint,
+	doubleSwap bool) (amt sdk.Uint, poolResult Pool, evt EventSwap, swapErr sdk.Error) {
 	source := tx.Coins[0].Asset
 	amount := tx.Coins[0].Amount
 
 	ctx.Logger().Info(fmt.Sprintf("%s Swapping %s(%s) -> %s to %s", tx.FromAddress, source, tx.Coins[0].Amount, target, destination))
 
-	var X, x, Y, liquidityFee, emitAssets sdk.Uint
-	var tradeSlip sdk.Uint
+	var emitAssets sdk.Uint
 
 	// Set asset to our non-rune asset asset
 	asset := source
@@ -137,6 +136,7 @@ func swapOne(ctx sdk.Context,
 		sdk.ZeroUint(),
 		sdk.ZeroUint(),
 		sdk.ZeroUint(),
+		doubleSwap,
 		tx,
 	)
 
@@ -156,62 +156,72 @@ func swapOne(ctx sdk.Context,
 		return sdk.ZeroUint(), pool, evt, sdk.NewError(DefaultCodespace, CodeInvalidPoolStatus, "pool %s is in %s status, can't swap", asset.String(), pool.Status)
 	}
 
-	// Get our X, x, Y values
+	ctx.Logger().Debug(fmt.Sprintf("Pre-Pool: %sRune %sAsset", pool.BalanceRune, pool.BalanceAsset))
+	poolResult, fee, err := applySlipFee(pool, amount, source.IsRune())
+	if err != nil {
+		return sdk.ZeroUint(), pool, evt, err
+	}
+	liquidityFee := fee.Coins[0].Amount
+	swapEvt.LiquidityFee = liquidityFee
 	if source.IsRune() {
+		swapEvt.LiquidityFeeInRune = pool.AssetValueInRune(liquidityFee)
+		swapEvt.TradeSlip = calcTradeSlip(pool.BalanceRune, amount)
+		emitAssets = common.SafeSub(pool.BalanceAsset, poolResult.BalanceAsset)
+	} else {
+		// because the output asset is RUNE , so liqualidtyFee is already in RUNE
+		swapEvt.LiquidityFeeInRune = liquidityFee
+		swapEvt.TradeSlip = calcTradeSlip(pool.BalanceAsset, amount)
+		emitAssets = common.SafeSub(pool.BalanceRune, poolResult.BalanceRune)
+	}
+	ctx.Logger().Debug(fmt.Sprintf("Post-swap: %sRune %sAsset , user get:%s ", poolResult.BalanceRune, poolResult.BalanceAsset, emitAssets))
+
+	return emitAssets, poolResult, swapEvt, nil
+}
+
+// applySlipFee swap the given input amount against the pool using the constant product formula, the liquidity fee
+// stays in the pool. It returns the pool balances after the swap, and the liquidity fee paid in the output asset
+func applySlipFee(pool Pool, inputAmount sdk.Uint, runeToAsset bool) (Pool, common.Fee, sdk.Error) {
+	// Get our X, x, Y values
+	X := pool.BalanceAsset
+	Y := pool.BalanceRune
+	outputAsset := common.RuneAsset()
+	if runeToAsset {
 		X = pool.BalanceRune
 		Y = pool.BalanceAsset
-	} else {
-		Y = pool.BalanceRune
-		X = pool.BalanceAsset
+		outputAsset = pool.Asset
 	}
-	x = amount
+	x := inputAmount
 
 	// check our X,x,Y values are valid
 	if x.IsZero() {
-		return sdk.ZeroUint(), pool, evt, sdk.NewError(DefaultCodespace, CodeSwapFailInvalidAmount, "amount is invalid")
+		return pool, common.Fee{}, sdk.NewError(DefaultCodespace, CodeSwapFailInvalidAmount, "amount is invalid")
 	}
 	if X.IsZero() || Y.IsZero() {
-		return sdk.ZeroUint(), pool, evt, sdk.NewError(DefaultCodespace, CodeSwapFailInvalidBalance, "invalid balance")
+		return pool, common.Fee{}, sdk.NewError(DefaultCodespace, CodeSwapFailInvalidBalance, "invalid balance")
 	}
 
-	liquidityFee = calcLiquidityFee(X, x, Y)
-	tradeSlip = calcTradeSlip(X, x)
-	emitAssets = calcAssetEmission(X, x, Y)
-	swapEvt.LiquidityFee = liquidityFee
-
-	if source.IsRune() {
-		swapEvt.LiquidityFeeInRune = pool.AssetValueInRune(liquidityFee)
-	} else {
-		// because the output asset is RUNE , so liqualidtyFee is already in RUNE
-		swapEvt.LiquidityFeeInRune = liquidityFee
-	}
-	swapEvt.TradeSlip = tradeSlip
+	liquidityFee := calcLiquidityFee(X, x, Y)
+	emitAssets := calcAssetEmission(X, x, Y)
 
 	// do THORNode have enough balance to swap?
 	if emitAssets.GTE(Y) {
-		return sdk.ZeroUint(), pool, evt, sdk.NewError(DefaultCodespace, CodeSwapFailNotEnoughBalance, "asset(%s) balance is %d, can't do swap", asset, Y)
+		return pool, common.Fee{}, sdk.NewError(DefaultCodespace, CodeSwapFailNotEnoughBalance, "asset(%s) balance is %d, can't do swap", pool.Asset, Y)
 	}
 
-	ctx.Logger().Debug(fmt.Sprintf("Pre-Pool: %sRune %sAsset", pool.BalanceRune, pool.BalanceAsset))
-
-	if source.IsRune() {
+	if runeToAsset {
 		pool.BalanceRune = X.Add(x)
 		pool.BalanceAsset = common.SafeSub(Y, emitAssets)
 	} else {
 		pool.BalanceAsset = X.Add(x)
 		pool.BalanceRune = common.SafeSub(Y, emitAssets)
 	}
-	ctx.Logger().Debug(fmt.Sprintf("Post-swap: %sRune %sAsset , user get:%s ", pool.BalanceRune, pool.BalanceAsset, emitAssets))
-
-	return emitAssets, pool, swapEvt, nil
+	fee := common.NewFee(common.Coins{common.NewCoin(outputAsset, liquidityFee)}, sdk.ZeroUint())
+	return pool, fee, nil
 }
 
 // calculate the number of assets sent to the address (includes liquidity fee)
 func calcAssetEmission(X, x, Y sdk.Uint) sdk.Uint {
-	// ( x * X * Y ) / ( x + X )^2
-	numerator := x.Mul(X).Mul(Y)
-	denominator := x.Add(X).Mul(x.Add(X))
-	return numerator.Quo(denominator)
+	return CalcAssetEmission(X, x, Y)
 }
 
 // calculateFee the fee of the swap
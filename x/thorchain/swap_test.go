@@ -68,7 +68,7 @@ func (k *TestSwapKeeper) AddToLiquidityFees(ctx sdk.Context, asset common.Asset,
 	return nil
 }
 
-func (k *TestSwapKeeper) GetLowestActiveVersion(ctx sdk.Context) semver.Version {
+func (k *TestSwapKeeper) GetThorchainVersion(ctx sdk.Context) semver.Version {
 	return constants.SWVersion
 }
 
@@ -275,7 +275,7 @@ func (s *SwapSuite) TestSwap(c *C) {
 			"",
 		)
 		tx.Chain = common.BNBChain
-		amount, evts, err := swap(ctx, poolStorage, tx, item.target, item.destination, item.tradeTarget, sdk.NewUint(1000_000))
+		amount, evts, err := swap(ctx, poolStorage, tx, item.target, item.destination, item.tradeTarget, sdk.ZeroUint(), sdk.NewUint(1000_000))
 		if item.expectedErr == nil {
 			c.Assert(err, IsNil)
 			c.Assert(evts, HasLen, len(item.events))
@@ -414,3 +414,39 @@ func (s SwapSuite) TestCalculators(c *C) {
 	c.Check(calcLiquidityFee(X, x, Y).Uint64(), Equals, uint64(82644628))
 	c.Check(calcTradeSlip(X, x).Uint64(), Equals, uint64(2100))
 }
+
+func (s SwapSuite) TestApplySlipFee(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+
+	// rune -> asset
+	result, fee, err := applySlipFee(pool, sdk.NewUint(10*common.One), true)
+	c.Assert(err, IsNil)
+	c.Check(result.BalanceRune.Uint64(), Equals, uint64(110*common.One))
+	c.Check(result.BalanceAsset.Uint64(), Equals, uint64(100*common.One-826446280))
+	c.Assert(fee.Coins, HasLen, 1)
+	c.Check(fee.Coins[0].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(fee.Coins[0].Amount.Uint64(), Equals, uint64(82644628))
+	c.Check(fee.PoolDeduct.IsZero(), Equals, true)
+	// the given pool is left untouched
+	c.Check(pool.BalanceRune.Uint64(), Equals, uint64(100*common.One))
+
+	// asset -> rune
+	result, fee, err = applySlipFee(pool, sdk.NewUint(10*common.One), false)
+	c.Assert(err, IsNil)
+	c.Check(result.BalanceAsset.Uint64(), Equals, uint64(110*common.One))
+	c.Check(result.BalanceRune.Uint64(), Equals, uint64(100*common.One-826446280))
+	c.Assert(fee.Coins, HasLen, 1)
+	c.Check(fee.Coins[0].Asset.IsRune(), Equals, true)
+	c.Check(fee.Coins[0].Amount.Uint64(), Equals, uint64(82644628))
+
+	_, _, err = applySlipFee(pool, sdk.ZeroUint(), true)
+	c.Check(err, NotNil)
+
+	empty := pool
+	empty.BalanceAsset = sdk.ZeroUint()
+	_, _, err = applySlipFee(empty, sdk.NewUint(10*common.One), true)
+	c.Check(err, NotNil)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -153,6 +154,16 @@ func (tos *TxOutStorageV1) prepareTxOutItem(ctx sdk.Context, toi *TxOutItem) (bo
 
 		// max gas amount is the transaction fee divided by two, in asset amount
 		maxAmt := pool.RuneValueInAsset(sdk.NewUint(uint64(transactionFee / 2)))
+		// prefer the network fee of the chain agreed by the active validators
+		if tos.keeper.GetThorchainVersion(ctx).GTE(semver.MustParse("0.3.0")) {
+			networkFee, err := tos.keeper.GetNetworkFee(ctx, toi.Chain)
+			if err != nil {
+				return false, fmt.Errorf("fail to get network fee: %w", err)
+			}
+			if !networkFee.IsZero() {
+				maxAmt = networkFee
+			}
+		}
 		toi.MaxGas = common.Gas{
 			common.NewCoin(gasAsset, maxAmt),
 		}
@@ -296,7 +307,11 @@ func (tos *TxOutStorageV1) nativeTxOut(ctx sdk.Context, toi *TxOutItem) error {
 		return err
 	}
 
-	tx := common.NewTx(txID, from, toi.ToAddress, common.Coins{toi.Coin}, common.Gas{}, toi.Memo)
+	tx := common.Tx{ID: txID, Gas: common.Gas{}}.
+		WithFromAddress(from).
+		WithToAddress(toi.ToAddress).
+		WithCoin(toi.Coin).
+		SetMemo(toi.Memo)
 
 	active, err := tos.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
@@ -309,7 +324,7 @@ func (tos *TxOutStorageV1) nativeTxOut(ctx sdk.Context, toi *TxOutItem) error {
 		BlockHeight:    ctx.BlockHeight(),
 		Tx:             tx,
 	}
-	m, err := processOneTxIn(ctx, tos.keeper, observedTx, supplier.GetModuleAddress(AsgardName))
+	m, err := processOneTxIn(ctx, tos.keeper.GetThorchainVersion(ctx), tos.keeper, observedTx, supplier.GetModuleAddress(AsgardName))
 	if err != nil {
 		ctx.Logger().Error("fail to process txOut", "error", err, "tx", tx.String())
 		return err
@@ -335,16 +350,28 @@ func (tos *TxOutStorageV1) nativeTxOut(ctx sdk.Context, toi *TxOutItem) error {
 func (tos *TxOutStorageV1) collectYggdrasilPools(ctx sdk.Context, tx ObservedTx, gasAsset common.Asset) (Vaults, error) {
 	// collect yggdrasil pools
 	var vaults Vaults
-	iterator := tos.keeper.GetVaultIterator(ctx)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var vault Vault
-		if err := tos.keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &vault); err != nil {
-			return nil, fmt.Errorf("fail to unmarshal vault: %w", err)
+	var yggs Vaults
+	if tos.keeper.GetThorchainVersion(ctx).GTE(semver.MustParse("0.3.0")) {
+		var err error
+		yggs, err = tos.keeper.GetYggdrasilVaults(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get yggdrasil vaults: %w", err)
 		}
-		if !vault.IsYggdrasil() {
-			continue
+	} else {
+		// the yggdrasil index doesn't exist before version 0.3.0
+		iterator := tos.keeper.GetVaultIterator(ctx)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var vault Vault
+			if err := tos.keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &vault); err != nil {
+				return nil, fmt.Errorf("fail to unmarshal vault: %w", err)
+			}
+			if vault.IsYggdrasil() {
+				yggs = append(yggs, vault)
+			}
 		}
+	}
+	for _, vault := range yggs {
 		// When trying to choose a ygg pool candidate to send out fund , let's make sure the ygg pool has gasAsset , for example, if it is
 		// on Binance chain , make sure ygg pool has BNB asset in it , otherwise it won't be able to pay the transaction fee
 		if !vault.HasAsset(gasAsset) {
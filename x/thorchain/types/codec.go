@@ -33,4 +33,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBan{}, "thorchain/MsgBan", nil)
 	cdc.RegisterConcrete(MsgSwitch{}, "thorchain/MsgSwitch", nil)
 	cdc.RegisterConcrete(MsgMimir{}, "thorchain/MsgMimir", nil)
+	cdc.RegisterConcrete(MsgSlash{}, "thorchain/MsgSlash", nil)
+	cdc.RegisterConcrete(MsgNetworkFeeVote{}, "thorchain/MsgNetworkFeeVote", nil)
+	cdc.RegisterConcrete(MsgPartialUnbond{}, "thorchain/MsgPartialUnbond", nil)
+	cdc.RegisterConcrete(MsgSetChainContract{}, "thorchain/MsgSetChainContract", nil)
 }
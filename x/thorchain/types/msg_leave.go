@@ -8,8 +8,9 @@ import (
 
 // MsgLeave when an operator don't want to be a validator anymore
 type MsgLeave struct {
-	Tx     common.Tx      `json:"tx"`
-	Signer sdk.AccAddress `json:"signer"`
+	Tx          common.Tx      `json:"tx"`
+	Signer      sdk.AccAddress `json:"signer"`
+	NodeAddress sdk.AccAddress `json:"node_address"` // optional, the node to leave, when empty the node is found by bond address
 }
 
 // NewMsgLeave create a new instance of MsgLeave
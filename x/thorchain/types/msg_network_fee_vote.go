@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MsgNetworkFeeVote defines a MsgNetworkFeeVote message, used by active validators to vote on
+// the network fee of a chain observed at the given block height
+type MsgNetworkFeeVote struct {
+	BlockHeight int64          `json:"block_height"`
+	Chain       common.Chain   `json:"chain"`
+	Fee         sdk.Uint       `json:"fee"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgNetworkFeeVote is a constructor function for MsgNetworkFeeVote
+func NewMsgNetworkFeeVote(blockHeight int64, chain common.Chain, fee sdk.Uint, signer sdk.AccAddress) MsgNetworkFeeVote {
+	return MsgNetworkFeeVote{
+		BlockHeight: blockHeight,
+		Chain:       chain,
+		Fee:         fee,
+		Signer:      signer,
+	}
+}
+
+// Route should return the cmname of the module
+func (msg MsgNetworkFeeVote) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgNetworkFeeVote) Type() string { return "set_network_fee" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgNetworkFeeVote) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if msg.BlockHeight <= 0 {
+		return sdk.ErrUnknownRequest("block height must be larger than zero")
+	}
+	if msg.Chain.IsEmpty() {
+		return sdk.ErrUnknownRequest("chain cannot be empty")
+	}
+	if msg.Fee.IsZero() {
+		return sdk.ErrUnknownRequest("fee cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgNetworkFeeVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgNetworkFeeVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgNetworkFeeVoteSuite struct{}
+
+var _ = Suite(&MsgNetworkFeeVoteSuite{})
+
+func (MsgNetworkFeeVoteSuite) TestMsgNetworkFeeVote(c *C) {
+	signer := GetRandomBech32Addr()
+	fee := sdk.NewUint(37500)
+	msg := NewMsgNetworkFeeVote(1024, common.BNBChain, fee, signer)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "set_network_fee")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(len(msg.GetSignBytes()) > 0, Equals, true)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, signer.String())
+
+	c.Check(NewMsgNetworkFeeVote(1024, common.BNBChain, fee, sdk.AccAddress{}).ValidateBasic(), NotNil)
+	c.Check(NewMsgNetworkFeeVote(0, common.BNBChain, fee, signer).ValidateBasic(), NotNil)
+	c.Check(NewMsgNetworkFeeVote(1024, common.EmptyChain, fee, signer).ValidateBasic(), NotNil)
+	c.Check(NewMsgNetworkFeeVote(1024, common.BNBChain, sdk.ZeroUint(), signer).ValidateBasic(), NotNil)
+}
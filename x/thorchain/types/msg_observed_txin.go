@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -63,3 +65,19 @@ func (msg MsgObservedTxIn) GetSignBytes() []byte {
 func (msg MsgObservedTxIn) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// ValidateSigners make sure the node submitting the observations is an active node account, observations from the
+// other nodes are aggregated by the handler, so the submitting node is the only signer of the message
+func (msg MsgObservedTxIn) ValidateSigners(ctx sdk.Context, keeper NodeAccountGetter) error {
+	nodeAccount, err := keeper.GetNodeAccount(ctx, msg.Signer)
+	if err != nil {
+		return fmt.Errorf("fail to get node account(%s): %w", msg.Signer, err)
+	}
+	if nodeAccount.IsEmpty() {
+		return fmt.Errorf("%s is not a node account", msg.Signer)
+	}
+	if nodeAccount.Status != Active {
+		return fmt.Errorf("node account(%s) is %s, only active node accounts can submit observations", msg.Signer, nodeAccount.Status)
+	}
+	return nil
+}
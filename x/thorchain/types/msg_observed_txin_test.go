@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 )
@@ -32,4 +34,12 @@ func (s *MsgObservedTxInSuite) TestMsgObservedTxIn(c *C) {
 	tx.Signers = append(tx.Signers, GetRandomBech32Addr())
 	m3 := NewMsgObservedTxIn(ObservedTxs{tx}, acc)
 	c.Assert(m3.ValidateBasic(), NotNil)
+
+	// coin amount doesn't fit in an int64, it is refunded by the handler, rather than failing the other txs in the msg
+	tx = NewObservedTx(GetRandomTx(), 55, pk)
+	tx.Tx.ToAddress, err = pk.GetAddress(tx.Tx.Coins[0].Asset.Chain)
+	c.Assert(err, IsNil)
+	tx.Tx.Coins[0].Amount = sdk.NewUint(math.MaxInt64).AddUint64(1)
+	m4 := NewMsgObservedTxIn(ObservedTxs{tx}, acc)
+	c.Assert(m4.ValidateBasic(), IsNil)
 }
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgPartialUnbond when an active node would like to withdraw part of its bond, without leaving
+type MsgPartialUnbond struct {
+	NodeAddress sdk.AccAddress `json:"node_address"`
+	Amount      sdk.Uint       `json:"amount"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgPartialUnbond create new MsgPartialUnbond message
+func NewMsgPartialUnbond(nodeAddr sdk.AccAddress, amount sdk.Uint, signer sdk.AccAddress) MsgPartialUnbond {
+	return MsgPartialUnbond{
+		NodeAddress: nodeAddr,
+		Amount:      amount,
+		Signer:      signer,
+	}
+}
+
+// Route should return the router key of the module
+func (msg MsgPartialUnbond) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgPartialUnbond) Type() string { return "partial_unbond" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgPartialUnbond) ValidateBasic() sdk.Error {
+	if msg.NodeAddress.Empty() {
+		return sdk.ErrUnknownRequest("node address cannot be empty")
+	}
+	if msg.Amount.IsZero() {
+		return sdk.ErrUnknownRequest("amount cannot be zero")
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("empty signer address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgPartialUnbond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPartialUnbond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
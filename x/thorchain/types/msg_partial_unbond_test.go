@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgPartialUnbondSuite struct{}
+
+var _ = Suite(&MsgPartialUnbondSuite{})
+
+func (MsgPartialUnbondSuite) TestMsgPartialUnbond(c *C) {
+	nodeAddr := GetRandomBech32Addr()
+	msg := NewMsgPartialUnbond(nodeAddr, sdk.NewUint(common.One), nodeAddr)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "partial_unbond")
+	c.Assert(msg.GetSignBytes(), NotNil)
+	c.Assert(msg.GetSigners(), HasLen, 1)
+	c.Assert(msg.GetSigners()[0].Equals(nodeAddr), Equals, true)
+	c.Assert(NewMsgPartialUnbond(sdk.AccAddress{}, sdk.NewUint(common.One), nodeAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgPartialUnbond(nodeAddr, sdk.ZeroUint(), nodeAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgPartialUnbond(nodeAddr, sdk.NewUint(common.One), sdk.AccAddress{}).ValidateBasic(), NotNil)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgSetChainContract is used by admin to update the smart contract of an EVM chain
+type MsgSetChainContract struct {
+	Contract ChainContract  `json:"contract"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSetChainContract is a constructor function for MsgSetChainContract
+func NewMsgSetChainContract(contract ChainContract, signer sdk.AccAddress) MsgSetChainContract {
+	return MsgSetChainContract{
+		Contract: contract,
+		Signer:   signer,
+	}
+}
+
+// Route should return the route key of the module
+func (msg MsgSetChainContract) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetChainContract) Type() string { return "set_chain_contract" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetChainContract) ValidateBasic() sdk.Error {
+	if err := msg.Contract.Valid(); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetChainContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetChainContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
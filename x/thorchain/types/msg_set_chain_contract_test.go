@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgSetChainContractSuite struct{}
+
+var _ = Suite(&MsgSetChainContractSuite{})
+
+func (MsgSetChainContractSuite) TestMsgSetChainContract(c *C) {
+	signer := GetRandomBech32Addr()
+	contract := NewChainContract(common.ETHChain, common.Address("0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473"), "[]")
+	msg := NewMsgSetChainContract(contract, signer)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "set_chain_contract")
+	c.Assert(msg.GetSignBytes(), NotNil)
+	c.Assert(msg.GetSigners(), HasLen, 1)
+	c.Assert(msg.GetSigners()[0].Equals(signer), Equals, true)
+	c.Assert(NewMsgSetChainContract(ChainContract{}, signer).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetChainContract(contract, sdk.AccAddress{}).ValidateBasic(), NotNil)
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgSlash defines a MsgSlash message, used by active validators to vote on
+// slashing the bond of a misbehaving node account for what it did at the given block height
+type MsgSlash struct {
+	BlockHeight int64          `json:"block_height"`
+	NodeAddress sdk.AccAddress `json:"node_address"`
+	Reason      string         `json:"reason"`
+	Amount      sdk.Uint       `json:"amount"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSlash is a constructor function for MsgSlash
+func NewMsgSlash(blockHeight int64, nodeAddress sdk.AccAddress, reason string, amount sdk.Uint, signer sdk.AccAddress) MsgSlash {
+	return MsgSlash{
+		BlockHeight: blockHeight,
+		NodeAddress: nodeAddress,
+		Reason:      reason,
+		Amount:      amount,
+		Signer:      signer,
+	}
+}
+
+// Route should return the cmname of the module
+func (msg MsgSlash) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSlash) Type() string { return "slash" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSlash) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if msg.BlockHeight <= 0 {
+		return sdk.ErrUnknownRequest("block height must be greater than zero")
+	}
+	if msg.NodeAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.NodeAddress.String())
+	}
+	if len(msg.Reason) == 0 {
+		return sdk.ErrUnknownRequest("reason cannot be empty")
+	}
+	if msg.Amount.IsZero() {
+		return sdk.ErrUnknownRequest("amount cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSlash) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSlash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgSlashSuite struct{}
+
+var _ = Suite(&MsgSlashSuite{})
+
+func (MsgSlashSuite) TestMsgSlash(c *C) {
+	nodeAddr := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	amt := sdk.NewUint(100 * common.One)
+	msg := NewMsgSlash(1024, nodeAddr, "misbehave", amt, signer)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "slash")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(len(msg.GetSignBytes()) > 0, Equals, true)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, signer.String())
+
+	c.Check(NewMsgSlash(1024, nodeAddr, "misbehave", amt, sdk.AccAddress{}).ValidateBasic(), NotNil)
+	c.Check(NewMsgSlash(1024, sdk.AccAddress{}, "misbehave", amt, signer).ValidateBasic(), NotNil)
+	c.Check(NewMsgSlash(1024, nodeAddr, "", amt, signer).ValidateBasic(), NotNil)
+	c.Check(NewMsgSlash(1024, nodeAddr, "misbehave", sdk.ZeroUint(), signer).ValidateBasic(), NotNil)
+	c.Check(NewMsgSlash(0, nodeAddr, "misbehave", amt, signer).ValidateBasic(), NotNil)
+}
@@ -1,28 +1,45 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gitlab.com/thorchain/thornode/common"
 )
 
+// MaxAffiliateFeeBasisPoints is the maximum share of the swap output an affiliate can receive
+const MaxAffiliateFeeBasisPoints = 1_000
+
 // MsgSwap defines a MsgSwap message
 type MsgSwap struct {
-	Tx          common.Tx      `json:"tx"`           // request tx
-	TargetAsset common.Asset   `json:"target_asset"` // target asset
-	Destination common.Address `json:"destination"`  // destination , used for swap and send , the destination address THORNode send it to
-	TradeTarget sdk.Uint       `json:"trade_target"`
-	Signer      sdk.AccAddress `json:"signer"`
+	Tx               common.Tx      `json:"tx"`           // request tx
+	TargetAsset      common.Asset   `json:"target_asset"` // target asset
+	Destination      common.Address `json:"destination"`  // destination , used for swap and send , the destination address THORNode send it to
+	TradeTarget      sdk.Uint       `json:"trade_target"`
+	Signer           sdk.AccAddress `json:"signer"`
+	AffiliateAddress common.Address `json:"affiliate_address"` // affiliate receive AffiliateBps of the swap output
+	AffiliateBps     sdk.Uint       `json:"affiliate_bps"`
 }
 
 // NewMsgSwap is a constructor function for MsgSwap
 func NewMsgSwap(tx common.Tx, target common.Asset, destination common.Address, tradeTarget sdk.Uint, signer sdk.AccAddress) MsgSwap {
 	return MsgSwap{
-		Tx:          tx,
-		TargetAsset: target,
-		Destination: destination,
-		TradeTarget: tradeTarget,
-		Signer:      signer,
+		Tx:           tx,
+		TargetAsset:  target,
+		Destination:  destination,
+		TradeTarget:  tradeTarget,
+		Signer:       signer,
+		AffiliateBps: sdk.ZeroUint(),
+	}
+}
+
+// GetAffiliateBps return the affiliate fee basis points, it is zero when the swap doesn't have an affiliate
+func (msg MsgSwap) GetAffiliateBps() sdk.Uint {
+	if msg.AffiliateAddress.IsEmpty() {
+		return sdk.ZeroUint()
 	}
+	return msg.AffiliateBps
 }
 
 // Route should return the pooldata of the module
@@ -53,6 +70,14 @@ func (msg MsgSwap) ValidateBasic() sdk.Error {
 	if !msg.Destination.IsChain(msg.TargetAsset.Chain) {
 		return sdk.ErrUnknownRequest("swap destination and swap target asset must be the same chain")
 	}
+	if !msg.AffiliateAddress.IsEmpty() {
+		if !msg.AffiliateAddress.IsChain(msg.TargetAsset.Chain) {
+			return sdk.ErrUnknownRequest("swap affiliate and swap target asset must be the same chain")
+		}
+		if msg.GetAffiliateBps().GT(sdk.NewUint(MaxAffiliateFeeBasisPoints)) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("affiliate fee basis points can't be more than %d", MaxAffiliateFeeBasisPoints))
+		}
+	}
 	return nil
 }
 
@@ -65,3 +90,38 @@ func (msg MsgSwap) GetSignBytes() []byte {
 func (msg MsgSwap) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// ExpectedOutput calculate the amount of target asset the swap would emit from the given pool, with the same formula
+// used by the swap handler, the pool is not modified. It only works for a swap between RUNE and the pool asset
+func (msg MsgSwap) ExpectedOutput(pool Pool) (sdk.Uint, error) {
+	if len(msg.Tx.Coins) == 0 {
+		return sdk.ZeroUint(), errors.New("no coin to swap")
+	}
+	source := msg.Tx.Coins[0]
+	var X, Y sdk.Uint
+	switch {
+	case source.Asset.IsRune() && pool.Asset.Equals(msg.TargetAsset):
+		X = pool.BalanceRune
+		Y = pool.BalanceAsset
+	case msg.TargetAsset.IsRune() && pool.Asset.Equals(source.Asset):
+		X = pool.BalanceAsset
+		Y = pool.BalanceRune
+	default:
+		return sdk.ZeroUint(), fmt.Errorf("can't swap %s to %s with %s pool", source.Asset, msg.TargetAsset, pool.Asset)
+	}
+	if source.Amount.IsZero() {
+		return sdk.ZeroUint(), errors.New("amount is invalid")
+	}
+	if X.IsZero() || Y.IsZero() {
+		return sdk.ZeroUint(), errors.New("invalid balance")
+	}
+	return CalcAssetEmission(X, source.Amount, Y), nil
+}
+
+// CalcAssetEmission calculate the number of assets sent to the address (includes liquidity fee)
+func CalcAssetEmission(X, x, Y sdk.Uint) sdk.Uint {
+	// ( x * X * Y ) / ( x + X )^2
+	numerator := x.Mul(X).Mul(Y)
+	denominator := x.Add(X).Mul(x.Add(X))
+	return numerator.Quo(denominator)
+}
@@ -139,3 +139,69 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 		c.Assert(m.ValidateBasic(), NotNil)
 	}
 }
+
+func (MsgSwapSuite) TestMsgSwapAffiliate(c *C) {
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))}
+	m := NewMsgSwap(tx, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	c.Check(m.GetAffiliateBps().IsZero(), Equals, true)
+	m.AffiliateBps = sdk.NewUint(50)
+	// without an affiliate address there is no fee
+	c.Check(m.GetAffiliateBps().IsZero(), Equals, true)
+	c.Assert(m.ValidateBasic(), IsNil)
+
+	m.AffiliateAddress = GetRandomBNBAddress()
+	c.Check(m.GetAffiliateBps().Equal(sdk.NewUint(50)), Equals, true)
+	c.Assert(m.ValidateBasic(), IsNil)
+	m.AffiliateBps = sdk.NewUint(MaxAffiliateFeeBasisPoints + 1)
+	c.Assert(m.ValidateBasic(), NotNil)
+	m.AffiliateBps = sdk.NewUint(50)
+	m.AffiliateAddress = GetRandomBTCAddress()
+	c.Assert(m.ValidateBasic(), NotNil)
+}
+
+func (MsgSwapSuite) TestExpectedOutput(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	newTx := func(coin common.Coin) common.Tx {
+		return common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(), common.Coins{coin}, BNBGasFeeSingleton, "")
+	}
+
+	// RUNE -> BNB
+	msg := NewMsgSwap(newTx(common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))), common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	expected, err := msg.ExpectedOutput(pool)
+	c.Assert(err, IsNil)
+	c.Check(expected.Uint64(), Equals, uint64(98029604))
+	// the pool is left untouched
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(pool.BalanceAsset.Equal(sdk.NewUint(100*common.One)), Equals, true)
+
+	// BNB -> RUNE
+	pool.BalanceRune = sdk.NewUint(200 * common.One)
+	msg = NewMsgSwap(newTx(common.NewCoin(common.BNBAsset, sdk.NewUint(common.One))), common.RuneAsset(), GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	expected, err = msg.ExpectedOutput(pool)
+	c.Assert(err, IsNil)
+	c.Check(expected.Equal(CalcAssetEmission(pool.BalanceAsset, sdk.NewUint(common.One), pool.BalanceRune)), Equals, true)
+	c.Check(expected.Uint64(), Equals, uint64(196059209))
+
+	// pool doesn't match
+	msg = NewMsgSwap(newTx(common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))), common.RuneAsset(), GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	_, err = msg.ExpectedOutput(pool)
+	c.Check(err, NotNil)
+	msg = NewMsgSwap(newTx(common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))), common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	_, err = msg.ExpectedOutput(pool)
+	c.Check(err, NotNil)
+
+	// zero amount
+	msg = NewMsgSwap(newTx(common.NewCoin(common.RuneAsset(), sdk.ZeroUint())), common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	_, err = msg.ExpectedOutput(pool)
+	c.Check(err, NotNil)
+
+	// empty pool
+	msg = NewMsgSwap(newTx(common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))), common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr())
+	pool.BalanceAsset = sdk.ZeroUint()
+	_, err = msg.ExpectedOutput(pool)
+	c.Check(err, NotNil)
+}
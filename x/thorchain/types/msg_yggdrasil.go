@@ -46,6 +46,14 @@ func (msg MsgYggdrasil) ValidateBasic() sdk.Error {
 	if msg.Tx.IsEmpty() {
 		return sdk.ErrUnknownRequest("request tx cannot be empty")
 	}
+	return msg.ValidateCoins()
+}
+
+// ValidateCoins make sure the message carries coins, and none of them is empty or dust (zero amount)
+func (msg MsgYggdrasil) ValidateCoins() sdk.Error {
+	if len(msg.Coins) == 0 {
+		return sdk.ErrInvalidCoins("coins cannot be empty")
+	}
 	for _, coin := range msg.Coins {
 		if err := coin.IsValid(); err != nil {
 			return sdk.ErrInvalidCoins(err.Error())
@@ -27,3 +27,26 @@ func (s *MsgYggdrasilSuite) TestMsgYggdrasil(c *C) {
 	c.Check(msg.Signer.Equals(signer), Equals, true)
 	c.Check(msg.BlockHeight, Equals, int64(12))
 }
+
+func (s *MsgYggdrasilSuite) TestValidateCoins(c *C) {
+	tx := GetRandomTx()
+	pk := GetRandomPubKey()
+	signer := GetRandomBech32Addr()
+	coins := common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(500*common.One)),
+	}
+	msg := NewMsgYggdrasil(tx, pk, 12, false, coins, signer)
+	c.Check(msg.ValidateCoins(), IsNil)
+	c.Check(msg.ValidateBasic(), IsNil)
+
+	// zero amount (dust) coin is rejected
+	coins = append(coins, common.NewCoin(common.BTCAsset, sdk.ZeroUint()))
+	msg = NewMsgYggdrasil(tx, pk, 12, false, coins, signer)
+	c.Check(msg.ValidateCoins(), NotNil)
+	c.Check(msg.ValidateBasic(), NotNil)
+
+	// empty coins is rejected
+	msg = NewMsgYggdrasil(tx, pk, 12, false, common.Coins{}, signer)
+	c.Check(msg.ValidateCoins(), NotNil)
+	c.Check(msg.ValidateBasic(), NotNil)
+}
@@ -21,6 +21,32 @@ func (n QueryResPools) String() string {
 	return strings.Join(assets, "\n")
 }
 
+// QueryResPool is the query result of a single pool, include the security status of the pool
+type QueryResPool struct {
+	BalanceRune  sdk.Uint       `json:"balance_rune"`
+	BalanceAsset sdk.Uint       `json:"balance_asset"`
+	Asset        common.Asset   `json:"asset"`
+	PoolUnits    sdk.Uint       `json:"pool_units"`
+	PoolAddress  common.Address `json:"pool_address"`
+	Status       PoolStatus     `json:"status"`
+	SecurityBond sdk.Uint       `json:"security_bond"`
+	IsSecure     bool           `json:"is_secure"`
+}
+
+// NewQueryResPool create a new instance of QueryResPool
+func NewQueryResPool(pool Pool, totalBonded sdk.Uint, activeNodeCount int) QueryResPool {
+	return QueryResPool{
+		BalanceRune:  pool.BalanceRune,
+		BalanceAsset: pool.BalanceAsset,
+		Asset:        pool.Asset,
+		PoolUnits:    pool.PoolUnits,
+		PoolAddress:  pool.PoolAddress,
+		Status:       pool.Status,
+		SecurityBond: pool.EffectiveSecurityBond(totalBonded, activeNodeCount),
+		IsSecure:     pool.IsSecure(totalBonded, activeNodeCount),
+	}
+}
+
 type QueryResHeights struct {
 	Chain            common.Chain `json:"chain"`
 	LastChainHeight  int64        `json:"lastobservedin"`
@@ -32,6 +58,11 @@ func (h QueryResHeights) String() string {
 	return fmt.Sprintf("Chain: %d, Signed: %d, Statechain: %d", h.LastChainHeight, h.LastSignedHeight, h.Statechain)
 }
 
+// QueryResReserve is the response of the reserve query
+type QueryResReserve struct {
+	Reserve sdk.Uint `json:"reserve"`
+}
+
 type ResTxOut struct {
 	Height  int64        `json:"height"`
 	Hash    common.TxID  `json:"hash"`
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// ChainContract is the smart contract THORChain use to interact with an EVM chain
+type ChainContract struct {
+	Chain           common.Chain   `json:"chain"`
+	ContractAddress common.Address `json:"contract_address"`
+	ABI             string         `json:"abi"`
+}
+
+// ChainContracts a list of ChainContract
+type ChainContracts []ChainContract
+
+// NewChainContract create a new instance of ChainContract
+func NewChainContract(chain common.Chain, contractAddress common.Address, abi string) ChainContract {
+	return ChainContract{
+		Chain:           chain,
+		ContractAddress: contractAddress,
+		ABI:             abi,
+	}
+}
+
+// IsEmpty return true when the chain is empty
+func (c ChainContract) IsEmpty() bool {
+	return c.Chain.IsEmpty()
+}
+
+// Valid check whether ChainContract has all necessary values
+func (c ChainContract) Valid() error {
+	if c.Chain.IsEmpty() {
+		return errors.New("chain cannot be empty")
+	}
+	if c.ContractAddress.IsEmpty() {
+		return errors.New("contract address cannot be empty")
+	}
+	if !c.ContractAddress.IsChain(c.Chain) {
+		return fmt.Errorf("contract address %s is not an address of chain %s", c.ContractAddress, c.Chain)
+	}
+	return nil
+}
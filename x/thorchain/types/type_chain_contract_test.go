@@ -0,0 +1,23 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type ChainContractSuite struct{}
+
+var _ = Suite(&ChainContractSuite{})
+
+func (s *ChainContractSuite) TestChainContract(c *C) {
+	addr := common.Address("0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473")
+	contract := NewChainContract(common.ETHChain, addr, "[]")
+	c.Check(contract.IsEmpty(), Equals, false)
+	c.Check(contract.Valid(), IsNil)
+
+	c.Check(ChainContract{}.IsEmpty(), Equals, true)
+	c.Check(NewChainContract(common.EmptyChain, addr, "[]").Valid(), NotNil)
+	c.Check(NewChainContract(common.ETHChain, common.NoAddress, "[]").Valid(), NotNil)
+	c.Check(NewChainContract(common.ETHChain, GetRandomBNBAddress(), "[]").Valid(), NotNil)
+}
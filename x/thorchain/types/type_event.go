@@ -2,7 +2,11 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"unicode"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -36,6 +40,7 @@ const (
 	ErrataEventType   = `errata`
 	FeeEventType      = `fee`
 	OutboundEventType = `outbound`
+	NoOpEventType     = `noop`
 )
 
 type PoolMod struct {
@@ -48,6 +53,18 @@ type PoolMod struct {
 
 type PoolMods []PoolMod
 
+// HasDuplicates return true when the same asset appears more than once in the pool mods
+func (pm PoolMods) HasDuplicates() bool {
+	for i := range pm {
+		for j := i + 1; j < len(pm); j++ {
+			if pm[i].Asset.Equals(pm[j].Asset) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func NewPoolMod(asset common.Asset, runeAmt sdk.Uint, runeAdd bool, assetAmt sdk.Uint, assetAdd bool) PoolMod {
 	return PoolMod{
 		Asset:    asset,
@@ -58,6 +75,27 @@ func NewPoolMod(asset common.Asset, runeAmt sdk.Uint, runeAdd bool, assetAmt sdk
 	}
 }
 
+// InvertDirection return a new pool mod that reverses the given one, amounts that were added get subtracted and the
+// other way around
+func (m PoolMod) InvertDirection() PoolMod {
+	return PoolMod{
+		Asset:    m.Asset,
+		RuneAmt:  m.RuneAmt,
+		RuneAdd:  !m.RuneAdd,
+		AssetAmt: m.AssetAmt,
+		AssetAdd: !m.AssetAdd,
+	}
+}
+
+// InvertAll return new pool mods reversing every one of the given pool mods
+func (pm PoolMods) InvertAll() PoolMods {
+	result := make(PoolMods, len(pm))
+	for i, mod := range pm {
+		result[i] = mod.InvertDirection()
+	}
+	return result
+}
+
 // NewEvent create a new  event
 func NewEvent(typ string, ht int64, inTx common.Tx, evt json.RawMessage, status EventStatus) Event {
 	return Event{
@@ -93,6 +131,41 @@ func (evts Events) PopByInHash(txID common.TxID) (found, events Events) {
 	return
 }
 
+// filter return the events the given func returns true for
+func (evts Events) filter(match func(evt Event) bool) Events {
+	var result Events
+	for _, evt := range evts {
+		if match(evt) {
+			result = append(result, evt)
+		}
+	}
+	return result
+}
+
+// FilterByStatus return the events in the given status
+func (evts Events) FilterByStatus(status EventStatus) Events {
+	return evts.filter(func(evt Event) bool { return evt.Status == status })
+}
+
+// FilterByType return the events of the given type
+func (evts Events) FilterByType(typ string) Events {
+	return evts.filter(func(evt Event) bool { return evt.Type == typ })
+}
+
+// FilterByHeight return the events at the given block height
+func (evts Events) FilterByHeight(height int64) Events {
+	return evts.filter(func(evt Event) bool { return evt.Height == height })
+}
+
+// GroupByType return the events grouped by their type, the order of the events is kept in each group
+func (evts Events) GroupByType() map[string]Events {
+	result := make(map[string]Events)
+	for _, evt := range evts {
+		result[evt.Type] = append(result[evt.Type], evt)
+	}
+	return result
+}
+
 // EventSwap event for swap action
 type EventSwap struct {
 	Pool               common.Asset `json:"pool"`
@@ -100,6 +173,7 @@ type EventSwap struct {
 	TradeSlip          sdk.Uint     `json:"trade_slip"`
 	LiquidityFee       sdk.Uint     `json:"liquidity_fee"`
 	LiquidityFeeInRune sdk.Uint     `json:"liquidity_fee_in_rune"`
+	DoubleSwap         bool         `json:"double_swap"` // true when the swap is one of the two hops of a double swap
 	//  the following two field is trying to make events change backward compatible
 	// very soon we don't need to save this event to key value store anymore , it will be removed then
 	InTx   common.Tx `json:"-"` // this is the Tx that cause the swap to happen, it is a double swap , then the txid will be blank
@@ -107,13 +181,14 @@ type EventSwap struct {
 }
 
 // NewEventSwap create a new swap event
-func NewEventSwap(pool common.Asset, priceTarget, fee, tradeSlip, liquidityFeeInRune sdk.Uint, inTx common.Tx) EventSwap {
+func NewEventSwap(pool common.Asset, priceTarget, fee, tradeSlip, liquidityFeeInRune sdk.Uint, doubleSwap bool, inTx common.Tx) EventSwap {
 	return EventSwap{
 		Pool:               pool,
 		PriceTarget:        priceTarget,
 		TradeSlip:          tradeSlip,
 		LiquidityFee:       fee,
 		LiquidityFeeInRune: liquidityFeeInRune,
+		DoubleSwap:         doubleSwap,
 		InTx:               inTx,
 	}
 }
@@ -130,6 +205,7 @@ func (e EventSwap) Events() (sdk.Events, error) {
 		sdk.NewAttribute("trade_slip", e.TradeSlip.String()),
 		sdk.NewAttribute("liquidity_fee", e.LiquidityFee.String()),
 		sdk.NewAttribute("liquidity_fee_in_rune", e.LiquidityFeeInRune.String()),
+		sdk.NewAttribute("double_swap", strconv.FormatBool(e.DoubleSwap)),
 	)
 	evt = evt.AppendAttributes(e.InTx.ToAttributes()...)
 	return sdk.Events{evt}, nil
@@ -137,17 +213,19 @@ func (e EventSwap) Events() (sdk.Events, error) {
 
 // EventStake stake event
 type EventStake struct {
-	Pool       common.Asset `json:"pool"`
-	StakeUnits sdk.Uint     `json:"stake_units"`
-	TxIn       common.Tx    `json:"-"`
+	Pool          common.Asset   `json:"pool"`
+	StakeUnits    sdk.Uint       `json:"stake_units"`
+	StakerAddress common.Address `json:"staker_address"`
+	TxIn          common.Tx      `json:"-"`
 }
 
 // NewEventStake create a new stake event
-func NewEventStake(pool common.Asset, su sdk.Uint, txIn common.Tx) EventStake {
+func NewEventStake(pool common.Asset, su sdk.Uint, stakerAddress common.Address, txIn common.Tx) EventStake {
 	return EventStake{
-		Pool:       pool,
-		StakeUnits: su,
-		TxIn:       txIn,
+		Pool:          pool,
+		StakeUnits:    su,
+		StakerAddress: stakerAddress,
+		TxIn:          txIn,
 	}
 }
 
@@ -159,7 +237,8 @@ func (e EventStake) Type() string {
 func (e EventStake) Events() (sdk.Events, error) {
 	evt := sdk.NewEvent(e.Type(),
 		sdk.NewAttribute("pool", e.Pool.String()),
-		sdk.NewAttribute("stake_units", e.StakeUnits.String()))
+		sdk.NewAttribute("stake_units", e.StakeUnits.String()),
+		sdk.NewAttribute("staker_address", e.StakerAddress.String()))
 	evt = evt.AppendAttributes(e.TxIn.ToAttributes()...)
 	return sdk.Events{
 		evt,
@@ -197,11 +276,38 @@ func (e EventUnstake) Events() (sdk.Events, error) {
 		sdk.NewAttribute("pool", e.Pool.String()),
 		sdk.NewAttribute("stake_units", e.StakeUnits.String()),
 		sdk.NewAttribute("basis_points", strconv.FormatInt(e.BasisPoints, 10)),
-		sdk.NewAttribute("asymmetry", e.Asymmetry.String()))
+		sdk.NewAttribute("asymmetry", e.Asymmetry.String()),
+		sdk.NewAttribute("asymmetry_description", e.AsymmetryDescription()))
 	evt = evt.AppendAttributes(e.InTx.ToAttributes()...)
 	return sdk.Events{evt}, nil
 }
 
+// AsymmetryDescription describe the asymmetry of the unstake, -1.0 means all the stake is withdrawn as RUNE, 1.0 means
+// all of it is withdrawn as asset, and 0.0 means it is withdrawn symmetrically
+func (e EventUnstake) AsymmetryDescription() string {
+	if e.Asymmetry.IsNil() || e.Asymmetry.IsZero() {
+		return "symmetric"
+	}
+	if e.Asymmetry.LTE(sdk.NewDec(-1)) {
+		return "full RUNE"
+	}
+	if e.Asymmetry.GTE(sdk.OneDec()) {
+		return "full asset"
+	}
+	percent := e.Asymmetry.Abs().MulInt64(100).RoundInt64()
+	// values that are close to the boundaries are still reported as partially asymmetric
+	if percent < 1 {
+		percent = 1
+	}
+	if percent > 99 {
+		percent = 99
+	}
+	if e.Asymmetry.IsNegative() {
+		return fmt.Sprintf("%d%% towards RUNE", percent)
+	}
+	return fmt.Sprintf("%d%% towards asset", percent)
+}
+
 // EventAdd represent add operation
 type EventAdd struct {
 	Pool common.Asset `json:"pool"`
@@ -231,15 +337,19 @@ func (e EventAdd) Events() (sdk.Events, error) {
 
 // EventPool represent pool change event
 type EventPool struct {
-	Pool   common.Asset `json:"pool"`
-	Status PoolStatus   `json:"status"`
+	Pool       common.Asset `json:"pool"`
+	Status     PoolStatus   `json:"status"`
+	RuneDepth  sdk.Uint     `json:"rune_depth"`
+	AssetDepth sdk.Uint     `json:"asset_depth"`
 }
 
-// NewEventPool create a new pool change event
-func NewEventPool(pool common.Asset, status PoolStatus) EventPool {
+// NewEventPool create a new pool change event, the depths are the balances of the pool at the time of the status change
+func NewEventPool(pool common.Asset, status PoolStatus, runeDepth, assetDepth sdk.Uint) EventPool {
 	return EventPool{
-		Pool:   pool,
-		Status: status,
+		Pool:       pool,
+		Status:     status,
+		RuneDepth:  runeDepth,
+		AssetDepth: assetDepth,
 	}
 }
 
@@ -253,7 +363,9 @@ func (e EventPool) Events() (sdk.Events, error) {
 	return sdk.Events{
 		sdk.NewEvent(e.Type(),
 			sdk.NewAttribute("pool", e.Pool.String()),
-			sdk.NewAttribute("pool_status", e.Status.String())),
+			sdk.NewAttribute("pool_status", e.Status.String()),
+			sdk.NewAttribute("rune_depth", e.RuneDepth.String()),
+			sdk.NewAttribute("asset_depth", e.AssetDepth.String())),
 	}, nil
 }
 
@@ -371,6 +483,57 @@ type GasPool struct {
 	Count    int64        `json:"transaction_count"`
 }
 
+// gasPoolAttributeKey is the key of the compact gas pool event attribute
+const gasPoolAttributeKey = "gas_pool"
+
+// ToEventAttribute return the gas pool as a single compact attribute, the value is {asset}:{assetAmt}:{runeAmt}:{count}
+func (gp GasPool) ToEventAttribute() sdk.Attribute {
+	return sdk.NewAttribute(gasPoolAttributeKey, fmt.Sprintf("%s:%s:%s:%d", gp.Asset, gp.AssetAmt, gp.RuneAmt, gp.Count))
+}
+
+// ToEventAttributes return the gas pool as one attribute per field
+func (gp GasPool) ToEventAttributes() []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute("asset", gp.Asset.String()),
+		sdk.NewAttribute("asset_amt", gp.AssetAmt.String()),
+		sdk.NewAttribute("rune_amt", gp.RuneAmt.String()),
+		sdk.NewAttribute("transaction_count", strconv.FormatInt(gp.Count, 10)),
+	}
+}
+
+// GasPoolFromEventAttribute parse the compact attribute produced by GasPool.ToEventAttribute
+func GasPoolFromEventAttribute(attr sdk.Attribute) (GasPool, error) {
+	if attr.Key != gasPoolAttributeKey {
+		return GasPool{}, fmt.Errorf("attribute key %s is not %s", attr.Key, gasPoolAttributeKey)
+	}
+	parts := strings.Split(attr.Value, ":")
+	if len(parts) != 4 {
+		return GasPool{}, fmt.Errorf("invalid gas pool attribute value: %s", attr.Value)
+	}
+	asset, err := common.NewAsset(parts[0])
+	if err != nil {
+		return GasPool{}, fmt.Errorf("fail to parse gas pool asset: %w", err)
+	}
+	assetAmt, err := sdk.ParseUint(parts[1])
+	if err != nil {
+		return GasPool{}, fmt.Errorf("fail to parse gas pool asset amount: %w", err)
+	}
+	runeAmt, err := sdk.ParseUint(parts[2])
+	if err != nil {
+		return GasPool{}, fmt.Errorf("fail to parse gas pool rune amount: %w", err)
+	}
+	count, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return GasPool{}, fmt.Errorf("fail to parse gas pool transaction count: %w", err)
+	}
+	return GasPool{
+		Asset:    asset,
+		AssetAmt: assetAmt,
+		RuneAmt:  runeAmt,
+		Count:    count,
+	}, nil
+}
+
 // EventGas represent the events happened in thorchain related to Gas
 type EventGas struct {
 	Pools []GasPool `json:"pools"`
@@ -404,12 +567,7 @@ func (e *EventGas) Type() string {
 func (e *EventGas) Events() (sdk.Events, error) {
 	events := make(sdk.Events, 0, len(e.Pools))
 	for _, item := range e.Pools {
-		evt := sdk.NewEvent(e.Type(),
-			sdk.NewAttribute("asset", item.Asset.String()),
-			sdk.NewAttribute("asset_amt", item.AssetAmt.String()),
-			sdk.NewAttribute("rune_amt", item.RuneAmt.String()),
-			sdk.NewAttribute("transaction_count", strconv.FormatInt(item.Count, 10)))
-		events = append(events, evt)
+		events = append(events, sdk.NewEvent(e.Type(), item.ToEventAttributes()...))
 	}
 	return events, nil
 }
@@ -476,11 +634,18 @@ type EventErrata struct {
 	Pools PoolMods    `json:"pools"`
 }
 
-func NewEventErrata(txID common.TxID, pools PoolMods) EventErrata {
+// NewEventErrata create a new errata event, the given pool mods must not be empty or contain the same asset more than once
+func NewEventErrata(txID common.TxID, pools PoolMods) (EventErrata, error) {
+	if len(pools) == 0 {
+		return EventErrata{}, errors.New("pool mods cannot be empty")
+	}
+	if pools.HasDuplicates() {
+		return EventErrata{}, errors.New("pool mods cannot contain duplicate assets")
+	}
 	return EventErrata{
 		TxID:  txID,
 		Pools: pools,
-	}
+	}, nil
 }
 
 // Type return slash event type
@@ -558,3 +723,35 @@ func (e EventOutbound) Events() (sdk.Events, error) {
 	evt = evt.AppendAttributes(e.Tx.ToAttributes()...)
 	return sdk.Events{evt}, nil
 }
+
+// EventNoOp represent a tx with a noop memo, which annotate the tx with a note and has no side effect
+type EventNoOp struct {
+	Note string    `json:"note"`
+	InTx common.Tx `json:"-"`
+}
+
+// NewEventNoOp create a new instance of EventNoOp, control characters are stripped from the note
+func NewEventNoOp(note string, inTx common.Tx) EventNoOp {
+	return EventNoOp{
+		Note: strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) {
+				return -1
+			}
+			return r
+		}, note),
+		InTx: inTx,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (e EventNoOp) Type() string {
+	return NoOpEventType
+}
+
+// Events return sdk events
+func (e EventNoOp) Events() (sdk.Events, error) {
+	evt := sdk.NewEvent(e.Type(),
+		sdk.NewAttribute("note", e.Note))
+	evt = evt.AppendAttributes(e.InTx.ToAttributes()...)
+	return sdk.Events{evt}, nil
+}
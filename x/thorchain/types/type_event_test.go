@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
@@ -20,18 +21,47 @@ func (s EventSuite) TestSwapEvent(c *C) {
 		sdk.NewUint(5),
 		sdk.NewUint(5),
 		sdk.ZeroUint(),
+		false,
 		GetRandomTx(),
 	)
 	c.Check(evt.Type(), Equals, "swap")
+
+	for _, doubleSwap := range []bool{false, true} {
+		evt.DoubleSwap = doubleSwap
+		events, err := evt.Events()
+		c.Assert(err, IsNil)
+		c.Assert(events, HasLen, 1)
+		found := false
+		for _, attr := range events[0].Attributes {
+			if string(attr.Key) == "double_swap" {
+				c.Check(string(attr.Value), Equals, strconv.FormatBool(doubleSwap))
+				found = true
+			}
+		}
+		c.Check(found, Equals, true)
+	}
 }
 
 func (s EventSuite) TestStakeEvent(c *C) {
+	tx := GetRandomTx()
 	evt := NewEventStake(
 		common.BNBAsset,
 		sdk.NewUint(5),
-		GetRandomTx(),
+		tx.FromAddress,
+		tx,
 	)
 	c.Check(evt.Type(), Equals, "stake")
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	found := false
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == "staker_address" {
+			c.Check(string(attr.Value), Equals, tx.FromAddress.String())
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
 }
 
 func (s EventSuite) TestUnstakeEvent(c *C) {
@@ -43,13 +73,61 @@ func (s EventSuite) TestUnstakeEvent(c *C) {
 		GetRandomTx(),
 	)
 	c.Check(evt.Type(), Equals, "unstake")
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	found := false
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == "asymmetry_description" {
+			found = true
+			c.Check(string(attr.Value), Equals, "symmetric")
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s EventSuite) TestUnstakeEventAsymmetryDescription(c *C) {
+	inputs := []struct {
+		asymmetry   sdk.Dec
+		description string
+	}{
+		{asymmetry: sdk.NewDec(-1), description: "full RUNE"},
+		{asymmetry: sdk.ZeroDec(), description: "symmetric"},
+		{asymmetry: sdk.OneDec(), description: "full asset"},
+		{asymmetry: sdk.Dec{}, description: "symmetric"},
+		{asymmetry: sdk.NewDecWithPrec(-5, 1), description: "50% towards RUNE"},
+		{asymmetry: sdk.NewDecWithPrec(25, 2), description: "25% towards asset"},
+		{asymmetry: sdk.NewDecWithPrec(333, 3), description: "33% towards asset"},
+		{asymmetry: sdk.NewDecWithPrec(-1, 3), description: "1% towards RUNE"},
+		{asymmetry: sdk.NewDecWithPrec(999, 3), description: "99% towards asset"},
+	}
+	for _, item := range inputs {
+		evt := NewEventUnstake(common.BNBAsset, sdk.NewUint(6), 5000, item.asymmetry, GetRandomTx())
+		c.Check(evt.AsymmetryDescription(), Equals, item.description, Commentf("%s", item.asymmetry))
+	}
 }
 
 func (s EventSuite) TestPool(c *C) {
-	evt := NewEventPool(common.BNBAsset, Enabled)
+	evt := NewEventPool(common.BNBAsset, Enabled, sdk.NewUint(100), sdk.NewUint(200))
 	c.Check(evt.Type(), Equals, "pool")
 	c.Check(evt.Pool.String(), Equals, common.BNBAsset.String())
 	c.Check(evt.Status.String(), Equals, Enabled.String())
+	c.Check(evt.RuneDepth.Equal(sdk.NewUint(100)), Equals, true)
+	c.Check(evt.AssetDepth.Equal(sdk.NewUint(200)), Equals, true)
+
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Type, Equals, "pool")
+	attributes := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+	c.Assert(attributes, HasLen, 4)
+	c.Check(attributes["pool"], Equals, common.BNBAsset.String())
+	c.Check(attributes["pool_status"], Equals, Enabled.String())
+	c.Check(attributes["rune_depth"], Equals, "100")
+	c.Check(attributes["asset_depth"], Equals, "200")
 }
 
 func (s EventSuite) TestReward(c *C) {
@@ -86,6 +164,7 @@ func (s EventSuite) TestEvent(c *C) {
 		sdk.NewUint(5),
 		sdk.NewUint(5),
 		sdk.ZeroUint(),
+		false,
 		txIn,
 	)
 
@@ -115,6 +194,7 @@ func (s EventSuite) TestEvent(c *C) {
 	stake := NewEventStake(
 		common.BNBAsset,
 		sdk.NewUint(5),
+		txIn.FromAddress,
 		txIn,
 	)
 	stakeBytes, _ := json.Marshal(stake)
@@ -206,6 +286,42 @@ func (s EventSuite) TestEventGas(c *C) {
 	c.Assert(eg.Pools[1].RuneAmt.Equal(sdk.NewUint(3333)), Equals, true)
 }
 
+func (s EventSuite) TestGasPoolEventAttribute(c *C) {
+	gp := GasPool{
+		Asset:    common.BNBAsset,
+		AssetAmt: sdk.NewUint(37500),
+		RuneAmt:  sdk.NewUint(1024),
+		Count:    3,
+	}
+	attr := gp.ToEventAttribute()
+	c.Check(attr.Value, Equals, "BNB.BNB:37500:1024:3")
+	parsed, err := GasPoolFromEventAttribute(attr)
+	c.Assert(err, IsNil)
+	c.Check(parsed.Asset.Equals(gp.Asset), Equals, true)
+	c.Check(parsed.AssetAmt.Equal(gp.AssetAmt), Equals, true)
+	c.Check(parsed.RuneAmt.Equal(gp.RuneAmt), Equals, true)
+	c.Check(parsed.Count, Equals, gp.Count)
+
+	attrs := gp.ToEventAttributes()
+	c.Assert(attrs, HasLen, 4)
+	c.Check(attrs[0].Value, Equals, "BNB.BNB")
+	c.Check(attrs[3].Value, Equals, "3")
+
+	for _, value := range []string{"", "BNB.BNB:37500:1024", "BNB.BNB:abc:1024:3", "BNB.BNB:37500:-1:3", "BNB.BNB:37500:1024:x"} {
+		_, err := GasPoolFromEventAttribute(sdk.NewAttribute(gasPoolAttributeKey, value))
+		c.Check(err, NotNil, Commentf("%s", value))
+	}
+	_, err = GasPoolFromEventAttribute(sdk.NewAttribute("asset", attr.Value))
+	c.Check(err, NotNil)
+
+	eg := NewEventGas()
+	eg.UpsertGasPool(gp)
+	events, err := eg.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 4)
+}
+
 func (s EventSuite) TestEventFee(c *C) {
 	event := NewEventFee(GetRandomTxHash(), common.Fee{
 		Coins: common.Coins{
@@ -218,3 +334,200 @@ func (s EventSuite) TestEventFee(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(evts, HasLen, 1)
 }
+
+func (s EventSuite) TestEventErrata(c *C) {
+	txID := GetRandomTxHash()
+	evt, err := NewEventErrata(txID, PoolMods{
+		NewPoolMod(common.BNBAsset, sdk.NewUint(100), false, sdk.ZeroUint(), false),
+		NewPoolMod(common.BTCAsset, sdk.ZeroUint(), false, sdk.NewUint(200), true),
+	})
+	c.Assert(err, IsNil)
+	c.Check(evt.Type(), Equals, ErrataEventType)
+	c.Check(evt.TxID.Equals(txID), Equals, true)
+	evts, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(evts, HasLen, 2)
+
+	// empty pool mods
+	_, err = NewEventErrata(txID, PoolMods{})
+	c.Assert(err, NotNil)
+	_, err = NewEventErrata(txID, nil)
+	c.Assert(err, NotNil)
+
+	// duplicate assets
+	mods := PoolMods{
+		NewPoolMod(common.BNBAsset, sdk.NewUint(100), false, sdk.ZeroUint(), false),
+		NewPoolMod(common.BTCAsset, sdk.ZeroUint(), false, sdk.NewUint(200), true),
+		NewPoolMod(common.BNBAsset, sdk.ZeroUint(), false, sdk.NewUint(300), true),
+	}
+	c.Check(mods.HasDuplicates(), Equals, true)
+	_, err = NewEventErrata(txID, mods)
+	c.Assert(err, NotNil)
+	c.Check(mods[:2].HasDuplicates(), Equals, false)
+	c.Check(PoolMods{}.HasDuplicates(), Equals, false)
+}
+
+// applyPoolMods adjust the balances of the given pools according to the pool mods
+func applyPoolMods(pools map[common.Asset]Pool, mods PoolMods) {
+	for _, mod := range mods {
+		pool := pools[mod.Asset]
+		if mod.RuneAdd {
+			pool.BalanceRune = pool.BalanceRune.Add(mod.RuneAmt)
+		} else {
+			pool.BalanceRune = common.SafeSub(pool.BalanceRune, mod.RuneAmt)
+		}
+		if mod.AssetAdd {
+			pool.BalanceAsset = pool.BalanceAsset.Add(mod.AssetAmt)
+		} else {
+			pool.BalanceAsset = common.SafeSub(pool.BalanceAsset, mod.AssetAmt)
+		}
+		pools[mod.Asset] = pool
+	}
+}
+
+func (s EventSuite) TestPoolModInvertDirection(c *C) {
+	mod := NewPoolMod(common.BNBAsset, sdk.NewUint(100), false, sdk.NewUint(200), true)
+	inverted := mod.InvertDirection()
+	c.Check(inverted.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(inverted.RuneAmt.Equal(sdk.NewUint(100)), Equals, true)
+	c.Check(inverted.RuneAdd, Equals, true)
+	c.Check(inverted.AssetAmt.Equal(sdk.NewUint(200)), Equals, true)
+	c.Check(inverted.AssetAdd, Equals, false)
+	// the original pool mod is left untouched
+	c.Check(mod.RuneAdd, Equals, false)
+	c.Check(mod.AssetAdd, Equals, true)
+	c.Check(PoolMods{}.InvertAll(), HasLen, 0)
+
+	newPool := func(asset common.Asset) Pool {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.BalanceRune = sdk.NewUint(1000)
+		pool.BalanceAsset = sdk.NewUint(2000)
+		return pool
+	}
+	pools := map[common.Asset]Pool{
+		common.BNBAsset: newPool(common.BNBAsset),
+		common.BTCAsset: newPool(common.BTCAsset),
+	}
+	mods := PoolMods{
+		NewPoolMod(common.BNBAsset, sdk.NewUint(100), false, sdk.NewUint(200), true),
+		NewPoolMod(common.BTCAsset, sdk.NewUint(300), true, sdk.NewUint(400), false),
+	}
+	applyPoolMods(pools, mods)
+	c.Check(pools[common.BNBAsset].BalanceRune.Equal(sdk.NewUint(900)), Equals, true)
+	c.Check(pools[common.BNBAsset].BalanceAsset.Equal(sdk.NewUint(2200)), Equals, true)
+	c.Check(pools[common.BTCAsset].BalanceRune.Equal(sdk.NewUint(1300)), Equals, true)
+	c.Check(pools[common.BTCAsset].BalanceAsset.Equal(sdk.NewUint(1600)), Equals, true)
+
+	applyPoolMods(pools, mods.InvertAll())
+	for _, asset := range []common.Asset{common.BNBAsset, common.BTCAsset} {
+		c.Check(pools[asset].BalanceRune.Equal(sdk.NewUint(1000)), Equals, true, Commentf("%s", asset))
+		c.Check(pools[asset].BalanceAsset.Equal(sdk.NewUint(2000)), Equals, true, Commentf("%s", asset))
+	}
+}
+
+func (s EventSuite) TestEventsFilter(c *C) {
+	tx := GetRandomTx()
+	events := Events{
+		NewEvent(SwapEventType, 1, tx, nil, Pending),
+		NewEvent(SwapEventType, 1, tx, nil, Success),
+		NewEvent(StakeEventType, 2, GetRandomTx(), nil, Success),
+		NewEvent(SwapEventType, 3, GetRandomTx(), nil, Pending),
+		NewEvent(RefundEventType, 3, GetRandomTx(), nil, Refund),
+	}
+
+	// by status
+	pending := events.FilterByStatus(Pending)
+	c.Assert(pending, HasLen, 2)
+	for _, evt := range pending {
+		c.Check(evt.Status, Equals, Pending)
+	}
+	c.Check(pending[0].InTx.ID.Equals(tx.ID), Equals, true)
+	c.Check(pending[1].Height, Equals, int64(3))
+	c.Check(events.FilterByStatus(Success), HasLen, 2)
+	c.Check(events.FilterByStatus(Refund), HasLen, 1)
+	c.Check(events.FilterByStatus(Failed), HasLen, 0)
+	c.Check(Events{}.FilterByStatus(Pending), HasLen, 0)
+
+	// by type
+	swaps := events.FilterByType(SwapEventType)
+	c.Assert(swaps, HasLen, 3)
+	for _, evt := range swaps {
+		c.Check(evt.Type, Equals, SwapEventType)
+	}
+	c.Check(swaps[0].Status, Equals, Pending)
+	c.Check(swaps[2].Height, Equals, int64(3))
+	stakes := events.FilterByType(StakeEventType)
+	c.Assert(stakes, HasLen, 1)
+	c.Check(stakes[0].Height, Equals, int64(2))
+	c.Check(events.FilterByType(BondEventType), HasLen, 0)
+	c.Check(Events{}.FilterByType(SwapEventType), HasLen, 0)
+
+	// by height
+	atHeight := events.FilterByHeight(3)
+	c.Assert(atHeight, HasLen, 2)
+	c.Check(atHeight[0].Type, Equals, SwapEventType)
+	c.Check(atHeight[1].Type, Equals, RefundEventType)
+	atHeight = events.FilterByHeight(2)
+	c.Assert(atHeight, HasLen, 1)
+	c.Check(atHeight[0].Type, Equals, StakeEventType)
+	c.Check(events.FilterByHeight(4), HasLen, 0)
+	c.Check(Events{}.FilterByHeight(1), HasLen, 0)
+
+	// filters can be chained
+	c.Check(events.FilterByHeight(1).FilterByType(SwapEventType).FilterByStatus(Success), HasLen, 1)
+}
+
+func (s EventSuite) TestEventsGroupByType(c *C) {
+	c.Check(Events{}.GroupByType(), HasLen, 0)
+
+	events := Events{
+		NewEvent(SwapEventType, 1, GetRandomTx(), nil, Pending),
+	}
+	groups := events.GroupByType()
+	c.Assert(groups, HasLen, 1)
+	c.Check(groups[SwapEventType], HasLen, 1)
+
+	events = append(events,
+		NewEvent(StakeEventType, 2, GetRandomTx(), nil, Success),
+		NewEvent(SwapEventType, 3, GetRandomTx(), nil, Success),
+	)
+	groups = events.GroupByType()
+	c.Assert(groups, HasLen, 2)
+	c.Assert(groups[SwapEventType], HasLen, 2)
+	c.Check(groups[SwapEventType][0].Height, Equals, int64(1))
+	c.Check(groups[SwapEventType][1].Height, Equals, int64(3))
+	c.Check(groups[StakeEventType], HasLen, 1)
+	c.Check(groups[RefundEventType], HasLen, 0)
+}
+
+func (s EventSuite) TestEventReserve(c *C) {
+	addr := GetRandomBNBAddress()
+	contributor := NewReserveContributor(addr, sdk.NewUint(100*common.One))
+	inTx := GetRandomTx()
+	evt := NewEventReserve(contributor, inTx)
+	c.Check(evt.Type(), Equals, ReserveEventType)
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Type, Equals, ReserveEventType)
+	c.Assert(events[0].Attributes, HasLen, 2+len(inTx.ToAttributes()))
+	c.Check(string(events[0].Attributes[0].Key), Equals, "contributor_address")
+	c.Check(string(events[0].Attributes[0].Value), Equals, addr.String())
+	c.Check(string(events[0].Attributes[1].Key), Equals, "amount")
+	c.Check(string(events[0].Attributes[1].Value), Equals, sdk.NewUint(100*common.One).String())
+}
+
+func (s EventSuite) TestEventNoOp(c *C) {
+	inTx := GetRandomTx()
+	evt := NewEventNoOp("hello\x00 world\n\u0085", inTx)
+	c.Check(evt.Type(), Equals, NoOpEventType)
+	c.Check(evt.Note, Equals, "hello world")
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Type, Equals, NoOpEventType)
+	c.Assert(events[0].Attributes, HasLen, 1+len(inTx.ToAttributes()))
+	c.Check(string(events[0].Attributes[0].Key), Equals, "note")
+	c.Check(string(events[0].Attributes[0].Value), Equals, "hello world")
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// NetworkFeeVoter accumulate the votes of active validators on the network fee of a chain observed at a block height
+type NetworkFeeVoter struct {
+	Chain                common.Chain     `json:"chain"`
+	BlockHeight          int64            `json:"block_height"` // block height of the chain when the fee is observed
+	Fee                  sdk.Uint         `json:"fee"`
+	ConsensusBlockHeight int64            `json:"consensus_block_height"` // thorchain block height when the fee gets applied
+	Signers              []sdk.AccAddress `json:"signers"`
+}
+
+// NewNetworkFeeVoter create a new instance of NetworkFeeVoter
+func NewNetworkFeeVoter(chain common.Chain, height int64, fee sdk.Uint) NetworkFeeVoter {
+	return NetworkFeeVoter{
+		Chain:       chain,
+		BlockHeight: height,
+		Fee:         fee,
+	}
+}
+
+// HasSigned - check if given address has signed
+func (v NetworkFeeVoter) HasSigned(signer sdk.AccAddress) bool {
+	for _, sign := range v.Signers {
+		if sign.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign this voter with given signer address
+func (v *NetworkFeeVoter) Sign(signer sdk.AccAddress) {
+	if !v.HasSigned(signer) {
+		v.Signers = append(v.Signers, signer)
+	}
+}
+
+// HasConsensus determine if this network fee has enough signers
+func (v *NetworkFeeVoter) HasConsensus(nas NodeAccounts) bool {
+	var count int
+	for _, signer := range v.Signers {
+		if nas.IsNodeKeys(signer) {
+			count += 1
+		}
+	}
+	return HasSuperMajority(count, len(nas))
+}
+
+// Empty check whether the voter is empty
+func (v *NetworkFeeVoter) Empty() bool {
+	return v.Chain.IsEmpty() || v.BlockHeight <= 0 || v.Fee.IsZero()
+}
+
+func (v *NetworkFeeVoter) String() string {
+	return fmt.Sprintf("%s-%d-%s", v.Chain.String(), v.BlockHeight, v.Fee.String())
+}
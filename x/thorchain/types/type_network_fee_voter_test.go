@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type NetworkFeeVoterSuite struct{}
+
+var _ = Suite(&NetworkFeeVoterSuite{})
+
+func (s *NetworkFeeVoterSuite) TestNetworkFeeVoter(c *C) {
+	voter := NewNetworkFeeVoter(common.BNBChain, 1024, sdk.NewUint(37500))
+	c.Check(voter.Empty(), Equals, false)
+	c.Check(voter.String(), Equals, "BNB-1024-37500")
+
+	nas := NodeAccounts{
+		GetRandomNodeAccount(Active),
+		GetRandomNodeAccount(Active),
+		GetRandomNodeAccount(Active),
+	}
+	c.Check(voter.HasSigned(nas[0].NodeAddress), Equals, false)
+	voter.Sign(nas[0].NodeAddress)
+	voter.Sign(nas[0].NodeAddress)
+	c.Check(voter.HasSigned(nas[0].NodeAddress), Equals, true)
+	c.Check(voter.Signers, HasLen, 1)
+	c.Check(voter.HasConsensus(nas), Equals, false)
+	// signer that is not an active node doesn't count
+	voter.Sign(GetRandomBech32Addr())
+	c.Check(voter.HasConsensus(nas), Equals, false)
+	voter.Sign(nas[1].NodeAddress)
+	c.Check(voter.HasConsensus(nas), Equals, true)
+
+	c.Check((&NetworkFeeVoter{}).Empty(), Equals, true)
+	empty := NewNetworkFeeVoter(common.BNBChain, 0, sdk.NewUint(37500))
+	c.Check(empty.Empty(), Equals, true)
+	empty = NewNetworkFeeVoter(common.BNBChain, 1024, sdk.ZeroUint())
+	c.Check(empty.Empty(), Equals, true)
+}
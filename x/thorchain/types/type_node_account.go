@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 // NodeStatus Represent the Node status
@@ -170,6 +171,15 @@ func (n NodeAccount) String() string {
 	return sb.String()
 }
 
+// IsReadyToChurn decide whether the node account can be churned out for age at the given block height, the node has
+// to be active and it should have been active for at least ChurnMinimumActiveBlocks
+func (n NodeAccount) IsReadyToChurn(constAccessor constants.ConstantValues, height int64) bool {
+	if n.Status != Active {
+		return false
+	}
+	return height-n.StatusSince >= constAccessor.GetInt64Value(constants.ChurnMinimumActiveBlocks)
+}
+
 // CalcBondUnits calculate bond
 func (n *NodeAccount) CalcBondUnits(height, slashpoints int64) sdk.Uint {
 	// ensure slashpoints is not negative
@@ -227,6 +237,11 @@ func (n *NodeAccount) TryRemoveSignerPubKey(key common.PubKey) {
 	}
 }
 
+// NodeAccountGetter is the subset of keeper functionality needed to look up a node account
+type NodeAccountGetter interface {
+	GetNodeAccount(ctx sdk.Context, addr sdk.AccAddress) (NodeAccount, error)
+}
+
 // NodeAccounts just a list of NodeAccount
 type NodeAccounts []NodeAccount
 
@@ -9,6 +9,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type NodeAccountSuite struct{}
@@ -83,6 +84,24 @@ func (NodeAccountSuite) TestNodeAccount(c *C) {
 	c.Assert(invalidBondAddr.IsValid(), NotNil)
 }
 
+func (NodeAccountSuite) TestIsReadyToChurn(c *C) {
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	minActiveBlocks := constAccessor.GetInt64Value(constants.ChurnMinimumActiveBlocks)
+	height := minActiveBlocks + 100
+
+	na := GetRandomNodeAccount(Active)
+	na.StatusSince = 100
+	c.Check(na.IsReadyToChurn(constAccessor, height), Equals, true)
+
+	// not active
+	standby := na
+	standby.Status = Standby
+	c.Check(standby.IsReadyToChurn(constAccessor, height), Equals, false)
+
+	// not active long enough
+	c.Check(na.IsReadyToChurn(constAccessor, height-1), Equals, false)
+}
+
 func (NodeAccountSuite) TestNodeAccountsSort(c *C) {
 	var accounts NodeAccounts
 	for {
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ObservedSlashVoter accumulate the votes of active validators on slashing a node account for what it did at a block height
+type ObservedSlashVoter struct {
+	NodeAddress          sdk.AccAddress   `json:"node_address"`
+	BlockHeight          int64            `json:"block_height"` // block height the node account misbehaved at
+	Reason               string           `json:"reason"`
+	Amount               sdk.Uint         `json:"amount"`
+	ConsensusBlockHeight int64            `json:"consensus_block_height"` // thorchain block height when the slash gets applied
+	Signers              []sdk.AccAddress `json:"signers"`
+}
+
+// NewObservedSlashVoter create a new instance of ObservedSlashVoter
+func NewObservedSlashVoter(nodeAddress sdk.AccAddress, height int64, reason string, amount sdk.Uint) ObservedSlashVoter {
+	return ObservedSlashVoter{
+		NodeAddress: nodeAddress,
+		BlockHeight: height,
+		Reason:      reason,
+		Amount:      amount,
+	}
+}
+
+// HasSigned - check if given address has signed
+func (slash ObservedSlashVoter) HasSigned(signer sdk.AccAddress) bool {
+	for _, sign := range slash.Signers {
+		if sign.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign this voter with given signer address
+func (slash *ObservedSlashVoter) Sign(signer sdk.AccAddress) {
+	if !slash.HasSigned(signer) {
+		slash.Signers = append(slash.Signers, signer)
+	}
+}
+
+// HasConsensus determine if this slash has enough signers
+func (slash *ObservedSlashVoter) HasConsensus(nas NodeAccounts) bool {
+	var count int
+	for _, signer := range slash.Signers {
+		if nas.IsNodeKeys(signer) {
+			count += 1
+		}
+	}
+	return HasSuperMajority(count, len(nas))
+}
+
+// Empty check whether the voter is empty
+func (slash *ObservedSlashVoter) Empty() bool {
+	return slash.NodeAddress.Empty() || len(slash.Reason) == 0 || slash.Amount.IsZero()
+}
+
+func (slash *ObservedSlashVoter) String() string {
+	return fmt.Sprintf("%s-%d-%s-%s", slash.NodeAddress.String(), slash.BlockHeight, slash.Amount.String(), slash.Reason)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type ObservedSlashVoterSuite struct{}
+
+var _ = Suite(&ObservedSlashVoterSuite{})
+
+func (ObservedSlashVoterSuite) TestVoter(c *C) {
+	nodeAddr := GetRandomBech32Addr()
+	voter := NewObservedSlashVoter(nodeAddr, 1024, "misbehave", sdk.NewUint(common.One))
+	c.Check(voter.Empty(), Equals, false)
+	c.Check(voter.String(), Equals, nodeAddr.String()+"-1024-100000000-misbehave")
+	c.Check(NewObservedSlashVoter(nodeAddr, 1024, "", sdk.NewUint(common.One)).Empty(), Equals, true)
+
+	nas := NodeAccounts{
+		GetRandomNodeAccount(Active),
+		GetRandomNodeAccount(Active),
+		GetRandomNodeAccount(Active),
+	}
+	voter.Sign(nas[0].NodeAddress)
+	voter.Sign(nas[0].NodeAddress)
+	c.Check(voter.Signers, HasLen, 1)
+	c.Check(voter.HasSigned(nas[0].NodeAddress), Equals, true)
+	c.Check(voter.HasSigned(nas[1].NodeAddress), Equals, false)
+	c.Check(voter.HasConsensus(nas), Equals, false)
+
+	// signers that are not active node accounts don't count
+	voter.Sign(GetRandomBech32Addr())
+	c.Check(voter.HasConsensus(nas), Equals, false)
+
+	voter.Sign(nas[1].NodeAddress)
+	c.Check(voter.HasConsensus(nas), Equals, true)
+}
@@ -214,6 +214,21 @@ func (tx *ObservedTxVoter) Add(observedTx ObservedTx, signer sdk.AccAddress) {
 	tx.Txs = append(tx.Txs, observedTx)
 }
 
+// GetConsensusThreshold return the minimum number of signers required to reach consensus, which is 2/3 of the total
+// active nodes rounded up
+func (tx ObservedTxVoter) GetConsensusThreshold(totalActiveNodes int) int {
+	threshold, err := GetThreshold(totalActiveNodes)
+	if err != nil {
+		return 0
+	}
+	return threshold
+}
+
+// hasConsensus return true when the number of signers reach the consensus threshold
+func (tx ObservedTxVoter) hasConsensus(signers, totalActiveNodes int) bool {
+	return HasSuperMajority(signers, totalActiveNodes)
+}
+
 func (tx ObservedTxVoter) HasConsensus(nodeAccounts NodeAccounts) bool {
 	for _, txIn := range tx.Txs {
 		var count int
@@ -222,7 +237,7 @@ func (tx ObservedTxVoter) HasConsensus(nodeAccounts NodeAccounts) bool {
 				count += 1
 			}
 		}
-		if HasSuperMajority(count, len(nodeAccounts)) {
+		if tx.hasConsensus(count, len(nodeAccounts)) {
 			return true
 		}
 	}
@@ -230,6 +245,50 @@ func (tx ObservedTxVoter) HasConsensus(nodeAccounts NodeAccounts) bool {
 	return false
 }
 
+// signersBond return the total bond of the signers of the given observation, signers not in bondByAddress have no bond
+func (tx ObservedTxVoter) signersBond(txIn ObservedTx, bondByAddress map[string]sdk.Uint) sdk.Uint {
+	total := sdk.ZeroUint()
+	for _, signer := range txIn.Signers {
+		if bond, ok := bondByAddress[signer.String()]; ok {
+			total = total.Add(bond)
+		}
+	}
+	return total
+}
+
+// hasWeightedConsensus return true when the bond of the signers is more than 2/3 of the total bond
+func (tx ObservedTxVoter) hasWeightedConsensus(signersBond, totalBond sdk.Uint) bool {
+	if totalBond.IsZero() {
+		return false
+	}
+	return signersBond.MulUint64(3).GT(totalBond.MulUint64(2))
+}
+
+// GetWeightedConsensus is the bond weighted version of HasConsensus, an observation reach consensus when its signers
+// hold more than 2/3 of the total bond. bondByAddress is the bond of each node account by node address
+func (tx ObservedTxVoter) GetWeightedConsensus(bondByAddress map[string]sdk.Uint, totalBond sdk.Uint) bool {
+	for _, txIn := range tx.Txs {
+		if tx.hasWeightedConsensus(tx.signersBond(txIn, bondByAddress), totalBond) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWeightedTx is the bond weighted version of GetTx
+func (tx *ObservedTxVoter) GetWeightedTx(bondByAddress map[string]sdk.Uint, totalBond sdk.Uint) ObservedTx {
+	if !tx.Tx.IsEmpty() {
+		return tx.Tx
+	}
+	for _, txIn := range tx.Txs {
+		if tx.hasWeightedConsensus(tx.signersBond(txIn, bondByAddress), totalBond) {
+			tx.Tx = txIn
+			return txIn
+		}
+	}
+	return ObservedTx{}
+}
+
 func (tx *ObservedTxVoter) GetTx(nodeAccounts NodeAccounts) ObservedTx {
 	if !tx.Tx.IsEmpty() {
 		return tx.Tx
@@ -241,7 +300,7 @@ func (tx *ObservedTxVoter) GetTx(nodeAccounts NodeAccounts) ObservedTx {
 				count += 1
 			}
 		}
-		if HasSuperMajority(count, len(nodeAccounts)) {
+		if tx.hasConsensus(count, len(nodeAccounts)) {
 			tx.Tx = txIn
 			return txIn
 		}
@@ -233,3 +233,53 @@ func (TypeObservedTxSuite) TestObservedTxEquals(c *C) {
 		c.Assert(item.tx.Equals(item.tx1), Equals, item.equal)
 	}
 }
+
+func (s TypeObservedTxSuite) TestGetConsensusThreshold(c *C) {
+	voter := NewObservedTxVoter(GetRandomTxHash(), nil)
+	expected := []int{1, 2, 2, 3, 4, 4, 5, 6, 6, 7, 8, 8, 9, 10, 10, 11, 12, 12, 13, 14}
+	for i, threshold := range expected {
+		total := i + 1
+		c.Check(voter.GetConsensusThreshold(total), Equals, threshold, Commentf("total: %d", total))
+		// threshold is the ceiling of 2/3 of total
+		c.Check(threshold*3 >= total*2, Equals, true)
+		c.Check((threshold-1)*3 < total*2, Equals, true)
+		c.Check(voter.hasConsensus(threshold, total), Equals, true)
+		c.Check(voter.hasConsensus(threshold-1, total), Equals, false)
+	}
+	c.Check(voter.hasConsensus(0, 0), Equals, false)
+	c.Check(voter.hasConsensus(5, 4), Equals, false)
+}
+
+func (s TypeObservedTxSuite) TestGetWeightedConsensus(c *C) {
+	tx := NewObservedTx(GetRandomTx(), 1, GetRandomPubKey())
+	voter := NewObservedTxVoter(tx.Tx.ID, nil)
+	whale := GetRandomBech32Addr()
+	node1 := GetRandomBech32Addr()
+	node2 := GetRandomBech32Addr()
+	bondByAddress := map[string]sdk.Uint{
+		whale.String(): sdk.NewUint(700),
+		node1.String(): sdk.NewUint(150),
+		node2.String(): sdk.NewUint(150),
+	}
+	totalBond := sdk.NewUint(1000)
+
+	c.Check(voter.GetWeightedConsensus(bondByAddress, totalBond), Equals, false)
+	voter.Add(tx, node1)
+	voter.Add(tx, node2)
+	// two out of three nodes, but only 30% of the bond
+	c.Check(voter.GetWeightedConsensus(bondByAddress, totalBond), Equals, false)
+	c.Check(voter.GetWeightedTx(bondByAddress, totalBond).IsEmpty(), Equals, true)
+
+	// an unknown signer doesn't add any bond
+	voter.Add(tx, GetRandomBech32Addr())
+	c.Check(voter.GetWeightedConsensus(bondByAddress, totalBond), Equals, false)
+
+	voter.Add(tx, whale)
+	c.Check(voter.GetWeightedConsensus(bondByAddress, totalBond), Equals, true)
+	c.Check(voter.GetWeightedTx(bondByAddress, totalBond).Equals(tx), Equals, true)
+	c.Check(voter.GetWeightedConsensus(bondByAddress, sdk.ZeroUint()), Equals, false)
+
+	// exactly 2/3 of the bond is not enough
+	c.Check(voter.hasWeightedConsensus(sdk.NewUint(200), sdk.NewUint(300)), Equals, false)
+	c.Check(voter.hasWeightedConsensus(sdk.NewUint(201), sdk.NewUint(300)), Equals, true)
+}
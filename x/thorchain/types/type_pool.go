@@ -158,3 +158,33 @@ func (ps Pool) RuneValueInAsset(amt sdk.Uint) sdk.Uint {
 	}
 	return common.GetShare(ps.BalanceAsset, ps.BalanceRune, amt)
 }
+
+// PriceInRune return the price of one unit of asset in RUNE, zero if the pool has no asset
+func (ps Pool) PriceInRune() sdk.Dec {
+	if ps.BalanceAsset.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromBigInt(ps.BalanceRune.BigInt()).Quo(sdk.NewDecFromBigInt(ps.BalanceAsset.BigInt()))
+}
+
+// PriceOfRune return the price of one unit of RUNE in asset, zero if the pool has no RUNE
+func (ps Pool) PriceOfRune() sdk.Dec {
+	if ps.BalanceRune.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromBigInt(ps.BalanceAsset.BigInt()).Quo(sdk.NewDecFromBigInt(ps.BalanceRune.BigInt()))
+}
+
+// EffectiveSecurityBond calculate the bond requirement of the pool based on the two-thirds rule,
+// only two-thirds of the total bonded rune need to collude to steal the fund, so the bond is shared by the active nodes
+func (ps Pool) EffectiveSecurityBond(totalBondedRune sdk.Uint, activeNodeCount int) sdk.Uint {
+	if activeNodeCount <= 0 {
+		return sdk.ZeroUint()
+	}
+	return totalBondedRune.QuoUint64(3).MulUint64(2).QuoUint64(uint64(activeNodeCount))
+}
+
+// IsSecure check whether the effective security bond is at least two times of the pool depth
+func (ps Pool) IsSecure(totalBonded sdk.Uint, activeNodeCount int) bool {
+	return ps.EffectiveSecurityBond(totalBonded, activeNodeCount).GTE(ps.BalanceRune.MulUint64(2))
+}
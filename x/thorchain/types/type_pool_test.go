@@ -66,3 +66,38 @@ func (PoolTestSuite) TestPoolStatus(c *C) {
 	err = json.Unmarshal([]byte(`{asdf}`), &ps)
 	c.Assert(err, NotNil)
 }
+
+func (PoolTestSuite) TestPoolSecurity(c *C) {
+	p := NewPool()
+	p.Asset = common.BNBAsset
+	p.BalanceRune = sdk.NewUint(100 * common.One)
+	p.BalanceAsset = sdk.NewUint(50 * common.One)
+
+	c.Check(p.EffectiveSecurityBond(sdk.NewUint(3000*common.One), 0).IsZero(), Equals, true)
+	c.Check(p.IsSecure(sdk.NewUint(3000*common.One), 0), Equals, false)
+
+	// 3000 / 3 * 2 / 4 = 500
+	bond := p.EffectiveSecurityBond(sdk.NewUint(3000*common.One), 4)
+	c.Check(bond.Equal(sdk.NewUint(500*common.One)), Equals, true, Commentf("%s", bond))
+	c.Check(p.IsSecure(sdk.NewUint(3000*common.One), 4), Equals, true)
+
+	// 900 / 3 * 2 / 4 = 150, less than 2 * 100
+	bond = p.EffectiveSecurityBond(sdk.NewUint(900*common.One), 4)
+	c.Check(bond.Equal(sdk.NewUint(150*common.One)), Equals, true, Commentf("%s", bond))
+	c.Check(p.IsSecure(sdk.NewUint(900*common.One), 4), Equals, false)
+}
+
+func (PoolTestSuite) TestPoolPrice(c *C) {
+	p := NewPool()
+	p.Asset = common.BNBAsset
+	c.Check(p.PriceInRune().Equal(sdk.ZeroDec()), Equals, true)
+	c.Check(p.PriceOfRune().Equal(sdk.ZeroDec()), Equals, true)
+
+	p.BalanceRune = sdk.NewUint(100 * common.One)
+	c.Check(p.PriceInRune().Equal(sdk.ZeroDec()), Equals, true)
+	c.Check(p.PriceOfRune().Equal(sdk.ZeroDec()), Equals, true)
+
+	p.BalanceAsset = sdk.NewUint(50 * common.One)
+	c.Check(p.PriceInRune().Equal(sdk.NewDec(2)), Equals, true)
+	c.Check(p.PriceOfRune().Equal(sdk.NewDecWithPrec(5, 1)), Equals, true)
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SlashRecord represent the slashes a node account received for the same reason
+type SlashRecord struct {
+	Height int64  `json:"height"` // block height of the latest slash
+	Reason string `json:"reason"`
+	Amount int64  `json:"amount"` // slash points , or the RUNE taken from the bond for a fund loss
+	Count  int64  `json:"count"`  // number of slashes
+}
+
+// SlashRecords a list of slash record
+type SlashRecords []SlashRecord
+
+// NewSlashRecord create a new instance of SlashRecord
+func NewSlashRecord(height int64, reason string, amount int64) SlashRecord {
+	return SlashRecord{
+		Height: height,
+		Reason: reason,
+		Amount: amount,
+		Count:  1,
+	}
+}
+
+// Add merge the given slash record into the records of the same reason, or append it when there is none
+func (rs SlashRecords) Add(record SlashRecord) SlashRecords {
+	for i, item := range rs {
+		if item.Reason == record.Reason {
+			rs[i].Height = record.Height
+			rs[i].Amount += record.Amount
+			rs[i].Count += record.Count
+			return rs
+		}
+	}
+	return append(rs, record)
+}
+
+// Valid check whether the slash record has all the necessary fields
+func (r SlashRecord) Valid() error {
+	if r.Height <= 0 {
+		return errors.New("height cannot be less than or equal to zero")
+	}
+	if len(r.Reason) == 0 {
+		return errors.New("reason cannot be empty")
+	}
+	if r.Amount <= 0 {
+		return errors.New("amount cannot be less than or equal to zero")
+	}
+	if r.Count <= 0 {
+		return errors.New("count cannot be less than or equal to zero")
+	}
+	return nil
+}
+
+// String implement fmt.Stringer
+func (r SlashRecord) String() string {
+	return fmt.Sprintf("height:%d,reason:%s,amount:%d,count:%d", r.Height, r.Reason, r.Amount, r.Count)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type SlashRecordSuite struct{}
+
+var _ = Suite(&SlashRecordSuite{})
+
+func (s *SlashRecordSuite) TestSlashRecord(c *C) {
+	record := NewSlashRecord(1024, "fail to observe", 2)
+	c.Assert(record.Valid(), IsNil)
+	c.Check(record.String(), Equals, "height:1024,reason:fail to observe,amount:2,count:1")
+
+	c.Check(NewSlashRecord(0, "fail to observe", 2).Valid(), NotNil)
+	c.Check(NewSlashRecord(1024, "", 2).Valid(), NotNil)
+	c.Check(NewSlashRecord(1024, "fail to observe", 0).Valid(), NotNil)
+	record.Count = 0
+	c.Check(record.Valid(), NotNil)
+}
+
+func (s *SlashRecordSuite) TestSlashRecordsAdd(c *C) {
+	var records SlashRecords
+	records = records.Add(NewSlashRecord(1024, "fail to observe", 2))
+	records = records.Add(NewSlashRecord(1025, "fail to keysign", 3))
+	records = records.Add(NewSlashRecord(1026, "fail to observe", 2))
+	c.Assert(records, HasLen, 2)
+	c.Check(records[0], Equals, SlashRecord{Height: 1026, Reason: "fail to observe", Amount: 4, Count: 2})
+	c.Check(records[1], Equals, NewSlashRecord(1025, "fail to keysign", 3))
+}
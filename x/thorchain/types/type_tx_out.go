@@ -49,11 +49,7 @@ func (toi TxOutItem) TxHash() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	tx := common.Tx{
-		FromAddress: fromAddr,
-		ToAddress:   toi.ToAddress,
-		Coins:       common.Coins{toi.Coin},
-	}
+	tx := common.Tx{}.WithFromAddress(fromAddr).WithToAddress(toi.ToAddress).WithCoin(toi.Coin)
 	return tx.Hash(), nil
 }
 
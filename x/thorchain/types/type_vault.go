@@ -31,6 +31,16 @@ const (
 	InactiveVault VaultStatus = "inactive"
 )
 
+// Valid check whether the vault status is one of the known status
+func (s VaultStatus) Valid() error {
+	switch s {
+	case ActiveVault, RetiringVault, InactiveVault:
+		return nil
+	default:
+		return fmt.Errorf("invalid vault status: %s", string(s))
+	}
+}
+
 // Vault usually represent the pool we are using
 type Vault struct {
 	BlockHeight           int64          `json:"block_height"`
@@ -44,6 +54,7 @@ type Vault struct {
 	InboundTxCount        int64          `json:"inbound_tx_count"`
 	OutboundTxCount       int64          `json:"outbound_tx_count"`
 	PendingTxBlockHeights []int64        `json:"pending_tx_heights"`
+	RetirementCompletedAt int64          `json:"retirement_completed_at"` // block height the retiring vault became empty
 }
 
 type Vaults []Vault
@@ -13,9 +13,8 @@ import (
 )
 
 const (
-	DefaultCodespace    sdk.CodespaceType = ModuleName
-	SuperMajorityFactor uint64            = 3
-	MajorityFactor      uint64            = 2
+	DefaultCodespace sdk.CodespaceType = ModuleName
+	MajorityFactor   uint64            = 2
 )
 
 // HasSuperMajority return true when it has 2/3 majority
@@ -26,16 +25,11 @@ func HasSuperMajority(signers, total int) bool {
 	if signers <= 0 {
 		return false // edge case
 	}
-	mU := sdk.NewUint(SuperMajorityFactor)
-
-	// 10*4 / (6.67*2) <= 3
-	// 4*4 / (3*2) <= 3
-	// 3*4 / (2*2) <= 3
-	// Is able to determine "majority" without needing floats or DECs
-	tU := sdk.NewUint(uint64(total))
-	sU := sdk.NewUint(uint64(signers))
-	factor := tU.MulUint64(2).Quo(sU)
-	return mU.GTE(factor)
+	threshold, err := GetThreshold(total)
+	if err != nil {
+		return false
+	}
+	return signers >= threshold
 }
 
 // HasMajority return true when it has more than 1/2
@@ -55,7 +49,8 @@ func HasSimpleMajority(signers, total int) bool {
 	return mU.GTE(factor)
 }
 
-// GetThreshold calculate threshold
+// GetThreshold calculate the 2/3 super majority threshold of the given total rounded up, every super majority check
+// goes through it
 func GetThreshold(value int) (int, error) {
 	if value < 0 {
 		return 0, errors.New("negative input")
@@ -40,6 +40,8 @@ func (s TypesSuite) TestHasSuperMajority(c *C) {
 
 	// unhappy path
 	c.Check(HasSuperMajority(2, 4), Equals, false)
+	c.Check(HasSuperMajority(6, 10), Equals, false)
+	c.Check(HasSuperMajority(51, 100), Equals, false)
 	c.Check(HasSuperMajority(9, 4), Equals, false)
 	c.Check(HasSuperMajority(-9, 4), Equals, false)
 	c.Check(HasSuperMajority(9, -4), Equals, false)
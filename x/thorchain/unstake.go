@@ -62,9 +62,14 @@ func unstake(ctx sdk.Context, version semver.Version, keeper Keeper, msg MsgSetU
 	}
 
 	cv := constants.GetConstantValues(version)
-	// check if thorchain need to rate limit unstaking
+	ragnarok, err := keeper.IsPoolRagnarokInProgress(ctx, msg.Asset)
+	if err != nil {
+		ctx.Logger().Error("fail to check pool ragnarok", "error", err)
+		return sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ErrInternal("fail to check pool ragnarok")
+	}
+	// check if thorchain need to rate limit unstaking, positions of a pool being ragnarok'd are unwound regardless
 	// https://gitlab.com/thorchain/thornode/issues/166
-	if !msg.Asset.Chain.Equals(common.BNBChain) {
+	if !ragnarok && !msg.Asset.Chain.Equals(common.BNBChain) {
 		height := ctx.BlockHeight()
 		if height < (stakerUnit.LastStakeHeight + cv.GetInt64Value(constants.StakeLockUpBlocks)) {
 			return sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.NewError(DefaultCodespace, CodeUnstakeWithin24Hours, "you cannot unstake for 24 hours after staking for this blockchain")
@@ -118,7 +123,7 @@ func unstake(ctx sdk.Context, version semver.Version, keeper Keeper, msg MsgSetU
 
 	// Create a pool event if THORNode have no rune or assets
 	if pool.BalanceAsset.IsZero() || pool.BalanceRune.IsZero() {
-		poolEvt := NewEventPool(pool.Asset, PoolBootstrap)
+		poolEvt := NewEventPool(pool.Asset, PoolBootstrap, pool.BalanceRune, pool.BalanceAsset)
 		if err := eventManager.EmitPoolEvent(ctx, keeper, common.BlankTxID, EventSuccess, poolEvt); nil != err {
 			ctx.Logger().Error("fail to emit pool event", "error", err)
 		}
@@ -129,6 +134,10 @@ func unstake(ctx sdk.Context, version semver.Version, keeper Keeper, msg MsgSetU
 		ctx.Logger().Error("fail to save pool", "error", err)
 		return sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ErrInternal("fail to save pool")
 	}
+	// all the positions of the pool are unwound, ragnarok of the pool is done
+	if ragnarok && pool.PoolUnits.IsZero() {
+		keeper.ClearPoolRagnarokInProgress(ctx, pool.Asset)
+	}
 	if !stakerUnit.Units.IsZero() {
 		keeper.SetStaker(ctx, stakerUnit)
 	} else {
@@ -514,3 +514,53 @@ func getUnstakeTestKeeper(c *C) Keeper {
 	store.SetStaker(ctx, staker)
 	return store
 }
+
+func (s *UnstakeSuite) TestUnstakePoolRagnarok(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	version := constants.SWVersion
+	eventManager, err := NewDummyVersionedEventMgr().GetEventManager(ctx, version)
+	c.Assert(err, IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.PoolUnits = sdk.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	runeAddress := GetRandomBNBAddress()
+	k.SetStaker(ctx, Staker{
+		Asset:           common.BTCAsset,
+		RuneAddress:     runeAddress,
+		LastStakeHeight: ctx.BlockHeight(),
+		Units:           sdk.NewUint(100 * common.One),
+		PendingRune:     sdk.ZeroUint(),
+	})
+	msg := NewMsgSetUnStake(GetRandomTx(), runeAddress, sdk.NewUint(5000), common.BTCAsset, GetRandomBech32Addr())
+
+	// staked within the lock up period
+	if constants.GetConstantValues(version).GetInt64Value(constants.StakeLockUpBlocks) > 0 {
+		_, _, _, _, unstakeErr := unstake(ctx, version, k, msg, eventManager)
+		c.Assert(unstakeErr, NotNil)
+		c.Check(unstakeErr.Code(), Equals, CodeUnstakeWithin24Hours)
+	}
+
+	// positions of a pool being ragnarok'd are unwound regardless of the lock up
+	c.Assert(k.SetPoolRagnarokInProgress(ctx, common.BTCAsset), IsNil)
+	r, asset, _, _, err := unstake(ctx, version, k, msg, eventManager)
+	c.Assert(err, IsNil)
+	c.Check(r.Uint64(), Equals, uint64(50*common.One))
+	c.Check(asset.Uint64(), Equals, uint64(50*common.One))
+	ragnarok, err := k.IsPoolRagnarokInProgress(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, true)
+
+	// ragnarok of the pool is done once the last position is unwound
+	msg.UnstakeBasisPoints = sdk.NewUint(MaxUnstakeBasisPoints)
+	_, _, _, _, err = unstake(ctx, version, k, msg, eventManager)
+	c.Assert(err, IsNil)
+	ragnarok, err = k.IsPoolRagnarokInProgress(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(ragnarok, Equals, false)
+}
@@ -19,6 +19,7 @@ type VersionedValidatorManager interface {
 	BeginBlock(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) error
 	EndBlock(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) []abci.ValidatorUpdate
 	RequestYggReturn(ctx sdk.Context, version semver.Version, node NodeAccount) error
+	MaximumStandbyNodes(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) int
 }
 
 // VersionedValidatorMgr
@@ -73,3 +74,15 @@ func (vm *VersionedValidatorMgr) RequestYggReturn(ctx sdk.Context, version semve
 	}
 	return errBadVersion
 }
+
+// MaximumStandbyNodes return the maximum number of nodes allowed in the standby pool
+func (vm *VersionedValidatorMgr) MaximumStandbyNodes(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) int {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		if vm.v1ValidatorMgr == nil {
+			vm.v1ValidatorMgr = newValidatorMgrV1(vm.keeper, vm.versionedTxOutStore, vm.versionedVaultManager, vm.versionedEventManager)
+		}
+		return vm.v1ValidatorMgr.MaximumStandbyNodes(ctx, constAccessor)
+	}
+	ctx.Logger().Error(fmt.Sprintf("unsupported version (%s) in validator manager", version))
+	return 0
+}
@@ -27,6 +27,10 @@ func (VersionedValidatorDummyMgr) RequestYggReturn(ctx sdk.Context, version semv
 	return nil
 }
 
+func (VersionedValidatorDummyMgr) MaximumStandbyNodes(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) int {
+	return 0
+}
+
 // ValidatorDummyMgr is to manage a list of validators , and rotate them
 type ValidatorDummyMgr struct {
 }
@@ -69,7 +69,7 @@ func (vm *validatorMgrV1) BeginBlock(ctx sdk.Context, constAccessor constants.Co
 		if oldValidatorRate < 0 || err != nil {
 			oldValidatorRate = constAccessor.GetInt64Value(constants.OldValidatorRate)
 		}
-		if err := vm.markOldActor(ctx, oldValidatorRate); err != nil {
+		if err := vm.markOldActor(ctx, oldValidatorRate, constAccessor); err != nil {
 			return err
 		}
 	}
@@ -331,8 +331,14 @@ func (vm *validatorMgrV1) payNodeAccountBondAward(ctx sdk.Context, na NodeAccoun
 	// calc number of rune they are awarded
 	reward := vault.CalcNodeRewards(earnedBlocks)
 
-	// Add to their bond the amount rewarded
-	na.Bond = na.Bond.Add(reward)
+	// Add the amount rewarded to their unclaimed rewards, it will be paid out when they leave
+	rewards, err := vm.k.GetNodeBondRewards(ctx, na.PubKeySet.Secp256k1)
+	if err != nil {
+		return fmt.Errorf("fail to get node bond rewards: %w", err)
+	}
+	if err := vm.k.SetNodeBondRewards(ctx, na.PubKeySet.Secp256k1, rewards.Add(reward)); err != nil {
+		return fmt.Errorf("fail to save node bond rewards: %w", err)
+	}
 
 	// Minus the number of rune THORNode have awarded them
 	vault.BondRewardRune = common.SafeSub(vault.BondRewardRune, reward)
@@ -429,6 +435,17 @@ func (vm *validatorMgrV1) ragnarokBondReward(ctx sdk.Context) error {
 		if err := vm.payNodeAccountBondAward(ctx, item); err != nil {
 			return fmt.Errorf("fail to pay node account(%s) bond award: %w", item.NodeAddress.String(), err)
 		}
+		// bond is refunded in portions during ragnarok, so the rewards have to be part of the bond
+		na, err := vm.k.GetNodeAccount(ctx, item.NodeAddress)
+		if err != nil {
+			return fmt.Errorf("fail to get node account(%s): %w", item.NodeAddress.String(), err)
+		}
+		if err := claimNodeBondRewards(ctx, vm.k, &na); err != nil {
+			return err
+		}
+		if err := vm.k.SetNodeAccount(ctx, na); err != nil {
+			return fmt.Errorf("fail to save node account(%s): %w", item.NodeAddress.String(), err)
+		}
 	}
 	return nil
 }
@@ -447,13 +464,8 @@ func (vm *validatorMgrV1) ragnarokReserve(ctx sdk.Context, nth int64) error {
 	if len(contribs) == 0 {
 		return nil
 	}
-	vaultData, err := vm.k.GetVaultData(ctx)
-	if err != nil {
-		ctx.Logger().Error("can't get vault data", "error", err)
-		return err
-	}
-
-	if vaultData.TotalReserve.IsZero() {
+	totalReserve := vm.k.GetReserveBalance(ctx)
+	if totalReserve.IsZero() {
 		return nil
 	}
 
@@ -462,7 +474,6 @@ func (vm *validatorMgrV1) ragnarokReserve(ctx sdk.Context, nth int64) error {
 		ctx.Logger().Error("can't get tx out store", "error", err)
 		return err
 	}
-	totalReserve := vaultData.TotalReserve
 	totalContributions := sdk.ZeroUint()
 	for _, contrib := range contribs {
 		totalContributions = totalContributions.Add(contrib.Amount)
@@ -485,7 +496,9 @@ func (vm *validatorMgrV1) ragnarokReserve(ctx sdk.Context, nth int64) error {
 			nth = 10
 		}
 		amt := share.MulUint64(uint64(nth)).QuoUint64(10)
-		vaultData.TotalReserve = common.SafeSub(vaultData.TotalReserve, amt)
+		if err := vm.k.DeductFromReserve(ctx, amt); err != nil {
+			return fmt.Errorf("fail to deduct reserve contribution refund from reserve: %w", err)
+		}
 		contribs[i].Amount = common.SafeSub(contrib.Amount, amt)
 
 		// refund contribution
@@ -502,10 +515,6 @@ func (vm *validatorMgrV1) ragnarokReserve(ctx sdk.Context, nth int64) error {
 		}
 	}
 
-	if err := vm.k.SetVaultData(ctx, vaultData); err != nil {
-		return err
-	}
-
 	if err := vm.k.SetReserveContributors(ctx, contribs); err != nil {
 		return err
 	}
@@ -608,7 +617,7 @@ func (vm *validatorMgrV1) ragnarokPools(ctx sdk.Context, nth int64, constAccesso
 	// set all pools to bootstrap mode
 	for _, pool := range pools {
 		if pool.Status != PoolBootstrap {
-			poolEvent := NewEventPool(pool.Asset, PoolBootstrap)
+			poolEvent := NewEventPool(pool.Asset, PoolBootstrap, pool.BalanceRune, pool.BalanceAsset)
 			if err := eventManager.EmitPoolEvent(ctx, vm.k, common.BlankTxID, EventSuccess, poolEvent); err != nil {
 				ctx.Logger().Error("fail to emit pool event", "error", err)
 			}
@@ -619,9 +628,13 @@ func (vm *validatorMgrV1) ragnarokPools(ctx sdk.Context, nth int64, constAccesso
 			ctx.Logger().Error(err.Error())
 			return err
 		}
+		if err := vm.k.SetPoolRagnarokInProgress(ctx, pool.Asset); err != nil {
+			ctx.Logger().Error("fail to set pool ragnarok", "error", err)
+			return err
+		}
 	}
 
-	version := vm.k.GetLowestActiveVersion(ctx)
+	version := vm.k.GetThorchainVersion(ctx)
 
 	for i := len(pools) - 1; i >= 0; i-- { // iterate backwards
 		pool := pools[i]
@@ -808,6 +821,7 @@ func (vm *validatorMgrV1) findBadActors(ctx sdk.Context) (NodeAccounts, error) {
 	type badTracker struct {
 		Score       sdk.Dec
 		NodeAccount NodeAccount
+		SlashCount  int64 // number of times the node account got slashed recently
 	}
 	tracker := make([]badTracker, 0, len(nas))
 	totalScore := sdk.ZeroDec()
@@ -830,9 +844,19 @@ func (vm *validatorMgrV1) findBadActors(ctx sdk.Context) (NodeAccounts, error) {
 		score := age.Quo(sdk.NewDecWithPrec(slashPts, 5))
 		totalScore = totalScore.Add(score)
 
+		history, err := vm.k.GetNodeAccountSlashHistory(ctx, na.NodeAddress)
+		if err != nil {
+			ctx.Logger().Error("fail to get node slash history", "error", err)
+		}
+		slashCount := int64(0)
+		for _, record := range history {
+			slashCount += record.Count
+		}
+
 		tracker = append(tracker, badTracker{
 			Score:       score,
 			NodeAccount: na,
+			SlashCount:  slashCount,
 		})
 	}
 
@@ -841,7 +865,11 @@ func (vm *validatorMgrV1) findBadActors(ctx sdk.Context) (NodeAccounts, error) {
 		return nil, nil
 	}
 
+	// when two node accounts have the same score , the one that got slashed more often is the worse one
 	sort.SliceStable(tracker, func(i, j int) bool {
+		if tracker[i].Score.Equal(tracker[j].Score) {
+			return tracker[i].SlashCount > tracker[j].SlashCount
+		}
 		return tracker[i].Score.LT(tracker[j].Score)
 	})
 
@@ -871,8 +899,9 @@ func (vm *validatorMgrV1) findBadActors(ctx sdk.Context) (NodeAccounts, error) {
 	return badActors, nil
 }
 
-// Iterate over active node accounts, finding the one that has been active longest
-func (vm *validatorMgrV1) findOldActor(ctx sdk.Context) (NodeAccount, error) {
+// Iterate over active node accounts ready to churn, finding the one that has been active longest. Nodes with a bond
+// below MinimumBondInRune or more than ChurnMaximumSlashPoints are churned out first
+func (vm *validatorMgrV1) findOldActor(ctx sdk.Context, constAccessor constants.ConstantValues) (NodeAccount, error) {
 	na := NodeAccount{}
 	nas, err := vm.k.ListActiveNodeAccounts(ctx)
 	if err != nil {
@@ -881,13 +910,37 @@ func (vm *validatorMgrV1) findOldActor(ctx sdk.Context) (NodeAccount, error) {
 
 	// TODO: return if we're at risk of loosing BTF
 
+	minBond, err := vm.k.GetMimir(ctx, constants.MinimumBondInRune.String())
+	if minBond < 0 || err != nil {
+		minBond = constAccessor.GetInt64Value(constants.MinimumBondInRune)
+	}
+	maxSlashPts := constAccessor.GetInt64Value(constants.ChurnMaximumSlashPoints)
+
 	na.StatusSince = ctx.BlockHeight() // set the start status age to "now"
+	unfit := na
 	for _, n := range nas {
+		if !n.IsReadyToChurn(constAccessor, ctx.BlockHeight()) {
+			continue
+		}
+		slashPts, err := vm.k.GetNodeAccountSlashPoints(ctx, n.NodeAddress)
+		if err != nil {
+			ctx.Logger().Error("fail to get node slash points", "error", err)
+			continue
+		}
+		if n.Bond.LT(sdk.NewUint(uint64(minBond))) || slashPts > maxSlashPts {
+			if n.StatusSince < unfit.StatusSince {
+				unfit = n
+			}
+			continue
+		}
 		if n.StatusSince < na.StatusSince {
 			na = n
 		}
 	}
 
+	if !unfit.IsEmpty() {
+		return unfit, nil
+	}
 	return na, nil
 }
 
@@ -902,9 +955,9 @@ func (vm *validatorMgrV1) markActor(ctx sdk.Context, na NodeAccount, reason stri
 }
 
 // Mark an old actor to be churned out
-func (vm *validatorMgrV1) markOldActor(ctx sdk.Context, rate int64) error {
+func (vm *validatorMgrV1) markOldActor(ctx sdk.Context, rate int64, constAccessor constants.ConstantValues) error {
 	if ctx.BlockHeight()%rate == 0 {
-		na, err := vm.findOldActor(ctx)
+		na, err := vm.findOldActor(ctx, constAccessor)
 		if err != nil {
 			return err
 		}
@@ -931,6 +984,29 @@ func (vm *validatorMgrV1) markBadActor(ctx sdk.Context, rate int64) error {
 	return nil
 }
 
+// MaximumStandbyNodes return the maximum number of nodes can be admitted into the standby pool (ready to be churned in)
+// which is min(DesireValidatorSet, activeNodes * RotationFactor / 100) , RotationFactor is a percentage can be override by mimir
+func (vm *validatorMgrV1) MaximumStandbyNodes(ctx sdk.Context, constAccessor constants.ConstantValues) int {
+	active, err := vm.k.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get active node accounts", "error", err)
+		return 0
+	}
+	maxNodes, err := vm.k.GetMimir(ctx, constants.DesireValidatorSet.String())
+	if maxNodes < 0 || err != nil {
+		maxNodes = constAccessor.GetInt64Value(constants.DesireValidatorSet)
+	}
+	rotationFactor, err := vm.k.GetMimir(ctx, constants.RotationFactor.String())
+	if rotationFactor < 0 || err != nil {
+		rotationFactor = constAccessor.GetInt64Value(constants.RotationFactor)
+	}
+	maxStandby := int64(len(active)) * rotationFactor / 100
+	if maxStandby > maxNodes {
+		maxStandby = maxNodes
+	}
+	return int(maxStandby)
+}
+
 // find any actor that are ready to become "ready" status
 func (vm *validatorMgrV1) markReadyActors(ctx sdk.Context, constAccessor constants.ConstantValues) error {
 	standby, err := vm.k.ListNodeAccountsByStatus(ctx, NodeStandby)
@@ -941,12 +1017,33 @@ func (vm *validatorMgrV1) markReadyActors(ctx sdk.Context, constAccessor constan
 	if err != nil {
 		return err
 	}
+	active, err := vm.k.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		return err
+	}
 
 	// find min version node has to be, to be "ready" status
 	minVersion := vm.k.GetMinJoinVersion(ctx)
 
+	// the size of the standby pool is limited , always leave enough room to bring the network back to BFT though
+	maxReady := vm.MaximumStandbyNodes(ctx, constAccessor)
+	minimumNodesForBFT := int(constAccessor.GetInt64Value(constants.MinimumNodesForBFT))
+	if maxReady < minimumNodesForBFT-len(active) {
+		maxReady = minimumNodesForBFT - len(active)
+	}
+	if maxReady < 1 {
+		maxReady = 1
+	}
+
+	// nodes with higher bond get admitted first
+	candidates := append(standby, ready...)
+	if err := sortByBondWithRewards(ctx, vm.k, candidates); err != nil {
+		return err
+	}
+
+	totalReady := 0
 	// check all ready and standby nodes are in "ready" state (upgrade/downgrade as needed)
-	for _, na := range append(standby, ready...) {
+	for _, na := range candidates {
 		na.UpdateStatus(NodeReady, ctx.BlockHeight()) // everyone starts with the benefit of the doubt
 		// TODO: check node is up to date on binance, etc
 		// must have made an observation that matched 2/3rds within the last 5 blocks
@@ -981,6 +1078,15 @@ func (vm *validatorMgrV1) markReadyActors(ctx sdk.Context, constAccessor constan
 			na.UpdateStatus(NodeStandby, ctx.BlockHeight())
 		}
 
+		// standby pool is full
+		if na.Status == NodeReady {
+			if totalReady >= maxReady {
+				na.UpdateStatus(NodeStandby, ctx.BlockHeight())
+			} else {
+				totalReady++
+			}
+		}
+
 		// ensure banned nodes can't get churned in again
 		if na.ForcedToLeave {
 			na.UpdateStatus(NodeDisabled, ctx.BlockHeight())
@@ -1009,9 +1115,9 @@ func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx sdk.Context, targetCount int
 	}
 
 	// sort by bond size
-	sort.SliceStable(ready, func(i, j int) bool {
-		return ready[i].Bond.GT(ready[j].Bond)
-	})
+	if err := sortByBondWithRewards(ctx, vm.k, ready); err != nil {
+		return nil, false, err
+	}
 
 	active, err := vm.k.ListActiveNodeAccounts(ctx)
 	if err != nil {
@@ -1095,7 +1201,10 @@ func findCountToRemove(blockHeight int64, active NodeAccounts) (toRemove int) {
 // findMaxAbleToLeave - given number of current active node account, figure out
 // the max number of individuals we can allow to leave in a single churn event
 func findMaxAbleToLeave(count int) int {
-	majority := (count * 2 / 3) + 1 // add an extra 1 to "round up" for security
+	majority, err := GetThreshold(count)
+	if err != nil {
+		return 0
+	}
 	max := count - majority
 
 	// we don't want to loose BFT by accident (only when someone leaves)
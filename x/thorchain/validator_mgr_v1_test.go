@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/constants"
 )
 
@@ -74,6 +75,142 @@ func (vts *ValidatorMgrV1TestSuite) TestBadActors(c *C) {
 	c.Check(count, Equals, 2)
 }
 
+func (vts *ValidatorMgrV1TestSuite) TestBadActorsWithSlashHistory(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	vMgr := newValidatorMgrV1(k, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+	c.Assert(vMgr, NotNil)
+
+	// two node accounts with the same score, the one slashed more often is the worse actor
+	node1 := GetRandomNodeAccount(NodeActive)
+	node1.StatusSince = 1
+	k.SetNodeAccountSlashPoints(ctx, node1.NodeAddress, 30)
+	c.Assert(k.SetNodeAccount(ctx, node1), IsNil)
+	c.Assert(k.RecordSlash(ctx.WithBlockHeight(100), node1.NodeAddress, "fail to keysign", 30), IsNil)
+
+	node2 := GetRandomNodeAccount(NodeActive)
+	node2.StatusSince = 1
+	k.SetNodeAccountSlashPoints(ctx, node2.NodeAddress, 30)
+	c.Assert(k.SetNodeAccount(ctx, node2), IsNil)
+	for i := int64(0); i < 3; i++ {
+		c.Assert(k.RecordSlash(ctx.WithBlockHeight(100+i), node2.NodeAddress, "lack of observation", 10), IsNil)
+	}
+
+	nas, err := vMgr.findBadActors(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(nas, HasLen, 1)
+	c.Check(nas[0].NodeAddress.Equals(node2.NodeAddress), Equals, true)
+}
+
+func (vts *ValidatorMgrV1TestSuite) TestFindOldActor(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	minBond := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.MinimumBondInRune)))
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+
+	vMgr := newValidatorMgrV1(k, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+	c.Assert(vMgr, NotNil)
+
+	// active for too short
+	young := GetRandomNodeAccount(NodeActive)
+	young.Bond = minBond
+	young.StatusSince = 900
+	c.Assert(k.SetNodeAccount(ctx, young), IsNil)
+	na, err := vMgr.findOldActor(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(na.IsEmpty(), Equals, true)
+
+	old1 := GetRandomNodeAccount(NodeActive)
+	old1.Bond = minBond
+	old1.StatusSince = 40
+	c.Assert(k.SetNodeAccount(ctx, old1), IsNil)
+	old2 := GetRandomNodeAccount(NodeActive)
+	old2.Bond = minBond
+	old2.StatusSince = 30
+	c.Assert(k.SetNodeAccount(ctx, old2), IsNil)
+	na, err = vMgr.findOldActor(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(na.NodeAddress.Equals(old2.NodeAddress), Equals, true)
+
+	// too many slash points, churned out ahead of older nodes
+	slashed := GetRandomNodeAccount(NodeActive)
+	slashed.Bond = minBond
+	slashed.StatusSince = 500
+	c.Assert(k.SetNodeAccount(ctx, slashed), IsNil)
+	k.SetNodeAccountSlashPoints(ctx, slashed.NodeAddress, constAccessor.GetInt64Value(constants.ChurnMaximumSlashPoints)+1)
+	na, err = vMgr.findOldActor(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(na.NodeAddress.Equals(slashed.NodeAddress), Equals, true)
+
+	// not enough bond
+	lowBond := GetRandomNodeAccount(NodeActive)
+	lowBond.Bond = common.SafeSub(minBond, sdk.OneUint())
+	lowBond.StatusSince = 400
+	c.Assert(k.SetNodeAccount(ctx, lowBond), IsNil)
+	na, err = vMgr.findOldActor(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(na.NodeAddress.Equals(lowBond.NodeAddress), Equals, true)
+
+	// raise the minimum bond through mimir, old2 is the oldest node under it
+	k.SetMimir(ctx, constants.MinimumBondInRune.String(), int64(minBond.Uint64()+1))
+	na, err = vMgr.findOldActor(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(na.NodeAddress.Equals(old2.NodeAddress), Equals, true)
+}
+
+func (vts *ValidatorMgrV1TestSuite) TestPayNodeAccountBondAward(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	vMgr := newValidatorMgrV1(k, versionedTxOutStoreDummy, versionedVaultMgrDummy, NewDummyVersionedEventMgr())
+
+	vd := NewVaultData()
+	vd.BondRewardRune = sdk.NewUint(1000 * common.One)
+	vd.TotalBondUnits = sdk.NewUint(1000)
+	c.Assert(k.SetVaultData(ctx, vd), IsNil)
+
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = sdk.NewUint(100 * common.One)
+	na.ActiveBlockHeight = 1
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	// rewards are accumulated, and the bond is left untouched
+	expected := sdk.ZeroUint()
+	for i := int64(1); i <= 2; i++ {
+		ctx = ctx.WithBlockHeight(na.ActiveBlockHeight + 100)
+		c.Assert(vMgr.payNodeAccountBondAward(ctx, na), IsNil)
+		expected = expected.Add(sdk.NewUint(100 * common.One))
+		rewards, err := k.GetNodeBondRewards(ctx, na.PubKeySet.Secp256k1)
+		c.Assert(err, IsNil)
+		c.Check(rewards.Equal(expected), Equals, true, Commentf("%d", rewards.Uint64()))
+		na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+		c.Assert(err, IsNil)
+		c.Check(na.Bond.Equal(sdk.NewUint(100*common.One)), Equals, true)
+		c.Check(na.ActiveBlockHeight, Equals, int64(0))
+		na.ActiveBlockHeight = ctx.BlockHeight()
+	}
+
+	// paying out the rewards reset the accumulator
+	c.Assert(claimNodeBondRewards(ctx, k, &na), IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(300*common.One)), Equals, true)
+	rewards, err := k.GetNodeBondRewards(ctx, na.PubKeySet.Secp256k1)
+	c.Assert(err, IsNil)
+	c.Check(rewards.IsZero(), Equals, true)
+
+	// nothing to claim
+	c.Assert(claimNodeBondRewards(ctx, k, &na), IsNil)
+	c.Check(na.Bond.Equal(sdk.NewUint(300*common.One)), Equals, true)
+}
+
 func (vts *ValidatorMgrV1TestSuite) TestRagnarokBond(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(1)
@@ -177,11 +314,67 @@ func (vts *ValidatorMgrV1TestSuite) TestFindMaxAbleToLeave(c *C) {
 	c.Check(findMaxAbleToLeave(4), Equals, 0)
 
 	c.Check(findMaxAbleToLeave(5), Equals, 1)
-	c.Check(findMaxAbleToLeave(6), Equals, 1)
+	c.Check(findMaxAbleToLeave(6), Equals, 2)
 	c.Check(findMaxAbleToLeave(7), Equals, 2)
 	c.Check(findMaxAbleToLeave(8), Equals, 2)
-	c.Check(findMaxAbleToLeave(9), Equals, 2)
+	c.Check(findMaxAbleToLeave(9), Equals, 3)
 	c.Check(findMaxAbleToLeave(10), Equals, 3)
 	c.Check(findMaxAbleToLeave(11), Equals, 3)
-	c.Check(findMaxAbleToLeave(12), Equals, 3)
+	c.Check(findMaxAbleToLeave(12), Equals, 4)
+}
+
+func (vts *ValidatorMgrV1TestSuite) TestMaximumStandbyNodes(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+	vMgr := newValidatorMgrV1(k, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	// no active nodes
+	c.Assert(vMgr.MaximumStandbyNodes(ctx, constAccessor), Equals, 0)
+
+	for i := 0; i < 10; i++ {
+		c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+	}
+	c.Assert(vMgr.MaximumStandbyNodes(ctx, constAccessor), Equals, 5)
+
+	// mimir override
+	k.SetMimir(ctx, constants.RotationFactor.String(), 20)
+	c.Assert(vMgr.MaximumStandbyNodes(ctx, constAccessor), Equals, 2)
+
+	// never more than the maximum number of nodes
+	k.SetMimir(ctx, constants.RotationFactor.String(), 1000)
+	c.Assert(vMgr.MaximumStandbyNodes(ctx, constAccessor), Equals, 33)
+	k.SetMimir(ctx, constants.DesireValidatorSet.String(), 10)
+	c.Assert(vMgr.MaximumStandbyNodes(ctx, constAccessor), Equals, 10)
+}
+
+func (vts *ValidatorMgrV1TestSuite) TestMarkReadyActorsWithMaximumStandbyNodes(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	versionedEventManagerDummy := NewDummyVersionedEventMgr()
+	vMgr := newValidatorMgrV1(k, versionedTxOutStoreDummy, versionedVaultMgrDummy, versionedEventManagerDummy)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	for i := 0; i < 4; i++ {
+		c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+	}
+	for i := 0; i < 3; i++ {
+		na := GetRandomNodeAccount(NodeStandby)
+		na.Bond = sdk.NewUint(uint64(2_000_000+i) * common.One)
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	c.Assert(vMgr.markReadyActors(ctx, constAccessor), IsNil)
+	// 4 active nodes * 50% , only the two highest bonded nodes become ready
+	ready, err := k.ListNodeAccountsByStatus(ctx, NodeReady)
+	c.Assert(err, IsNil)
+	c.Assert(ready, HasLen, 2)
+	for _, na := range ready {
+		c.Assert(na.Bond.GT(sdk.NewUint(2_000_000*common.One)), Equals, true)
+	}
+	standby, err := k.ListNodeAccountsByStatus(ctx, NodeStandby)
+	c.Assert(err, IsNil)
+	c.Assert(standby, HasLen, 1)
 }
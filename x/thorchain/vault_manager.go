@@ -78,6 +78,11 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 		migrateInterval = constAccessor.GetInt64Value(constants.FundMigrationInterval)
 	}
 
+	gracePeriod, err := vm.k.GetMimir(ctx, constants.RetirementGracePeriodBlocks.String())
+	if gracePeriod < 0 || err != nil {
+		gracePeriod = constAccessor.GetInt64Value(constants.RetirementGracePeriodBlocks)
+	}
+
 	retiring, err := vm.k.GetAsgardVaultsByStatus(ctx, RetiringVault)
 	if err != nil {
 		return err
@@ -99,12 +104,24 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 	}
 	for _, vault := range retiring {
 		if !vault.HasFunds() {
-			vault.Status = InactiveVault
-			if err := vm.k.SetVault(ctx, vault); err != nil {
+			// empty vaults used to be retired straight away before version 0.3.0
+			if version.GTE(semver.MustParse("0.3.0")) {
+				err = vm.retireEmptyVault(ctx, vault, gracePeriod)
+			} else {
+				err = vm.k.SetVaultStatus(ctx, vault.PubKey, InactiveVault, ctx.BlockHeight())
+			}
+			if err != nil {
 				ctx.Logger().Error("fail to set vault to inactive", "error", err)
 			}
 			continue
 		}
+		if vault.RetirementCompletedAt > 0 {
+			// funds arrived at the vault during the grace period, keep migrating them
+			vault.RetirementCompletedAt = 0
+			if err := vm.k.SetVault(ctx, vault); err != nil {
+				ctx.Logger().Error("fail to save vault", "error", err)
+			}
+		}
 
 		// move partial funds every 30 minutes
 		if (ctx.BlockHeight()-vault.StatusSince)%migrateInterval == 0 {
@@ -198,6 +215,118 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 	return nil
 }
 
+// RebalanceYggdrasilFunds top up the yggdrasil vaults of the active nodes that hold less than MinYggFundPercent of
+// their target amount of a coin, the coins are sent from the asgard vault that has the most of them. Yggdrasil vaults
+// with pending txs are skipped, so the same top up is not scheduled twice before it arrives
+func (vm *VaultMgr) RebalanceYggdrasilFunds(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) error {
+	if version.LT(semver.MustParse("0.3.0")) {
+		return nil
+	}
+	if vm.k.RagnarokInProgress(ctx) {
+		return nil
+	}
+	retiring, err := vm.k.GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		return fmt.Errorf("fail to get retiring vaults: %w", err)
+	}
+	if len(retiring) > 0 {
+		// skip the rebalance while a migration is in progress
+		return nil
+	}
+	nodeAccs, err := vm.k.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get active node accounts: %w", err)
+	}
+	if int64(len(nodeAccs)) <= constAccessor.GetInt64Value(constants.MinimumNodesForYggdrasil) {
+		return nil
+	}
+	pools, err := vm.k.GetPools(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get pools: %w", err)
+	}
+	minBond, err := vm.k.GetMimir(ctx, constants.MinimumBondInRune.String())
+	if minBond < 0 || err != nil {
+		minBond = constAccessor.GetInt64Value(constants.MinimumBondInRune)
+	}
+	minPercent, err := vm.k.GetMimir(ctx, constants.MinYggFundPercent.String())
+	if minPercent < 0 || err != nil {
+		minPercent = constAccessor.GetInt64Value(constants.MinYggFundPercent)
+	}
+	txOutStore, err := vm.versionedTxOutStore.GetTxOutStore(ctx, vm.k, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get txout store", "error", err)
+		return errBadVersion
+	}
+
+	totalBond := sdk.ZeroUint()
+	for _, na := range nodeAccs {
+		totalBond = totalBond.Add(na.Bond)
+	}
+	for _, na := range nodeAccs {
+		if na.Bond.LT(sdk.NewUint(uint64(minBond))) {
+			continue
+		}
+		// yggdrasil vaults are created by Fund, only the existing ones get topped up here
+		if !vm.k.VaultExists(ctx, na.PubKeySet.Secp256k1) {
+			continue
+		}
+		ygg, err := vm.k.GetVault(ctx, na.PubKeySet.Secp256k1)
+		if err != nil {
+			return fmt.Errorf("fail to get yggdrasil vault: %w", err)
+		}
+		if !ygg.IsYggdrasil() || ygg.Status != ActiveVault {
+			continue
+		}
+		if ygg.LenPendingTxBlockHeights(ctx.BlockHeight(), constAccessor) > 0 {
+			continue
+		}
+		// calcTargetYggCoins return the amount of each coin the yggdrasil vault is short of its target
+		shortCoins, err := calcTargetYggCoins(pools, ygg, na.Bond, totalBond)
+		if err != nil {
+			ctx.Logger().Error("fail to calculate yggdrasil target coins", "pubkey", ygg.PubKey, "error", err)
+			continue
+		}
+		var sendCoins common.Coins
+		for _, coin := range shortCoins {
+			held := ygg.GetCoin(coin.Asset).Amount
+			target := held.Add(coin.Amount)
+			if held.MulUint64(100).LT(target.MulUint64(uint64(minPercent))) {
+				sendCoins = append(sendCoins, coin)
+			}
+		}
+		if len(sendCoins) == 0 {
+			continue
+		}
+		count, err := sendCoinsToYggdrasil(ctx, vm.k, sendCoins, ygg, txOutStore)
+		if err != nil {
+			return fmt.Errorf("fail to send coins to yggdrasil vault: %w", err)
+		}
+		if count == 0 {
+			continue
+		}
+		for i := 0; i < count; i++ {
+			ygg.AppendPendingTxBlockHeights(ctx.BlockHeight(), constAccessor)
+		}
+		if err := vm.k.SetVault(ctx, ygg); err != nil {
+			return fmt.Errorf("fail to save yggdrasil vault: %w", err)
+		}
+	}
+	return nil
+}
+
+// retireEmptyVault set the given empty retiring vault to inactive, once it has been empty for more than gracePeriod blocks.
+// Inbound txs that are still pending could arrive at the vault right after the funds have been migrated
+func (vm *VaultMgr) retireEmptyVault(ctx sdk.Context, vault Vault, gracePeriod int64) error {
+	if vault.RetirementCompletedAt == 0 {
+		vault.RetirementCompletedAt = ctx.BlockHeight()
+		return vm.k.SetVault(ctx, vault)
+	}
+	if ctx.BlockHeight()-vault.RetirementCompletedAt <= gracePeriod {
+		return nil
+	}
+	return vm.k.SetVaultStatus(ctx, vault.PubKey, InactiveVault, ctx.BlockHeight())
+}
+
 // TriggerKeygen generate a record to instruct signer kick off keygen process
 func (vm *VaultMgr) TriggerKeygen(ctx sdk.Context, nas NodeAccounts) error {
 	var members common.PubKeys
@@ -229,8 +358,7 @@ func (vm *VaultMgr) RotateVault(ctx sdk.Context, vault Vault) error {
 	for _, asgard := range active {
 		for _, member := range asgard.Membership {
 			if vault.Contains(member) {
-				asgard.UpdateStatus(RetiringVault, ctx.BlockHeight())
-				if err := vm.k.SetVault(ctx, asgard); err != nil {
+				if err := vm.k.SetVaultStatus(ctx, asgard.PubKey, RetiringVault, ctx.BlockHeight()); err != nil {
 					return err
 				}
 
@@ -348,7 +476,7 @@ func (vm *VaultMgr) findChainsToRetire(ctx sdk.Context) (common.Chains, error) {
 // recallChainFunds - sends a message to bifrost nodes to send back all funds
 // associated with given chain
 func (vm *VaultMgr) recallChainFunds(ctx sdk.Context, chain common.Chain) error {
-	version := vm.k.GetLowestActiveVersion(ctx)
+	version := vm.k.GetThorchainVersion(ctx)
 	allNodes, err := vm.k.ListNodeAccountsWithBond(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to list all node accounts: %w", err)
@@ -417,7 +545,7 @@ func (vm *VaultMgr) recallChainFunds(ctx sdk.Context, chain common.Chain) error
 // ragnarokChain - ends a chain by unstaking all stakers of any pool that's
 // asset is on the given chain
 func (vm *VaultMgr) ragnarokChain(ctx sdk.Context, chain common.Chain, nth int64, constAccessor constants.ConstantValues) error {
-	version := vm.k.GetLowestActiveVersion(ctx)
+	version := vm.k.GetThorchainVersion(ctx)
 	nas, err := vm.k.ListActiveNodeAccounts(ctx)
 	if err != nil {
 		ctx.Logger().Error("can't get active nodes", "error", err)
@@ -448,6 +576,10 @@ func (vm *VaultMgr) ragnarokChain(ctx sdk.Context, chain common.Chain, nth int64
 		if !pool.Asset.Chain.Equals(chain) || pool.PoolUnits.IsZero() {
 			continue
 		}
+		if err := vm.k.SetPoolRagnarokInProgress(ctx, pool.Asset); err != nil {
+			ctx.Logger().Error("fail to set pool ragnarok", "error", err)
+			return err
+		}
 		iterator := vm.k.GetStakerIterator(ctx, pool.Asset)
 		defer iterator.Close()
 		for ; iterator.Valid(); iterator.Next() {
@@ -48,3 +48,7 @@ func (vm *VaultMgrDummy) RotateVault(ctx sdk.Context, vault Vault) error {
 	vm.vault = vault
 	return nil
 }
+
+func (vm *VaultMgrDummy) RebalanceYggdrasilFunds(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) error {
+	return nil
+}
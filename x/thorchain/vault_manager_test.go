@@ -127,7 +127,7 @@ func (k *TestRagnarokChainKeeper) GetGas(_ sdk.Context, _ common.Asset) ([]sdk.U
 	return []sdk.Uint{sdk.NewUint(10)}, k.err
 }
 
-func (k *TestRagnarokChainKeeper) GetLowestActiveVersion(_ sdk.Context) semver.Version {
+func (k *TestRagnarokChainKeeper) GetThorchainVersion(_ sdk.Context) semver.Version {
 	return constants.SWVersion
 }
 
@@ -221,3 +221,154 @@ func (s *ValidatorManagerTestSuite) TestRagnarokChain(c *C) {
 	c.Check(items[0].Memo, Equals, NewYggdrasilReturn(ctx.BlockHeight()).String())
 	c.Check(items[0].Chain.Equals(common.BTCChain), Equals, true)
 }
+
+func (s *VaultManagerTestSuite) TestRetireEmptyVault(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	gracePeriod := constAccessor.GetInt64Value(constants.RetirementGracePeriodBlocks)
+	vaultMgr := NewVaultMgr(k, NewVersionedTxOutStoreDummy(), NewDummyVersionedEventMgr())
+
+	active := GetRandomVault()
+	active.Status = ActiveVault
+	c.Assert(k.SetVault(ctx, active), IsNil)
+	retiring := GetRandomVault()
+	retiring.Status = RetiringVault
+	retiring.Coins = nil
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+
+	// the vault is empty, start the grace period
+	c.Assert(vaultMgr.EndBlock(ctx, ver, constAccessor), IsNil)
+	vault, err := k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, RetiringVault)
+	c.Check(vault.RetirementCompletedAt, Equals, int64(10))
+
+	// still in the grace period
+	c.Assert(vaultMgr.EndBlock(ctx.WithBlockHeight(10+gracePeriod), ver, constAccessor), IsNil)
+	vault, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, RetiringVault)
+
+	// funds arrived during the grace period
+	vault.Coins = common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(common.One))}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	c.Assert(vaultMgr.EndBlock(ctx.WithBlockHeight(11+gracePeriod), ver, constAccessor), IsNil)
+	vault, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, RetiringVault)
+	c.Check(vault.RetirementCompletedAt, Equals, int64(0))
+
+	// empty again, the grace period restart
+	vault.Coins = nil
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	height := 12 + gracePeriod
+	c.Assert(vaultMgr.EndBlock(ctx.WithBlockHeight(height), ver, constAccessor), IsNil)
+	c.Assert(vaultMgr.EndBlock(ctx.WithBlockHeight(height+gracePeriod), ver, constAccessor), IsNil)
+	vault, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, RetiringVault)
+	c.Check(vault.RetirementCompletedAt, Equals, height)
+
+	// grace period is over
+	c.Assert(vaultMgr.EndBlock(ctx.WithBlockHeight(height+gracePeriod+1), ver, constAccessor), IsNil)
+	vault, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, InactiveVault)
+
+	// empty vaults are retired straight away before version 0.3.0
+	retiring = GetRandomVault()
+	retiring.Status = RetiringVault
+	retiring.Coins = nil
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+	c.Assert(vaultMgr.EndBlock(ctx, semver.MustParse("0.2.0"), constAccessor), IsNil)
+	vault, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, InactiveVault)
+	c.Check(vault.RetirementCompletedAt, Equals, int64(0))
+}
+
+func (s *VaultManagerTestSuite) TestRebalanceYggdrasilFunds(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedTxOutStoreDummy.txoutStore.NewBlock(ctx.BlockHeight(), constAccessor)
+	vaultMgr := NewVaultMgr(k, versionedTxOutStoreDummy, NewDummyVersionedEventMgr())
+
+	asgard := GetRandomVault()
+	asgard.Status = ActiveVault
+	asgard.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(10000*common.One)),
+		common.NewCoin(common.BNBAsset, sdk.NewUint(10000*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, asgard), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = sdk.NewUint(100000 * common.One)
+	pool.BalanceRune = sdk.NewUint(100000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	// not enough nodes for yggdrasil vaults
+	c.Assert(vaultMgr.RebalanceYggdrasilFunds(ctx, ver, constAccessor), IsNil)
+	items, err := versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+
+	var nodeAccounts NodeAccounts
+	totalBond := sdk.ZeroUint()
+	for i := 0; i < 7; i++ {
+		na := GetRandomNodeAccount(NodeActive)
+		na.Bond = sdk.NewUint(common.One * 1000000)
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+		nodeAccounts = append(nodeAccounts, na)
+		totalBond = totalBond.Add(na.Bond)
+	}
+	newYgg := func(na NodeAccount) Vault {
+		return NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, nil)
+	}
+	targetCoins, err := calcTargetYggCoins(Pools{pool}, newYgg(nodeAccounts[0]), nodeAccounts[0].Bond, totalBond)
+	c.Assert(err, IsNil)
+	c.Assert(targetCoins, HasLen, 2)
+
+	// an empty yggdrasil vault get topped up
+	c.Assert(k.SetVault(ctx, newYgg(nodeAccounts[0])), IsNil)
+	// a yggdrasil vault above MinYggFundPercent of its target is left alone
+	ygg := newYgg(nodeAccounts[1])
+	for _, coin := range targetCoins {
+		ygg.AddFunds(common.Coins{common.NewCoin(coin.Asset, coin.Amount.MulUint64(60).QuoUint64(100))})
+	}
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+	// a yggdrasil vault with pending txs is skipped
+	ygg = newYgg(nodeAccounts[2])
+	ygg.AppendPendingTxBlockHeights(ctx.BlockHeight(), constAccessor)
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+
+	// yggdrasil vaults are not rebalanced before version 0.3.0
+	c.Assert(vaultMgr.RebalanceYggdrasilFunds(ctx, semver.MustParse("0.2.0"), constAccessor), IsNil)
+	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+
+	c.Assert(vaultMgr.RebalanceYggdrasilFunds(ctx, ver, constAccessor), IsNil)
+	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	for _, item := range items {
+		addr, err := nodeAccounts[0].PubKeySet.Secp256k1.GetAddress(item.Chain)
+		c.Assert(err, IsNil)
+		c.Check(item.ToAddress.Equals(addr), Equals, true)
+		c.Check(item.VaultPubKey.Equals(asgard.PubKey), Equals, true)
+		c.Check(item.Coin.Amount.Equal(targetCoins.GetCoin(item.Coin.Asset).Amount), Equals, true)
+	}
+	ygg, err = k.GetVault(ctx, nodeAccounts[0].PubKeySet.Secp256k1)
+	c.Assert(err, IsNil)
+	c.Check(ygg.LenPendingTxBlockHeights(ctx.BlockHeight(), constAccessor), Equals, 2)
+
+	// the top up is pending, nothing is sent again
+	c.Assert(vaultMgr.RebalanceYggdrasilFunds(ctx, ver, constAccessor), IsNil)
+	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+}
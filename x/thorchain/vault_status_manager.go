@@ -0,0 +1,45 @@
+package thorchain
+
+import (
+	"fmt"
+)
+
+// validVaultStatusTransitions define all the status a vault is allowed to move to from a given status
+// InactiveVault is a final status, once a vault become inactive it can't move to any other status
+var validVaultStatusTransitions = map[VaultStatus][]VaultStatus{
+	ActiveVault:   {RetiringVault, InactiveVault},
+	RetiringVault: {InactiveVault},
+	InactiveVault: {},
+}
+
+// VaultStatusTransitionManager make sure vault only move between status following the valid path
+type VaultStatusTransitionManager struct {
+	transitions map[VaultStatus][]VaultStatus
+}
+
+// NewVaultStatusTransitionManager create a new instance of VaultStatusTransitionManager
+func NewVaultStatusTransitionManager() VaultStatusTransitionManager {
+	return VaultStatusTransitionManager{
+		transitions: validVaultStatusTransitions,
+	}
+}
+
+// Transition check whether a vault is allowed to move from status `from` to status `to`
+// staying in the same status is always allowed
+func (m VaultStatusTransitionManager) Transition(from, to VaultStatus) error {
+	if err := from.Valid(); err != nil {
+		return fmt.Errorf("invalid from status: %w", err)
+	}
+	if err := to.Valid(); err != nil {
+		return fmt.Errorf("invalid to status: %w", err)
+	}
+	if from == to {
+		return nil
+	}
+	for _, item := range m.transitions[from] {
+		if item == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("vault status can't transit from %s to %s", from, to)
+}
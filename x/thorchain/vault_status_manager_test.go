@@ -0,0 +1,46 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type VaultStatusTransitionManagerSuite struct{}
+
+var _ = Suite(&VaultStatusTransitionManagerSuite{})
+
+func (s *VaultStatusTransitionManagerSuite) TestTransition(c *C) {
+	allStatus := []VaultStatus{
+		ActiveVault,
+		RetiringVault,
+		InactiveVault,
+	}
+	valid := map[VaultStatus]map[VaultStatus]bool{
+		ActiveVault: {
+			ActiveVault:   true,
+			RetiringVault: true,
+			InactiveVault: true,
+		},
+		RetiringVault: {
+			RetiringVault: true,
+			InactiveVault: true,
+		},
+		InactiveVault: {
+			InactiveVault: true,
+		},
+	}
+	m := NewVaultStatusTransitionManager()
+	for _, from := range allStatus {
+		for _, to := range allStatus {
+			err := m.Transition(from, to)
+			if valid[from][to] {
+				c.Check(err, IsNil, Commentf("%s => %s should be valid", from, to))
+			} else {
+				c.Check(err, NotNil, Commentf("%s => %s should be invalid", from, to))
+			}
+		}
+	}
+
+	// invalid status
+	c.Check(m.Transition(VaultStatus("whatever"), ActiveVault), NotNil)
+	c.Check(m.Transition(ActiveVault, VaultStatus("")), NotNil)
+}
@@ -17,6 +17,7 @@ type VaultManager interface {
 	TriggerKeygen(ctx sdk.Context, nas NodeAccounts) error
 	RotateVault(ctx sdk.Context, vault Vault) error
 	EndBlock(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) error
+	RebalanceYggdrasilFunds(ctx sdk.Context, version semver.Version, constAccessor constants.ConstantValues) error
 }
 
 // VersionedVaultMgr is an implementation of versioned Vault Manager